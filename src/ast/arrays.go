@@ -0,0 +1,431 @@
+// This file implements the arrays whose elements are neither integers nor
+// strings, such as multi-dimensional arrays and arrays of structs, along with
+// the array literals. Such an array type is placed as -
+//	"arr:<length>:<element type>"
+// and the symbol table entry of an array of the type is of the form -
+//	{ renamedVar, length, element type }
+// The elements of an array are laid out one after another, where an element
+// occupies the number of words given by sizeOf. A struct element is laid out
+// as a block (see pointers.go), and an element which is an array is referred
+// to by its address, hence the elements are accessed like the values pointed
+// to by pointers.
+
+package ast
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shivansh/gogo/src/tac"
+	"github.com/shivansh/gogo/src/utils"
+)
+
+// elidedLits maps the places of the composite literals whose types are elided
+// to their values. Such a literal is placed as "lit:<index>", and is resolved
+// once the element type of the enclosing literal is known.
+var elidedLits = make(map[string]*Node)
+
+// arrayLits contains the temporary arrays holding array literals, whose
+// addresses are taken by allocating copies on the heap.
+var arrayLits = make(map[string]bool)
+
+// arrayParts returns the length and the element type of an array type.
+func arrayParts(typ string) (string, string) {
+	typ = underlying(typ)
+	switch GetPrefix(typ) {
+	case ARRINT:
+		return StripPrefix(typ), INT
+	case ARRSTR:
+		return StripPrefix(typ), STR
+	}
+	typ = StripPrefix(typ)
+	i := strings.Index(typ, ":")
+	return typ[:i], typ[i+1:]
+}
+
+// sizeOf returns the number of words occupied by a value of the given type
+// when it is an element of an array.
+func sizeOf(typ string) int {
+	if isArrayType(typ) {
+		length, elem := arrayParts(typ)
+		n, _ := strconv.Atoi(length)
+		return n * sizeOf(elem)
+	}
+	return wordsOf([]string{typ})
+}
+
+// arrayType returns the type of the array at a place.
+func arrayType(place string) (string, bool) {
+	symEntry, found := lookupPlace(place)
+	if !found {
+		return "", false
+	}
+	switch symEntry.kind {
+	case ARRAY:
+		return fmt.Sprintf("%s:%s:%s", ARR, symEntry.symbols[1], symEntry.symbols[2]), true
+	case INTEGER, STRING:
+		if length, ok := arrayLen(place); ok {
+			prefix := ARRINT
+			if symEntry.kind == STRING {
+				prefix = ARRSTR
+			}
+			return prefix + ":" + length, true
+		}
+	}
+	return "", false
+}
+
+// checkElem verifies that a type can be the element type of an array.
+func checkElem(typ string) error {
+	switch GetKind(typ) {
+	case SLICE, MAP, FUNCVAL, CHANNEL:
+		return fmt.Errorf("arrays of type %s are not supported", displayType(typ))
+	}
+	if !isStructType(typ) {
+		return nil
+	}
+	for _, v := range flatTypes([]string{typ}) {
+		if isArrayType(v) {
+			return fmt.Errorf("arrays of struct %s with array fields are not supported", typ)
+		}
+	}
+	return nil
+}
+
+// elemAddr returns the address of the element at an index of the array at
+// base, where each element occupies the given number of words.
+func elemAddr(base, index string, size int) (string, []string) {
+	addr := NewTmp()
+	InsertSymbol(addr, INTEGER, addr)
+	if i, err := strconv.Atoi(index); err == nil {
+		return addr, []string{fmt.Sprintf("%s, %s, %s, %d", tac.ADD, addr, base, i*size*tac.WordSize)}
+	}
+	off := NewTmp()
+	return addr, []string{
+		fmt.Sprintf("%s, %s, %s, %d", tac.MUL, off, index, size*tac.WordSize),
+		fmt.Sprintf("%s, %s, %s, %s", tac.ADD, addr, base, off),
+	}
+}
+
+// indexArray returns the element at the given index of an array whose symbol
+// table entry is symEntry.
+func indexArray(symEntry *SymTabEntry, expr, index *Node) (*Node, error) {
+	length, elem := symEntry.symbols[1], symEntry.symbols[2]
+	check, err := indexCheck(index.Place, length)
+	if err != nil {
+		return nil, err
+	}
+	n, err := loadElem(expr.Place, index.Place, elem)
+	if err != nil {
+		return nil, err
+	}
+	n.Code = utils.AppendCode(expr.Code, index.Code, check, n.Code)
+	return n, nil
+}
+
+// loadElem returns the element of the given type at an index of the array
+// whose first element is at base. An element which is an array is referred to
+// by its address, whereas any other element is loaded from its offset.
+func loadElem(base, index, elem string) (*Node, error) {
+	size := sizeOf(elem)
+	if isArrayType(elem) {
+		addr, code := elemAddr(base, index, size)
+		insertTyped(addr, elem, addr)
+		return &Node{addr, code}, nil
+	}
+	if i, err := strconv.Atoi(index); err == nil {
+		return deref(base, i*size, elem)
+	}
+	addr, code := elemAddr(base, index, size)
+	n, err := deref(addr, 0, elem)
+	if err != nil {
+		return nil, err
+	}
+	n.Code = append(code, n.Code...)
+	return n, nil
+}
+
+// NewElidedLit returns a composite literal whose type is elided, which is an
+// element of an array literal.
+func NewElidedLit(val *Node) (*Node, error) {
+	place := fmt.Sprintf("%s:%d", LIT, len(elidedLits))
+	elidedLits[place] = val
+	return &Node{place, []string{}}, nil
+}
+
+// checkElided verifies that none of the places refers to a composite literal
+// whose type is elided, as the type cannot be inferred outside of arrays.
+func checkElided(places []string) error {
+	for _, v := range places {
+		if GetPrefix(v) == LIT {
+			return fmt.Errorf("missing type in composite literal")
+		}
+	}
+	return nil
+}
+
+// newArrayLit returns an array literal, which is held by a temporary array.
+// The elements which are not given a value take their zero values.
+func newArrayLit(typ string, val *Node) (*Node, error) {
+	length, elem := arrayParts(typ)
+	values := utils.SplitAndSanitize(val.Place, ",")
+	if n, _ := strconv.Atoi(length); len(values) > n {
+		return nil, fmt.Errorf("array index %d out of bounds [0:%d]", n, n)
+	}
+	n := &Node{NewTmp(), val.Code}
+	insertTyped(n.Place, typ, n.Place)
+	arrayLits[n.Place] = true
+	n.Code = append(n.Code, declareArray(n.Place, typ)...)
+	code, err := storeElems(n.Place, elem, values, "array literal")
+	if err != nil {
+		return nil, err
+	}
+	n.Code = append(n.Code, code...)
+	return n, nil
+}
+
+// storeElems returns the code for storing the values of the elements of a
+// composite literal in the array at dst. An element whose type is elided is
+// a composite literal of the given element type (or the address of one).
+func storeElems(dst, elem string, values []string, context string) ([]string, error) {
+	code := []string{}
+	size := sizeOf(elem)
+	for k, v := range values {
+		if lit, ok := elidedLits[v]; ok {
+			if lit.Place == "" {
+				// The element keeps its zero value.
+				continue
+			}
+			// An elided literal of a pointer type is the address of
+			// a literal of the type pointed to.
+			typ := elem
+			if GetPrefix(elem) == PTR {
+				typ = StripPrefix(elem)
+			}
+			node, err := NewCompositeLit(&Node{typ, []string{}}, lit)
+			if err == nil && typ != elem {
+				node, err = addressOf(&Node{node.place(), node.code()})
+			}
+			if err != nil {
+				return nil, err
+			}
+			v = node.place()
+			code = append(code, node.code()...)
+		}
+		c, err := storeElem(dst, k*size, elem, v, context)
+		if err != nil {
+			return nil, err
+		}
+		code = append(code, c...)
+	}
+	return code, nil
+}
+
+// storeElem returns the code for storing the value at src as an element of
+// the given type, which is at an offset (in words) in the array at dst. The
+// context in which the element is stored is reported by errors.
+func storeElem(dst string, off int, elem, src, context string) ([]string, error) {
+	errElem := fmt.Errorf("cannot use %s (type %s) as type %s in %s",
+		exprName(src), typeOf(src), displayType(elem), context)
+	if isStructType(elem) {
+		if structType(src) != elem {
+			return nil, errElem
+		}
+		code := []string{}
+		for _, v := range members(src) {
+			code = append(code, fmt.Sprintf("%s, %s, %s, %d, %s", memOp(tac.INTO, KindOf(v)), dst, dst, off, v))
+			off += varWords([]string{v})
+		}
+		return code, nil
+	}
+	if isArrayType(elem) {
+		if typ, ok := arrayType(src); !ok || typ != elem {
+			return nil, errElem
+		}
+		addr, code := elemAddr(dst, strconv.Itoa(off), 1)
+		return append(code, copyArray(addr, src, strconv.Itoa(sizeOf(elem)))...), nil
+	}
+	if !samePointer(elem, src) || isStruct(src) || !assignable(src, elem) {
+		return nil, errElem
+	}
+	code := []string{}
+	kind := GetKind(elem)
+	if isFloat(kind) {
+		t := NewTmp()
+		InsertSymbol(t, kind, t)
+		c, err := assignFloat(t, kind, src)
+		if err != nil {
+			return nil, err
+		}
+		code, src = append(code, c), t
+	} else {
+		var c []string
+		src, c = strValue(src)
+		code = append(code, c...)
+	}
+	return append(code, fmt.Sprintf("%s, %s, %s, %d, %s", memOp(tac.INTO, kind), dst, dst, off, src)), nil
+}
+
+// declareArray returns the code for declaring an array of the given type,
+// whose elements are initialized to their zero values.
+func declareArray(name, typ string) []string {
+	size := strconv.Itoa(sizeOf(typ))
+	return append([]string{fmt.Sprintf("%s, %s, %s", tac.DECL, name, size)}, zeroFill(name, size, typ)...)
+}
+
+// zeroFill returns the code for initializing the given number of words at
+// base, which hold the elements of an array of the given type, to the zero
+// values of the elements. The words of a string hold the address of the empty
+// string, and the remaining words are zeroed.
+func zeroFill(base, size, typ string) []string {
+	zeros, code := zeroWords(typ)
+	i := NewTmp()
+	InsertSymbol(i, INTEGER, i)
+	loop, end := NewLabel(), NewLabel()
+	code = append(code,
+		fmt.Sprintf("%s, %s, 0", tac.EQ, i),
+		fmt.Sprintf("%s, %s", tac.LABEL, loop),
+		fmt.Sprintf("%s, %s, %s, %s", tac.BGE, end, i, size),
+		fmt.Sprintf("%s, %s, %s, %s, %s", tac.INTO, base, base, i, zeros[0]),
+	)
+	for k, v := range zeros[1:] {
+		t := NewTmp()
+		InsertSymbol(t, INTEGER, t)
+		code = append(code,
+			fmt.Sprintf("%s, %s, %s, %d", tac.ADD, t, i, k+1),
+			fmt.Sprintf("%s, %s, %s, %s, %s", tac.INTO, base, base, t, v),
+		)
+	}
+	return append(code,
+		fmt.Sprintf("%s, %s, %s, %d", tac.ADD, i, i, len(zeros)),
+		fmt.Sprintf("%s, %s", tac.JMP, loop),
+		fmt.Sprintf("%s, %s", tac.LABEL, end),
+	)
+}
+
+// holdsStrings determines whether a value of the given type holds strings,
+// whose zero values are not represented by zeroed words.
+func holdsStrings(typ string) bool {
+	return holdsKind(typ, STRING)
+}
+
+// isZeroed determines whether the zero value of the given type is represented
+// by zeroed words, which is not the case for strings and slices (whose zero
+// value is the address of an empty header).
+func isZeroed(typ string) bool {
+	return !holdsKind(typ, STRING) && !holdsKind(typ, SLICE)
+}
+
+// holdsKind determines whether a value of the given type holds values of the
+// given kind.
+func holdsKind(typ string, kind symkind) bool {
+	for isArrayType(typ) {
+		_, typ = arrayParts(typ)
+	}
+	for _, v := range flatTypes([]string{typ}) {
+		if GetKind(v) == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// zeroWords returns the zero values of the words occupied by an element of an
+// array of the given type, along with the code for loading the address of the
+// empty string (slice header) when an element holds strings (slices). The zero
+// values of an element are repeated throughout the array, hence the elements
+// share a single empty slice header, which is never modified.
+func zeroWords(typ string) ([]string, []string) {
+	if isZeroed(typ) {
+		// The words are zeroed one at a time.
+		return []string{"0"}, []string{}
+	}
+	for isArrayType(typ) {
+		_, typ = arrayParts(typ)
+	}
+	empty, header, code := "0", "0", []string{}
+	if holdsStrings(typ) {
+		empty, code = strValue(STR + ":\"\"")
+	}
+	if holdsKind(typ, SLICE) && currScope.parent != nil {
+		var c []string
+		header, c = newSliceHeader("0", "0", "0")
+		code = append(code, c...)
+	}
+	zeros := []string{}
+	for _, v := range flatTypes([]string{typ}) {
+		switch GetKind(v) {
+		case STRING:
+			zeros = append(zeros, empty)
+		case SLICE:
+			zeros = append(zeros, header)
+		case FLOAT64:
+			zeros = append(zeros, "0", "0")
+		default:
+			zeros = append(zeros, "0")
+		}
+	}
+	return zeros, code
+}
+
+// checkArray verifies that the value at src can be assigned to dst when
+// either of them is an array.
+func checkArray(dst, src string) error {
+	dstType, dstOk := arrayType(dst)
+	srcType, srcOk := arrayType(src)
+	if dstType == srcType || !dstOk && !srcOk {
+		return nil
+	}
+	return fmt.Errorf("cannot use %s (type %s) as type %s in assignment",
+		exprName(src), typeOf(src), typeOf(dst))
+}
+
+// assignArray returns the code for assigning the array at src to the array at
+// dst, which are copied word by word.
+func assignArray(dst, src string) ([]string, bool) {
+	typ, ok := arrayType(dst)
+	if !ok {
+		return nil, false
+	}
+	return copyArray(dst, src, strconv.Itoa(sizeOf(typ))), true
+}
+
+// arraySpec returns a variable specification which declares arrays of the
+// given type. The arrays are initialized with the values at the places in
+// expr, or with their zero values if expr is empty.
+func arraySpec(n *Node, idents []string, typ string, expr []string) (*Node, error) {
+	if len(expr) != 0 && len(expr) != len(idents) {
+		return nil, ErrCountMismatch(len(idents), len(expr))
+	}
+	if len(expr) != 0 && currScope.parent == nil {
+		return nil, ErrGlobalArr
+	}
+	for k, v := range idents {
+		renamedVar := RenameVariable(v)
+		insertTyped(v, typ, renamedVar)
+		if currScope.parent == nil {
+			// A global array resides in the data section, where it
+			// is initialized to the zero values of its words.
+			decl := fmt.Sprintf("%s, %s, %d", tac.DECL, renamedVar, sizeOf(typ))
+			if holdsStrings(typ) {
+				zeros, code := zeroWords(typ)
+				decl += ", " + strings.Join(zeros, ", ")
+				n.Code = append(n.Code, code...)
+			}
+			n.Code = append(n.Code, decl)
+			continue
+		}
+		if len(expr) == 0 {
+			n.Code = append(n.Code, declareArray(renamedVar, typ)...)
+			continue
+		}
+		if err := checkArray(renamedVar, expr[k]); err != nil {
+			return nil, err
+		}
+		code, _ := assignArray(renamedVar, expr[k])
+		n.Code = append(n.Code, fmt.Sprintf("%s, %s, %d", tac.DECL, renamedVar, sizeOf(typ)))
+		n.Code = append(n.Code, code...)
+	}
+	return n, nil
+}
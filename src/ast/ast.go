@@ -5,6 +5,7 @@ package ast
 
 import (
 	"fmt"
+	"math/big"
 	"regexp"
 	"strconv"
 	"strings"
@@ -13,17 +14,12 @@ import (
 	"github.com/shivansh/gogo/src/utils"
 )
 
-// DeferStackItem is an individual item stored when a call to defer is made. It
-// contains the code for the corresponding function call which is placed at the
-// end of the function body.
-type DeferStackItem []string
-
 var (
-	// deferStack stores the deferred function calls which are then called
-	// when the surrounding function block ends.
-	deferStack *utils.Stack
-	re         *regexp.Regexp
-	PkgName    string
+	re      *regexp.Regexp
+	PkgName string
+	// NoChecks disables the runtime checks of the indices, the pointers
+	// and the divisors, which panic when they fail.
+	NoChecks bool
 )
 
 func init() {
@@ -35,7 +31,6 @@ func init() {
 	// be reflected globally.
 	// TODO: Update type of currScope.symTab to a pointer.
 	currScope = &SymInfo{make(symTabType), nil}
-	deferStack = utils.CreateStack()
 	re = regexp.MustCompile("(^-?[0-9]+$)") // integers
 }
 
@@ -60,24 +55,40 @@ func InitNode(place string, code []string) (*Node, error) {
 // NewPkgDecl initializes package relevant data structures.
 func NewPkgDecl(pkgName []byte) (*Node, error) {
 	PkgName = string(pkgName)
+	if PkgName == "runtime" {
+		qualifier = "runtime."
+	}
+	// The imports are scoped to a file.
+	imports = make(map[string]string)
+	usedImports = make(map[string]bool)
 	return nil, nil
 }
 
 // --- [ Top level declarations ] ----------------------------------------------
 
-// NewTopLevelDecl returns a top level declaration.
+// NewTopLevelDecl returns a top level declaration followed by the remaining
+// ones, which are nil for the last declaration.
 func NewTopLevelDecl(topDecl, repeatTopDecl *Node) (*Node, error) {
-	return &Node{"", append(topDecl.Code, repeatTopDecl.Code...)}, nil
+	// The declarations are reduced only after all of them have been parsed,
+	// hence the globals whose addresses are taken are known by now.
+	code := boxGlobals(topDecl.Code)
+	if repeatTopDecl != nil {
+		code = append(code, repeatTopDecl.Code...)
+	}
+	return &Node{"", code}, nil
 }
 
-// NewTypeDef returns a type definition.
+// NewTypeDef returns a type definition. The place attribute of the definition
+// of a type other than a struct holds the name of the type, and its code holds
+// the type from which it is defined.
 func NewTypeDef(ident string, typ AstNode) (AstNode, error) {
+	ident = qualifier + ident
 	switch typ.(type) {
 	case *StructType:
 		node := Node{typ.place(), typ.code()}
 		return &StructType{node, ident, 0}, nil
 	}
-	return &Node{fmt.Sprintf("%s, %s", typ.place(), ident), typ.code()}, nil
+	return &Node{ident, []string{typ.place()}}, nil
 }
 
 // NewElementList returns a keyed element list.
@@ -105,16 +116,10 @@ func NewVarSpec(typ int, args ...*Node) (*Node, error) {
 	n := &Node{"", []string{}}
 	expr := []string{}
 	var vartype, exprtype symkind
-
-	// Evaluate the type of identifier from the declaration.
-	switch args[1].Place {
-	case INT:
-		vartype = INTEGER
-	case STR:
-		vartype = STRING
-	default:
-		return nil, fmt.Errorf("unsupported type: %s", args[1].Place)
-	}
+	// initCode contains the code initializing the package-level variables
+	// whose initial values are not constants, which is run at the start of
+	// main.
+	initCode := []string{}
 
 	// Add the IR instructions for ExpressionList.
 	switch typ {
@@ -122,87 +127,192 @@ func NewVarSpec(typ int, args ...*Node) (*Node, error) {
 		n.Code = args[2].Code
 		expr = utils.SplitAndSanitize(args[2].Place, ",")
 	case 2:
-		// Infer type of identifier from the expression.
-		if re.MatchString(args[1].Place) {
-			vartype = INTEGER
-		} else {
-			vartype = STRING
-		}
 		n.Code = args[1].Code
 		expr = utils.SplitAndSanitize(args[1].Place, ",")
 	}
+	// A function declared at top level is assigned as a function value.
+	expr, code, err := funcValues(expr)
+	if err != nil {
+		return nil, err
+	}
+	n.Code = append(n.Code, code...)
 
-	for k, v := range args[0].Code {
-		renamedVar := RenameVariable(v)
-		InsertSymbol(v, vartype, renamedVar)
+	if typ != 2 && isStructType(args[1].Place) {
+		return structSpec(n, args[0].Code, args[1].Place, expr)
+	}
+	if typ != 2 && isArrayType(args[1].Place) {
+		return arraySpec(n, args[0].Code, args[1].Place, expr)
+	}
+	if typ == 2 && len(expr) > 0 {
+		if arrType, ok := arrayType(expr[0]); ok {
+			return arraySpec(n, args[0].Code, arrType, expr)
+		}
+	}
+	if typ != 2 {
+		// Evaluate the type of identifier from the declaration.
+		if vartype = GetKind(args[1].Place); vartype == NIL {
+			return nil, fmt.Errorf("unsupported type: %s", args[1].Place)
+		}
+	}
 
-		// Evaluate type of the expression
-		if typ == 1 {
-			if symEntry, found := Lookup(RealName(expr[k])); found {
-				switch symEntry.kind {
-				case ARRAYINT:
-					exprtype = INTEGER
-				case ARRAYSTR:
-					exprtype = STRING
-				default:
-					exprtype = symEntry.kind
-				}
-			} else if re.MatchString(expr[k]) {
+	exprLen := len(n.Code)
+	for k, v := range args[0].Code {
+		start := len(n.Code)
+		if typ == 2 && isStruct(expr[k]) {
+			if currScope.parent == nil {
+				return nil, ErrGlobalStrct
+			}
+			n.Code = append(n.Code, copyStruct(v, expr[k])...)
+			continue
+		}
+		if typ != 0 {
+			// Evaluate type of the expression. An expression whose
+			// type cannot be determined is taken to be an integer.
+			if exprtype = KindOf(expr[k]); exprtype == NIL {
 				exprtype = INTEGER
-			} else if strings.HasPrefix(expr[k], ARR) {
-				switch GetPrefix(expr[k]) {
-				case ARRINT:
-					exprtype = INTEGER
-				case ARRSTR:
-					exprtype = STRING
-				default:
-					panic("type not supported by arrays")
+			}
+		}
+		if typ == 1 && isInteger(vartype) && GetPrefix(expr[k]) == FLT {
+			// An integral floating-point constant can be assigned to
+			// an integer.
+			var err error
+			if expr[k], err = intConst(expr[k]); err != nil {
+				return nil, err
+			}
+		}
+		if typ == 1 && isInteger(vartype) && re.MatchString(expr[k]) {
+			// An integer constant takes the type of the variable.
+			if err := representable(expr[k], vartype); err != nil {
+				return nil, err
+			}
+			exprtype = vartype
+		}
+		if typ == 2 {
+			// Infer type of identifier from the expression, where an
+			// untyped constant is of the default type int.
+			if err := representable(expr[k], INTEGER); err != nil {
+				return nil, err
+			}
+			vartype = exprtype
+		}
+
+		renamedVar := RenameVariable(v)
+		if typ == 2 {
+			if symEntry, ok := sliceEntry(expr[k]); ok {
+				InsertSymbol(v, vartype, renamedVar, symEntry.symbols[1])
+			} else if symEntry, ok := mapEntry(expr[k]); ok {
+				InsertSymbol(v, vartype, renamedVar, symEntry.symbols[1:])
+			} else if symEntry, ok := chanEntry(expr[k]); ok {
+				InsertSymbol(v, vartype, renamedVar, symEntry.symbols[1])
+			} else if symEntry, ok := funcEntry(expr[k]); ok {
+				InsertSymbol(v, vartype, renamedVar, symEntry.symbols[1])
+			} else if ptr, ok := ptrType(expr[k]); ok {
+				if isNil(expr[k]) {
+					return nil, ErrUntypedNil
 				}
+				insertTyped(v, ptr, renamedVar)
 			} else {
-				exprtype = STRING
+				InsertSymbol(v, vartype, renamedVar)
 			}
 		} else {
-			exprtype = STRING
+			insertTyped(v, args[1].Place, renamedVar)
+		}
+		if typ == 1 {
+			if err := checkNamed(renamedVar, expr[k]); err != nil {
+				return nil, err
+			}
+		} else if typ == 2 {
+			if named, ok := namedTypes[expr[k]]; ok {
+				namedTypes[renamedVar] = named
+			}
+		}
+		if vartype == FUNCVAL && typ != 0 && currScope.parent == nil {
+			return nil, ErrGlobalFunc
+		}
+		if vartype == POINTER && typ != 0 && currScope.parent == nil {
+			return nil, ErrGlobalPtr
+		}
+		if vartype == CHANNEL && typ != 0 && currScope.parent == nil {
+			return nil, ErrGlobalChan
 		}
 
 		if typ == 0 {
 			// Initialize identifiers to their default values
 			// depending on type information.
+			n.Code = append(n.Code, zeroValue(vartype, renamedVar)...)
+		} else if isFloat(vartype) {
+			code, err := assignFloat(renamedVar, vartype, expr[k])
+			if err != nil {
+				return nil, err
+			}
+			n.Code = append(n.Code, code)
+		} else if vartype == exprtype {
+			if vartype == FUNCVAL {
+				if err := checkFuncAssign(v, expr[k]); err != nil {
+					return nil, err
+				}
+			}
 			switch vartype {
-			case INTEGER:
-				n.Code = append(n.Code, fmt.Sprintf("declInt, %s, 0", renamedVar))
+			case INTEGER, BYTE, RUNE, BOOLEAN, SLICE, MAP, FUNCVAL, POINTER, CHANNEL:
+				n.Code = append(n.Code, fmt.Sprintf("declInt, %s, %s", renamedVar, StripPrefix(expr[k])))
 			case STRING:
-				n.Code = append(n.Code, fmt.Sprintf("declStr, %s, \"\"", renamedVar))
-			}
-		} else if typ == 1 || typ == 2 {
-			if vartype == exprtype {
-				switch vartype {
-				case INTEGER:
-					n.Code = append(n.Code, fmt.Sprintf("declInt, %s, %s", renamedVar, StripPrefix(expr[k])))
-				case STRING:
+				if GetPrefix(expr[k]) == STR {
 					n.Code = append(n.Code, fmt.Sprintf("declStr, %s, %s", renamedVar, StripPrefix(expr[k])))
+				} else {
+					// A string value is the address of its contents.
+					n.Code = append(n.Code, fmt.Sprintf("declInt, %s, %s", renamedVar, expr[k]))
 				}
+			}
+		} else {
+			return nil, fmt.Errorf("cannot use %s (type %s) as type %s in assignment",
+				exprName(expr[k]), typeOf(expr[k]), GetType(vartype))
+		}
+		if typ != 0 && currScope.parent == nil && (vartype == SLICE || vartype == MAP || !isConst(expr[k])) {
+			// The code outside of functions is not run, hence the
+			// variable is initialized at the start of main, and holds
+			// its zero value (nil for a slice) until then.
+			initCode = append(initCode, n.Code[start:]...)
+			n.Code = n.Code[:start]
+			if vartype == SLICE {
+				n.Code = append(n.Code, fmt.Sprintf("declInt, %s, 0", renamedVar))
 			} else {
-				exprName := RealName(StripPrefix(expr[k]))
-				return nil, fmt.Errorf("cannot use %s (type %s) as type %s in assignment",
-					exprName, GetType(exprtype), GetType(vartype))
+				n.Code = append(n.Code, zeroValue(vartype, renamedVar)...)
 			}
 		}
 	}
 
+	if len(initCode) > 0 {
+		// The code evaluating the initial values is run along with the
+		// initialization of the variables.
+		pkgInits = append(append(pkgInits, n.Code[:exprLen]...), initCode...)
+		n.Code = n.Code[exprLen:]
+	}
 	return n, nil
 }
 
+// AppendVarSpec appends a variable specification to a list of variable
+// specifications.
+func AppendVarSpec(spec, specList *Node) (*Node, error) {
+	return &Node{"", append(spec.Code, specList.Code...)}, nil
+}
+
 // --- [ Type declarations ] ---------------------------------------------------
 
 // NewTypeDecl returns a type declaration.
 func NewTypeDecl(typespec AstNode) (*Node, error) {
 	switch t := typespec.(type) {
 	case *StructType:
+		if _, found := globalSymTab[t.Name]; found {
+			return nil, ErrRedeclared(t.Name)
+		}
 		globalSymTab[t.Name] = SymTabEntry{
 			kind:    STRUCT,
 			symbols: t.code(),
 		}
+	case *Node:
+		if err := declareNamed(t.Place, t.Code[0]); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, fmt.Errorf("unknown type %v", t)
 	}
@@ -210,30 +320,6 @@ func NewTypeDecl(typespec AstNode) (*Node, error) {
 	return &Node{"", []string{}}, nil
 }
 
-// --- [ Constant declarations ] -----------------------------------------------
-
-// NewConstSpec returns a constant declaration.
-func NewConstSpec(typ int, args ...*Node) (*Node, error) {
-	n := &Node{"", []string{}}
-	expr := []string{}
-	if typ == 1 {
-		n.Code = append(n.Code, args[1].Code...)
-		expr = utils.SplitAndSanitize(args[1].Place, ",")
-	}
-	for k, v := range args[0].Code {
-		renamedVar := RenameVariable(v)
-		// TODO: Add remaining types.
-		InsertSymbol(v, INTEGER, renamedVar)
-		switch typ {
-		case 0:
-			n.Code = append(n.Code, fmt.Sprintf("declInt, %s, 0", renamedVar))
-		case 1:
-			n.Code = append(n.Code, fmt.Sprintf("declInt, %s, %s", renamedVar, expr[k]))
-		}
-	}
-	return n, nil
-}
-
 // --- [ Expressions ] ---------------------------------------------------------
 
 // AppendExpr appends a list of expressions to a given expression.
@@ -243,43 +329,81 @@ func AppendExpr(expr, exprlist *Node) (*Node, error) {
 }
 
 // NewBoolExpr returns a new logical expression.
+// The logical operators are short-circuited, i.e. the right operand is
+// evaluated only when the value of the left operand does not already determine
+// the value of the expression.
 func NewBoolExpr(op string, leftexpr, rightexpr *Node) (*Node, error) {
-	n := &Node{"", append(leftexpr.Code, rightexpr.Code...)}
-	n.Place = NewTmp()
+	for _, expr := range []*Node{leftexpr, rightexpr} {
+		if kind := KindOf(expr.Place); kind != BOOLEAN && kind != NIL {
+			return nil, ErrOperator(op, exprName(expr.Place), GetType(kind))
+		}
+	}
+	n := &Node{NewTmp(), leftexpr.Code}
+	InsertSymbol(n.Place, BOOLEAN, n.Place)
 	afterLabel := NewLabel()
-	switch op {
-	case OR:
-		trueLabel := NewLabel()
-		n.Code = utils.AppendCode(
-			n.Code,
-			fmt.Sprintf("beq, %s, %s, 1", trueLabel, leftexpr.Place),
-			fmt.Sprintf("beq, %s, %s, 1", trueLabel, rightexpr.Place),
-			fmt.Sprintf("=, %s, 0", n.Place),
-			fmt.Sprintf("%s, %s", tac.JMP, afterLabel),
-			fmt.Sprintf("label, %s", trueLabel),
-			fmt.Sprintf("=, %s, 1", n.Place),
-			fmt.Sprintf("label, %s", afterLabel),
-		)
-	case AND:
-		falseLabel := NewLabel()
-		n.Code = utils.AppendCode(
-			n.Code,
-			fmt.Sprintf("beq, %s, %s, 0", falseLabel, leftexpr.Place),
-			fmt.Sprintf("beq, %s, %s, 0", falseLabel, rightexpr.Place),
-			fmt.Sprintf("=, %s, 1", n.Place),
-			fmt.Sprintf("%s, %s", tac.JMP, afterLabel),
-			fmt.Sprintf("label, %s", falseLabel),
-			fmt.Sprintf("=, %s, 0", n.Place),
-			fmt.Sprintf("label, %s", afterLabel),
-		)
+	// shortLabel is the label jumped to when the value of the expression is
+	// determined by any one of the operands, which is true for || and false
+	// for &&.
+	shortLabel := NewLabel()
+	shortVal := 0
+	if op == OR {
+		shortVal = 1
 	}
-	return n, nil
+	n.Code = append(n.Code, fmt.Sprintf("beq, %s, %s, %d", shortLabel, leftexpr.Place, shortVal))
+	n.Code = append(n.Code, rightexpr.Code...)
+	n.Code = utils.AppendCode(
+		n.Code,
+		fmt.Sprintf("beq, %s, %s, %d", shortLabel, rightexpr.Place, shortVal),
+		fmt.Sprintf("=, %s, %d", n.Place, 1-shortVal),
+		fmt.Sprintf("%s, %s", tac.JMP, afterLabel),
+		fmt.Sprintf("label, %s", shortLabel),
+		fmt.Sprintf("=, %s, %d", n.Place, shortVal),
+		fmt.Sprintf("label, %s", afterLabel),
+	)
+	return nameExpr(n, nil, "%s %s %s", exprName(leftexpr.Place), op, exprName(rightexpr.Place))
 }
 
 // NewRelExpr returns a new relational expression.
 func NewRelExpr(op, leftexpr, rightexpr *Node) (*Node, error) {
+	n, err := relExpr(op, leftexpr, rightexpr)
+	return nameExpr(n, err, "%s %s %s", exprName(leftexpr.Place), op.Place, exprName(rightexpr.Place))
+}
+
+// relExpr returns a relational expression.
+func relExpr(op, leftexpr, rightexpr *Node) (*Node, error) {
+	if _, err := namedOperands(op.Place, leftexpr.Place, rightexpr.Place); err != nil {
+		return nil, err
+	}
+	if isFloatExpr(leftexpr.Place, rightexpr.Place) {
+		return newFloatRel(op, leftexpr, rightexpr)
+	}
+	if isStringExpr(leftexpr.Place, rightexpr.Place) {
+		return newStrRel(op, leftexpr, rightexpr)
+	}
+	if n, ok, err := nilRel(op, leftexpr, rightexpr); ok {
+		return n, err
+	}
+	if isCompositeExpr(leftexpr.Place, rightexpr.Place) {
+		return compositeRel(op, leftexpr, rightexpr)
+	}
+	if isIfaceExpr(leftexpr.Place, rightexpr.Place) {
+		if err := ifaceOperands(op.Place, leftexpr.Place, rightexpr.Place); err != nil {
+			return nil, err
+		}
+	} else if isPtrExpr(leftexpr.Place, rightexpr.Place) {
+		if err := ptrOperands(op.Place, leftexpr.Place, rightexpr.Place); err != nil {
+			return nil, err
+		}
+	} else if isWordExpr(leftexpr.Place, rightexpr.Place) {
+		if err := wordOperands(op.Place, leftexpr.Place, rightexpr.Place); err != nil {
+			return nil, err
+		}
+	} else if _, err := intKind(op.Place, leftexpr.Place, rightexpr.Place); err != nil {
+		return nil, err
+	}
 	n := &Node{"", append(leftexpr.Code, rightexpr.Code...)}
 	n.Place = NewTmp()
+	InsertSymbol(n.Place, BOOLEAN, n.Place)
 	branchOp := ""
 	falseLabel := NewLabel()
 	afterLabel := NewLabel()
@@ -297,9 +421,24 @@ func NewRelExpr(op, leftexpr, rightexpr *Node) (*Node, error) {
 	case GT:
 		branchOp = "ble"
 	}
+	branch, err := branchCode(branchOp, falseLabel, leftexpr.Place, rightexpr.Place)
+	if err != nil {
+		return nil, err
+	}
+	if re.MatchString(leftexpr.Place) && re.MatchString(rightexpr.Place) {
+		// --- [ Constant folding optimization ] -----------------------
+		// A comparison of integer constants is a boolean constant,
+		// which is false when the branch to the false label is taken.
+		val := 1
+		if len(branch) > 0 {
+			val = 0
+		}
+		n.Code = append(n.Code, fmt.Sprintf("=, %s, %d", n.Place, val))
+		return n, nil
+	}
 	n.Code = utils.AppendCode(
 		n.Code,
-		fmt.Sprintf("%s, %s, %s, %s", branchOp, falseLabel, leftexpr.Place, rightexpr.Place),
+		branch,
 		fmt.Sprintf("=, %s, 1", n.Place),
 		fmt.Sprintf("%s, %s", tac.JMP, afterLabel),
 		fmt.Sprintf("label, %s", falseLabel),
@@ -309,51 +448,246 @@ func NewRelExpr(op, leftexpr, rightexpr *Node) (*Node, error) {
 	return n, nil
 }
 
+// isWordExpr determines whether any of the places holds a boolean or a
+// channel, which are compared as words.
+func isWordExpr(places ...string) bool {
+	for _, v := range places {
+		if kind := KindOf(v); kind == BOOLEAN || kind == CHANNEL {
+			return true
+		}
+	}
+	return false
+}
+
+// wordOperands verifies the operands of a comparison involving booleans or
+// channels, which can only be compared for equality with values of the same
+// type.
+func wordOperands(op, left, right string) error {
+	if op != EQ && op != NEQ {
+		if !isWordExpr(left) {
+			left = right
+		}
+		return ErrOperator(op, exprName(left), typeOf(left))
+	}
+	if typeOf(left) != typeOf(right) {
+		return fmt.Errorf("invalid operation: %s %s %s (mismatched types %s and %s)",
+			exprName(left), op, exprName(right), typeOf(left), typeOf(right))
+	}
+	return nil
+}
+
 // NewArithExpr returns an arithmetic expression.
 func NewArithExpr(op string, leftexpr, rightexpr *Node) (*Node, error) {
+	if isIotaExpr(leftexpr.Place, rightexpr.Place) {
+		return iotaExpr(func(val int) (*Node, error) {
+			left, err := evalIota(leftexpr, val)
+			if err != nil {
+				return nil, err
+			}
+			right, err := evalIota(rightexpr, val)
+			if err != nil {
+				return nil, err
+			}
+			return NewArithExpr(op, left, right)
+		}), nil
+	}
+	named, err := namedOperands(op, leftexpr.Place, rightexpr.Place)
+	if err != nil {
+		return nil, err
+	}
+	n, err := arithExpr(op, leftexpr, rightexpr)
+	if err == nil && named != "" && !isConst(n.Place) {
+		namedTypes[n.Place] = named
+	}
+	return nameExpr(n, err, "%s %s %s", exprName(leftexpr.Place), op, exprName(rightexpr.Place))
+}
+
+// arithExpr returns an arithmetic expression on operands of the same type.
+func arithExpr(op string, leftexpr, rightexpr *Node) (*Node, error) {
+	if err := ptrOperator(op, leftexpr.Place, rightexpr.Place); err != nil {
+		return nil, err
+	}
+	if isFloatExpr(leftexpr.Place, rightexpr.Place) {
+		return newFloatArith(op, leftexpr, rightexpr)
+	}
+	if isStringExpr(leftexpr.Place, rightexpr.Place) {
+		return newStrArith(op, leftexpr, rightexpr)
+	}
 	n := &Node{"", append(leftexpr.Code, rightexpr.Code...)}
 	if re.MatchString(leftexpr.Place) && re.MatchString(rightexpr.Place) {
 		// --- [ Constant folding optimization ] -----------------------
 		// Expression is of the form "1 op 2". Such expression can
 		// be reduced by evaluating its value during compilation itself.
-		leftval, err := strconv.Atoi(leftexpr.Place)
+		leftval, err := strconv.ParseInt(leftexpr.Place, 10, 64)
 		if err != nil {
 			return nil, err
 		}
-		rightval, err := strconv.Atoi(rightexpr.Place)
+		rightval, err := strconv.ParseInt(rightexpr.Place, 10, 64)
 		if err != nil {
 			return nil, err
 		}
-		switch op {
-		case ADD:
-			n.Place = strconv.Itoa(leftval + rightval)
-		case SUB:
-			n.Place = strconv.Itoa(leftval - rightval)
-		case AST:
-			n.Place = strconv.Itoa(leftval * rightval)
-		case DIV:
-			n.Place = strconv.Itoa(leftval / rightval)
-		case REM:
-			n.Place = strconv.Itoa(leftval % rightval)
-		default:
-			return nil, fmt.Errorf("Invalid operation %s", op)
+		val, err := foldConst(op, leftval, rightval)
+		if err != nil {
+			return nil, err
 		}
-	} else if re.MatchString(leftexpr.Place) {
-		// Expression is of the form "1 + b", which needs to be
-		// converted to the equivalent form "b + 1" to be counted as a
-		// valid IR statement.
-		n.Place = NewTmp()
-		n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, %s", op, n.Place, rightexpr.Place, leftexpr.Place))
+		n.Place = strconv.FormatInt(val, 10)
 	} else {
+		kind, err := intKind(op, leftexpr.Place, rightexpr.Place)
+		if err != nil {
+			return nil, err
+		}
 		n.Place = NewTmp()
-		n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, %s", op, n.Place, leftexpr.Place, rightexpr.Place))
+		InsertSymbol(n.Place, kind, n.Place)
+		code, err := binaryOpCode(op, n.Place, leftexpr.Place, rightexpr.Place)
+		if err != nil {
+			return nil, err
+		}
+		n.Code = append(n.Code, code...)
+		n.Code = append(n.Code, truncate(n.Place, kind)...)
 	}
 	return n, nil
 }
 
+// foldConst evaluates a binary operation on untyped integer constants. The
+// operations are carried out at arbitrary precision, and a result which cannot
+// be represented by a 64-bit integer is reported as an overflow. Whether the
+// value of a constant can be represented by its type is verified once it is
+// given one (see representable).
+func foldConst(op string, leftval, rightval int64) (int64, error) {
+	left, right := big.NewInt(leftval), big.NewInt(rightval)
+	val := new(big.Int)
+	switch op {
+	case ADD:
+		val.Add(left, right)
+	case SUB:
+		val.Sub(left, right)
+	case AST:
+		val.Mul(left, right)
+	case DIV, REM:
+		if rightval == 0 {
+			return 0, ErrDivByZero
+		}
+		if op == DIV {
+			val.Quo(left, right)
+		} else {
+			val.Rem(left, right)
+		}
+	case BOR:
+		val.Or(left, right)
+	case XOR:
+		val.Xor(left, right)
+	case AMP:
+		val.And(left, right)
+	case ANDNOT:
+		val.AndNot(left, right)
+	case LSH, RSH:
+		if rightval < 0 {
+			return 0, ErrNegShift(rightval)
+		}
+		if op == RSH {
+			return leftval >> uint64(rightval), nil
+		}
+		if rightval >= 64 && leftval != 0 {
+			return 0, ErrOverflow(fmt.Sprintf("%d << %d", leftval, rightval))
+		}
+		val.Lsh(left, uint(rightval))
+	default:
+		return 0, fmt.Errorf("Invalid operation %s", op)
+	}
+	if !val.IsInt64() {
+		return 0, ErrOverflow(val.String())
+	}
+	return val.Int64(), nil
+}
+
+// binaryOpCode returns the IR statements which evaluate "left op right" into
+// dst, where at most one of the operands is an integer constant.
+func binaryOpCode(op, dst, left, right string) ([]string, error) {
+	code := []string{}
+	if op == ANDNOT {
+		// "x &^ y" is evaluated as "x & (y ^ -1)".
+		if re.MatchString(right) {
+			val, err := strconv.Atoi(right)
+			if err != nil {
+				return nil, err
+			}
+			right = strconv.Itoa(^val)
+		} else {
+			t := NewTmp()
+			code = append(code, fmt.Sprintf("%s, %s, %s, -1", tac.XOR, t, right))
+			right = t
+		}
+		op = AMP
+	}
+	if re.MatchString(left) {
+		switch op {
+		case ADD, AST, BOR, XOR, AMP:
+			// Expression is of the form "1 + b", which needs to be
+			// converted to the equivalent form "b + 1" to be counted
+			// as a valid IR statement.
+			left, right = right, left
+		default:
+			// The operator is not commutative, hence the constant
+			// is first moved into a temporary.
+			t := NewTmp()
+			code = append(code, fmt.Sprintf("=, %s, %s", t, left))
+			left = t
+		}
+	} else if right == "0" && (op == DIV || op == REM) {
+		return nil, ErrDivByZero
+	}
+	switch op {
+	case BOR:
+		op = tac.OR
+	case XOR:
+		op = tac.XOR
+	case AMP:
+		op = tac.AND
+	case ADD, SUB, AST, DIV, REM, LSH, RSH:
+	default:
+		return nil, fmt.Errorf("Invalid operation %s", op)
+	}
+	code = append(code, fmt.Sprintf("%s, %s, %s, %s", op, dst, left, right))
+	return code, nil
+}
+
 // NewUnaryExpr returns a unary expression.
 func NewUnaryExpr(op, expr *Node) (*Node, error) {
+	if isIotaExpr(expr.Place) {
+		return iotaExpr(func(val int) (*Node, error) {
+			expr, err := evalIota(expr, val)
+			if err != nil {
+				return nil, err
+			}
+			return NewUnaryExpr(op, expr)
+		}), nil
+	}
+	n, err := unaryExpr(op, expr)
+	if named, ok := namedTypes[expr.Place]; ok && err == nil && (op.Place == SUB || op.Place == XOR) {
+		namedTypes[n.Place] = named
+	}
+	return nameExpr(n, err, "%s%s", op.Place, exprName(expr.Place))
+}
+
+// unaryExpr returns a unary expression.
+func unaryExpr(op, expr *Node) (*Node, error) {
+	if op.Place == ARROW {
+		return newRecv(expr)
+	}
 	n := &Node{"", expr.Code}
+	if op.Place != AMP && op.Place != AST {
+		if err := ptrOperator(op.Place, expr.Place); err != nil {
+			return nil, err
+		}
+	}
+	if isFloatExpr(expr.Place) {
+		switch op.Place {
+		case SUB:
+			return newFloatNeg(expr)
+		case XOR:
+			return nil, ErrOperator(op.Place, exprName(expr.Place), GetType(KindOf(expr.Place)))
+		}
+	}
 	switch op.Place {
 	case SUB:
 		if re.MatchString(expr.Place) {
@@ -364,20 +698,65 @@ func NewUnaryExpr(op, expr *Node) (*Node, error) {
 			}
 			n.Place = strconv.Itoa(term3val * -1)
 		} else {
+			// The negation has the type of its operand.
+			kind := KindOf(expr.Place)
+			if !isInteger(kind) {
+				if kind != NIL {
+					return nil, ErrOperator(op.Place, exprName(expr.Place), GetType(kind))
+				}
+				kind = INTEGER
+			}
 			n.Place = NewTmp()
+			InsertSymbol(n.Place, kind, n.Place)
+			setNamed(n.Place, namedTypes[expr.Place])
 			n.Code = append(n.Code, fmt.Sprintf("*, %s, %s, -1", n.Place, expr.Place))
+			n.Code = append(n.Code, truncate(n.Place, kind)...)
+		}
+	case XOR:
+		// The bitwise complement of x is evaluated as "x ^ -1".
+		if re.MatchString(expr.Place) {
+			val, err := strconv.Atoi(expr.Place)
+			if err != nil {
+				return nil, err
+			}
+			n.Place = strconv.Itoa(^val)
+		} else {
+			kind := KindOf(expr.Place)
+			if !isInteger(kind) {
+				kind = INTEGER
+			}
+			n.Place = NewTmp()
+			InsertSymbol(n.Place, kind, n.Place)
+			n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, -1", tac.XOR, n.Place, expr.Place))
+			n.Code = append(n.Code, truncate(n.Place, kind)...)
 		}
 	case NOT:
+		if kind := KindOf(expr.Place); kind != BOOLEAN && kind != NIL {
+			return nil, ErrOperator(op.Place, exprName(expr.Place), GetType(kind))
+		}
+		// Booleans are represented as 0 or 1, hence the logical negation
+		// is evaluated by flipping the least significant bit.
 		n.Place = NewTmp()
-		n.Code = append(n.Code, fmt.Sprintf("not, %s, %s", n.Place, expr.Place))
+		InsertSymbol(n.Place, BOOLEAN, n.Place)
+		n.Code = append(n.Code, fmt.Sprintf("xor, %s, %s, 1", n.Place, expr.Place))
 	case ADD:
 		n.Place = expr.Place
 	case AMP:
-		// Place attribute of a pointer variable starts with "pointer:"
-		// followed by its place attribute.
-		n.Place = PTR + ":" + expr.Place
+		return addressOf(expr)
 	case AST:
-		n.Place = DRF + ":" + expr.Place
+		typ, ok := ptrType(expr.Place)
+		if !ok || isNil(expr.Place) {
+			return nil, ErrIndirection(exprName(expr.Place), typeOf(expr.Place))
+		}
+		if elem := StripPrefix(typ); isArrayType(elem) {
+			return derefArray(expr, elem), nil
+		}
+		val, err := deref(expr.Place, 0, StripPrefix(typ))
+		if err != nil {
+			return nil, err
+		}
+		n.Place = val.Place
+		n.Code = append(n.Code, val.Code...)
 	default:
 		return nil, fmt.Errorf("%s operator not supported", op.Place)
 	}
@@ -386,6 +765,29 @@ func NewUnaryExpr(op, expr *Node) (*Node, error) {
 
 // NewPrimaryExprSel returns an AST node for PrimaryExpr Selector.
 func NewPrimaryExprSel(expr, selector *Node) (*Node, error) {
+	n, err := primaryExprSel(expr, selector)
+	text := fmt.Sprintf("%s.%s", exprName(expr.Place), selector.Place)
+	// The field of a struct variable is held by a variable of its own.
+	if symEntry, found := Lookup(fmt.Sprintf("%s.%s", expr.Place, selector.Place)); err == nil && found &&
+		symEntry.symbols[0] == n.Place {
+		exprTexts[n.Place] = text
+	}
+	return nameExpr(n, err, "%s", text)
+}
+
+// primaryExprSel returns the field or the method selected from a value.
+func primaryExprSel(expr, selector *Node) (*Node, error) {
+	if typ, ok := structOf(expr.Place); ok && importedNames[typ] && !isExported(selector.Place) {
+		return nil, fmt.Errorf("%s.%s undefined (cannot refer to unexported field or method %s)",
+			RealName(expr.Place), selector.Place, selector.Place)
+	}
+	if n, ok := methodRef(expr, selector); ok {
+		return n, nil
+	}
+	// A pointer to a struct is dereferenced automatically.
+	if typ, ok := ptrType(expr.Place); ok && isStructType(StripPrefix(typ)) {
+		return selectField(expr, StripPrefix(typ), selector.Place)
+	}
 	// The key for a selector's symbol table entry is of the form -
 	//	(exprPlace).(selectorPlace)
 	varName := fmt.Sprintf("%s.%s", expr.Place, selector.Place)
@@ -394,7 +796,7 @@ func NewPrimaryExprSel(expr, selector *Node) (*Node, error) {
 			// TODO verify if this is correct.
 			return nil, ErrUndefined(varName)
 		} else {
-			return &Node{symEntry.symbols[0], []string{}}, nil
+			return &Node{symEntry.symbols[0], expr.Code}, nil
 		}
 	} else {
 		return nil, ErrUndefined(varName)
@@ -403,57 +805,145 @@ func NewPrimaryExprSel(expr, selector *Node) (*Node, error) {
 
 // NewPrimaryExprIndex returns an AST node for PrimaryExpr Index.
 func NewPrimaryExprIndex(expr, index *Node) (*Node, error) {
+	n, err := primaryExprIndex(expr, index)
+	return nameExpr(n, err, "%s[%s]", exprName(expr.Place), exprName(index.Place))
+}
+
+// primaryExprIndex returns the element of a value at the given index.
+func primaryExprIndex(expr, index *Node) (*Node, error) {
 	n := &Node{"", []string{}}
 	n.Place = NewTmp()
-	// NOTE: Indexing is only supported by array types and not pointer types.
+	errIndex := fmt.Errorf("invalid operation: %s[%s] (type %s does not support indexing)",
+		exprName(expr.Place), exprName(index.Place), typeOf(expr.Place))
 
 	var exprtype symkind
-	if symEntry, found := Lookup(RealName(expr.Place)); found {
+	if GetPrefix(expr.Place) == STR {
+		return indexString(n, expr, index)
+	}
+	// A slice (map) may also be loaded from memory, e.g. as an element.
+	if symEntry, ok := sliceEntry(expr.Place); ok {
+		return indexSlice(symEntry.symbols[1], expr, index)
+	}
+	if symEntry, ok := mapEntry(expr.Place); ok {
+		return indexMap(n, symEntry, expr, index)
+	}
+	if symEntry, found := lookupPlace(expr.Place); found {
 		switch symEntry.kind {
 		case INTEGER:
+			if _, ok := arrayLen(expr.Place); !ok {
+				return nil, errIndex
+			}
 			exprtype = ARRAYINT
 		case STRING:
+			if _, ok := arrayLen(expr.Place); !ok {
+				return indexString(n, expr, index)
+			}
 			exprtype = ARRAYSTR
+		case ARRAY:
+			return indexArray(symEntry, expr, index)
+		case POINTER:
+			// A pointer to an array is dereferenced automatically.
+			if elem := symEntry.symbols[1]; isArrayType(elem) {
+				return NewPrimaryExprIndex(derefArray(expr, elem), index)
+			}
+			return nil, errIndex
 		default:
-			panic("NewPrimaryExprIndex: indexing not supported on type")
+			return nil, errIndex
 		}
 	} else {
 		return nil, ErrUndefined(RealName(expr.Place))
 	}
 	InsertSymbol(n.Place, exprtype, expr.Place, index.Place)
+	setNamed(n.Place, namedTypes[expr.Place])
 
+	n.Code = append(n.Code, expr.Code...)
 	n.Code = append(n.Code, index.Code...)
+	if length, ok := arrayLen(expr.Place); ok {
+		check, err := indexCheck(index.Place, length)
+		if err != nil {
+			return nil, err
+		}
+		n.Code = append(n.Code, check...)
+	}
 	n.Code = append(n.Code, fmt.Sprintf("from, %s, %s, %s", n.Place, expr.Place, index.Place))
 	return n, nil
 }
 
+// indexSlice returns the element of a slice at the given index, which is
+// loaded from the underlying array of the slice like an element of an array.
+func indexSlice(elemType string, expr, index *Node) (*Node, error) {
+	ptr, length := NewTmp(), NewTmp()
+	code := utils.AppendCode(
+		expr.Code,
+		index.Code,
+		fmt.Sprintf("%s, %s, %s, %d", tac.FROM, length, expr.Place, sliceLen),
+	)
+	check, err := indexCheck(index.Place, length)
+	if err != nil {
+		return nil, err
+	}
+	n, err := loadElem(ptr, index.Place, elemType)
+	if err != nil {
+		return nil, err
+	}
+	n.Code = utils.AppendCode(
+		code,
+		check,
+		fmt.Sprintf("%s, %s, %s, %d", tac.FROM, ptr, expr.Place, slicePtr),
+		n.Code,
+	)
+	return n, nil
+}
+
 // NewPrimaryExprArgs returns an AST node for PrimaryExpr Arguments.
 // NOTE: This is the production rule for a function call.
 func NewPrimaryExprArgs(expr, args *Node) (*Node, error) {
+	n, err := primaryExprArgs(expr, args)
+	return nameExpr(n, err, "%s", callText(expr.Place, utils.SplitAndSanitize(args.Place, ",")))
+}
+
+// primaryExprArgs returns a call of a function or a method, or a conversion.
+func primaryExprArgs(expr, args *Node) (*Node, error) {
+	if _, _, ok := splitMethodRef(expr.Place); ok {
+		return newMethodCall(expr, args)
+	}
+	if _, found := globalSymTab[expr.Place]; !found && isBuiltin(expr.Place) {
+		return NewBuiltinCall(expr.Place, args)
+	}
+	if symEntry, found := globalSymTab[expr.Place]; found {
+		// A call of a type is a conversion.
+		switch symEntry.kind {
+		case NAMED:
+			return namedConversion(expr.Place, args)
+		case ALIAS:
+			if typ := symEntry.symbols[0]; isNamedType(typ) {
+				return namedConversion(typ, args)
+			} else if expr := utils.SplitAndSanitize(args.Place, ","); len(expr) == 1 {
+				return NewConversion(typ, &Node{expr[0], args.Code})
+			}
+		}
+	}
 	n := &Node{"", args.Code}
 	symEntry := globalSymTab[expr.Place]
-	returnLen := 0
-	if symEntry.kind == FUNCTION {
-		if ret, err := strconv.Atoi(symEntry.symbols[0]); err != nil {
-			return nil, err
-		} else {
-			returnLen = ret
+	if symEntry.kind != FUNCTION {
+		// A function value may also be the element of an array, a
+		// slice or a map.
+		if symEntry, ok := funcEntry(expr.Place); ok {
+			return newIndirectCall(symEntry, expr, args)
 		}
-	} else {
-		varName := RealName(expr.Place)
-		if symEntry, ok := GetSymbol(varName); !ok {
-			return nil, ErrUndefined(varName)
-		} else {
-			return nil, ErrInvalidFunc(varName, GetType(symEntry.kind))
+		if _, ok := lookupPlace(expr.Place); !ok {
+			return nil, ErrUndefined(RealName(expr.Place))
 		}
+		return nil, ErrInvalidFunc(exprName(expr.Place), typeOf(expr.Place))
 	}
-	argExpr := utils.SplitAndSanitize(args.Place, ",")
-	for k, v := range argExpr {
-		n.Code = append(n.Code, fmt.Sprintf("=, %s.%d, %s", expr.Place, k, v))
+	// The arguments are pushed on the stack in order before the call.
+	argExpr, code, err := funcValues(utils.SplitAndSanitize(args.Place, ","))
+	if err != nil {
+		return nil, err
 	}
-	n.Code = append(n.Code, fmt.Sprintf("call, %s", expr.Place))
-	for k := 0; k < returnLen; k++ {
-		n.Place = fmt.Sprintf("%s, return.%d", n.Place, k)
+	n.Code = append(n.Code, code...)
+	if err := callCode(n, tac.CALL, FuncName(expr.Place), argExpr, symEntry.symbols); err != nil {
+		return nil, err
 	}
 	return n, nil
 }
@@ -463,59 +953,86 @@ func NewCompositeLit(typ, val *Node) (AstNode, error) {
 	n := &Node{"", []string{}}
 	// Check if the LiteralType corresponds to ArrayType. This is done
 	// because unlike structs it is not required to add a symbol table entry
-	// for place values of arrays (which is of the form "array:<length>"),
-	// thus returning early.
-	if strings.HasPrefix(typ.Place, ARR) {
-		n.Place = typ.place()
-		return n, nil
+	// for place values of empty array literals (which are of the form
+	// "arrint:<length>"), thus returning early.
+	if isArrayType(typ.Place) {
+		if val.Place == "" {
+			n.Place = typ.place()
+			return n, nil
+		}
+		return newArrayLit(typ.Place, val)
 	}
-	// In case the corresponds to a struct, add the code for its data member
-	// initialization.
+	// A literal of a named type is a literal of its underlying type, which
+	// is recorded as a value of the named type.
+	switch GetKind(typ.Place) {
+	case MAP:
+		lit, err := newMapLit(&Node{underlying(typ.Place), typ.Code}, val)
+		if err == nil {
+			setNamed(lit.Place, typ.Place)
+		}
+		return lit, err
+	case SLICE:
+		lit, err := newSliceLit(StripPrefix(underlying(typ.Place)), val)
+		if err == nil {
+			setNamed(lit.Place, typ.Place)
+		}
+		return lit, err
+	}
+	// A struct literal is held by a temporary struct.
 	if symEntry, found := Lookup(typ.Place); !found {
 		return nil, ErrUndefined(typ.Place)
-	} else {
-		switch symEntry.kind {
-		case STRUCT:
-			litVals := utils.SplitAndSanitize(val.Place, ",")
-			litValCodes := val.Code
-			structInit := []string{}
-			// In case of integral (or any type) initializations, the corresponding
-			// lexeme is placed at the place value, justifying the length check which
-			// is made on 'litVals' instead of 'litValCodes'. If there are no place
-			// values for the data members, then initialize all to their default
-			// values. Otherwise initialize them to the corresponding place value.
-			if len(litVals) == 0 {
-				for k, v := range symEntry.symbols {
-					if k%2 == 0 {
-						// TODO: Update default values depending on type.
-						// The default type is currently assumed to be int.
-						structInit = append(structInit, v, "0")
-					}
-				}
-			} else {
-				for k, v := range symEntry.symbols {
-					if k%2 == 0 {
-						structInit = append(structInit, v, litVals[k/2])
-					}
-				}
-			}
-			// When these code values will be utilized above, the litValCodes will be
-			// placed above the code corresponding to structInit (litVals can be expressions).
-			n.Code = utils.AppendCode(n.Code, structInit, litValCodes)
-			return &StructType{*n, typ.place(), len(symEntry.symbols) / 2}, nil
-		}
+	} else if symEntry.kind == STRUCT {
+		return newStructLit(typ.Place, val)
+	}
+	return n, nil
+}
+
+// NewBoolLit returns a boolean literal. The value of the literal is placed in
+// a temporary so that its type information is retained in the symbol table.
+func NewBoolLit(lit string) (*Node, error) {
+	n := &Node{NewTmp(), []string{}}
+	InsertSymbol(n.Place, BOOLEAN, n.Place)
+	exprTexts[n.Place] = lit
+	val := 0
+	if lit == "true" {
+		val = 1
 	}
+	n.Code = append(n.Code, fmt.Sprintf("=, %s, %d", n.Place, val))
 	return n, nil
 }
 
+// NewParenExpr returns a parenthesized expression, whose value is that of the
+// enclosed expression.
+func NewParenExpr(expr *Node) (*Node, error) {
+	if text, ok := exprTexts[expr.Place]; ok {
+		exprTexts[expr.Place] = "(" + text + ")"
+	}
+	return expr, nil
+}
+
 // NewIdentifier returns a new identifier.
 func NewIdentifier(varName string) (*Node, error) {
-	if symEntry, found := Lookup(varName); found {
+	if symEntry, found := resolve(varName); found {
+		if symEntry.kind == CONSTANT {
+			return constNode(symEntry), nil
+		}
 		if _, found := globalSymTab[varName]; found {
 			return &Node{varName, []string{}}, nil
 		} else {
 			return &Node{symEntry.symbols[0], []string{}}, nil
 		}
+	} else if isBuiltin(varName) {
+		return &Node{varName, []string{}}, nil
+	} else if _, found := globalSymTab[qualifier+varName]; found {
+		// A type declared in an imported package is keyed by its
+		// qualified name.
+		return &Node{qualifier + varName, []string{}}, nil
+	} else if isPkg(varName) {
+		return nil, fmt.Errorf("use of package %s without selector", varName)
+	} else if varName == IOTA && constDecl {
+		return NewIota(), nil
+	} else if varName == NILPTR {
+		return newNil(), nil
 	} else {
 		return nil, ErrUndefined(varName)
 	}
@@ -523,34 +1040,66 @@ func NewIdentifier(varName string) (*Node, error) {
 
 // --- [ Functions ] -----------------------------------------------------------
 
-// NewFuncDecl returns a function declaration.
+// NewFuncDecl returns a function declaration. The code of the function
+// literals declared in the function is placed after it.
 func NewFuncDecl(marker, body *Node) (AstNode, error) {
-	n := &FuncType{Node{"", append(marker.Code, body.Code...)}, nil, nil}
-	funcSymtabCreated = true // end of function block
-	// Return statement insertion will be handled when the defer stack is
-	// emptied and the code for deferred calls has been inserted.
-	if deferStack.Len > 0 {
-		defer func() { n.Code = append(n.Code, "ret,") }()
-	}
-	for deferStack.Len > 0 {
-		deferFuncCode := deferStack.Pop().(DeferStackItem)
-		n.Code = append(n.Code, deferFuncCode...)
+	code := funcCode(marker, body)
+	if err := checkBranches(code); err != nil {
+		return nil, err
 	}
+	code = boxVars(popFunc(), code)
+	n := &FuncType{Node{"", append(code, funcLits...)}, nil, nil}
+	funcLits = nil
+	funcLitIndex = 0
 	return n, nil
 }
 
+// funcCode returns the code of a function (or a function literal) along with
+// the code running its deferred calls.
+func funcCode(marker, body *Node) []string {
+	if len(currFunc().defers) > 0 {
+		return deferCode(marker, body)
+	}
+	code := utils.AppendCode(marker.Code, currFunc().resultDecl, body.Code)
+	if endsInReturn(code) {
+		return code
+	}
+	// A function without results need not end in a return statement, in
+	// which case it is inserted.
+	return append(code, retCode(flatValues(currFunc().results)))
+}
+
+// endsInReturn determines whether the last instruction of a function body is
+// a return statement.
+func endsInReturn(code []string) bool {
+	for k := len(code) - 1; k >= 0; k-- {
+		if v := strings.TrimSpace(code[k]); v != "" {
+			lines := strings.Split(v, "\n")
+			return strings.HasPrefix(lines[len(lines)-1], tac.RET)
+		}
+	}
+	return false
+}
+
 // NewFuncMarker returns a marker non-terminal used in the production rule for
 // function declaration.
 func NewFuncMarker(name, signature *Node) (*Node, error) {
-	n := &Node{name.Place, []string{fmt.Sprintf("func, %s", name.Place)}}
-	// Assign values to arguments.
-	for k, v := range signature.Code {
-		n.Code = append(n.Code, fmt.Sprintf("=, %s, %s.%d", v, name.Place, k))
-	}
+	currFunc().name = name.Place
+	funcSymtabCreated = true // the scope of the body is that of the parameters
+	n := &Node{name.Place, []string{fmt.Sprintf("func, %s", FuncName(name.Place))}}
+	// Declare the parameters, which are allocated a slot in the frame.
+	n.Code = append(n.Code, paramCode(signature.Code)...)
 	if _, found := globalSymTab[name.Place]; !found {
+		// The symbol table entry of a function is of the form -
+		//	{ number of results, type of result 0, ..., type of parameter 0, ... }
+		// where the types of the parameters are those of the values
+		// passed for them, a struct being passed as its members. The
+		// type of a variadic parameter is "variadic:<element type>".
+		results := utils.SplitAndSanitize(signature.Place, ",")
+		symbols := append([]string{strconv.Itoa(len(results))}, results...)
 		globalSymTab[name.Place] = SymTabEntry{
 			kind:    FUNCTION,
-			symbols: []string{signature.Place},
+			symbols: append(symbols, signatureTypes(signature.Code)...),
 		}
 	} else {
 		return nil, fmt.Errorf("function %s is already declared\n", name.Place)
@@ -566,53 +1115,152 @@ func NewFuncMarker(name, signature *Node) (*Node, error) {
 // invoked starting from top.
 func NewSignature(typ int, args ...*Node) (*Node, error) {
 	NewScope()
-	for _, v := range args[0].Code {
+	pushFunc()
+	// The parameters are renamed similar to local variables so that they
+	// don't conflict with the variables of the other functions.
+	params := []string{}
+	paramTypes := utils.SplitAndSanitize(args[0].Place, ",")
+	for k, v := range args[0].Code {
 		if v == "" {
 			break
 		}
-		InsertSymbol(v, INTEGER, v)
+		typ := INT
+		if k < len(paramTypes) {
+			typ = paramTypes[k]
+		}
+		if GetPrefix(typ) == VRD {
+			// A variadic parameter is a slice of the values passed
+			// for it.
+			if k != len(paramTypes)-1 {
+				return nil, fmt.Errorf("can only use ... with final parameter in list")
+			}
+			currFunc().variadic = StripPrefix(typ)
+			typ = SLC + ":" + StripPrefix(typ)
+		}
+		if isStructType(typ) {
+			params = append(params, declareStruct(v, typ)...)
+			continue
+		}
+		renamedVar := RenameVariable(v)
+		insertTyped(v, typ, renamedVar)
+		params = append(params, renamedVar)
 	}
 	if typ == 0 {
-		return &Node{"0", args[0].Code}, nil
-	} else {
-		return &Node{fmt.Sprintf("%s", args[1].Place), args[0].Code}, nil
+		return &Node{"", params}, nil
 	}
+	currFunc().resultTypes = utils.SplitAndSanitize(args[1].Place, ",")
+	declareResults(args[1].Code, currFunc().resultTypes)
+	return &Node{args[1].Place, params}, nil
 }
 
-// NewResult defines the return type of a function.
+// NewResult defines the return type of a function. The place attribute of the
+// returned node contains the comma separated types of the results, and the code
+// attribute contains the names of the results if they are named.
 func NewResult(params *Node) (*Node, error) {
-	returnLength := 0
-	// Evaluate the number of return values.
-	for _, v := range params.Code {
-		if v == INT {
-			returnLength++
+	n := &Node{params.Place, []string{}}
+	if strings.Contains(params.Place, VRD+":") {
+		return nil, fmt.Errorf("cannot use ... in result list")
+	}
+	for k, v := range utils.SplitAndSanitize(params.Place, ",") {
+		if k < len(params.Code) && params.Code[k] != v {
+			n.Code = params.Code
+			break
 		}
 	}
-	return &Node{fmt.Sprintf("%d", returnLength), []string{}}, nil
+	return n, nil
+}
+
+// NewParamDecl returns a parameter declaration. The identifier is placed in the
+// code attribute and its type in the place attribute of the node.
+func NewParamDecl(ident string, typ *Node) (*Node, error) {
+	return &Node{typ.Place, []string{ident}}, nil
+}
+
+// NewParamType returns the declaration of an unnamed parameter, whose code
+// attribute holds an empty name.
+func NewParamType(typ *Node) (*Node, error) {
+	return &Node{typ.Place, []string{""}}, nil
+}
+
+// NewQualifiedParam returns the declaration of an unnamed parameter whose type
+// is declared in an imported package.
+func NewQualifiedParam(n *Node) (*Node, error) {
+	typ, err := NewQualifiedType(n)
+	if err != nil {
+		return nil, err
+	}
+	return NewParamType(typ)
 }
 
-// NewParamList returns a list of parameters.
+// NewParamList returns a list of parameters. A lone identifier is the name of
+// a parameter having the type of the next one if any of the parameters are
+// named, and the name of its type otherwise. The code attribute of an unnamed
+// parameter is its type.
 func NewParamList(decl, declList *Node) (*Node, error) {
-	n := &Node{"", append(decl.Code, declList.Code...)}
-	return n, nil
+	n, _ := AppendParam(decl, declList)
+	names := n.Code
+	types := strings.Split(n.Place, ",")
+	for k := range types {
+		types[k] = strings.TrimSpace(types[k])
+	}
+	named := false
+	for k := range names {
+		if names[k] != "" && types[k] != "" {
+			named = true
+		}
+	}
+	for k := len(names) - 1; k >= 0; k-- {
+		switch {
+		case named && names[k] == "":
+			return nil, fmt.Errorf("mixed named and unnamed parameters")
+		case named && types[k] == "":
+			if k == len(names)-1 {
+				return nil, fmt.Errorf("mixed named and unnamed parameters")
+			}
+			if GetPrefix(types[k+1]) == VRD {
+				return nil, fmt.Errorf("can only use ... with final parameter in list")
+			}
+			types[k] = types[k+1]
+		case !named && names[k] != "":
+			typ, err := NewTypeName(names[k])
+			if err != nil {
+				return nil, err
+			}
+			types[k] = typ.Place
+			names[k] = typ.Place
+		case !named:
+			names[k] = types[k]
+		}
+	}
+	return &Node{strings.Join(types, ", "), names}, nil
 }
 
-// AppendParam appends a parameter to a list of parameters.
+// AppendParam appends a parameter to a list of parameters. The place attribute
+// holds exactly one type for each name in the code attribute, where the type of
+// a lone identifier is empty.
 func AppendParam(decl, declList *Node) (*Node, error) {
-	n := &Node{"", append(decl.Code, declList.Code...)}
+	if len(declList.Code) == 0 {
+		return &Node{decl.Place, decl.Code}, nil
+	}
+	n := &Node{fmt.Sprintf("%s, %s", decl.Place, declList.Place), append(decl.Code, declList.Code...)}
 	return n, nil
 }
 
 // NewArrayType returns an array.
 func NewArrayType(arrLen, arrType string) (*Node, error) {
 	n := &Node{"", []string{}}
+	// The elements of a named type are placed along with their type, which
+	// is taken by the elements when they are indexed.
 	switch arrType {
 	case INT:
 		n.Place = ARRINT + ":" + arrLen
 	case STR:
 		n.Place = ARRSTR + ":" + arrLen
 	default:
-		panic("NewArrayType: type not supported by arrays")
+		if err := checkElem(arrType); err != nil {
+			return nil, err
+		}
+		n.Place = fmt.Sprintf("%s:%s:%s", ARR, arrLen, arrType)
 	}
 	return n, nil
 }
@@ -628,6 +1276,13 @@ func NewStruct(node *Node) (*StructType, error) {
 // NewFieldDecl returns a field declaration.
 func NewFieldDecl(identList, typ *Node) (*Node, error) {
 	n := &Node{"", []string{}}
+	if GetPrefix(typ.Place) == ARR {
+		// An array of a named integer (string) type is laid out like an
+		// array of integers (strings).
+		if _, elem := arrayParts(typ.Place); underlying(elem) != INT && underlying(elem) != STR {
+			return nil, fmt.Errorf("fields of type %s are not supported", displayType(typ.Place))
+		}
+	}
 	// The AST node contains the identifier name and its type one after the
 	// another in Code.
 	for _, v := range identList.Code {
@@ -660,13 +1315,6 @@ func NewStmtList(stmt, stmtList *Node) (*Node, error) {
 	return n, nil
 }
 
-// NewLabelStmt returns a labeled statement.
-func NewLabelStmt(label, stmt *Node) (AstNode, error) {
-	n := &LabeledStmt{Node{"", []string{"label, " + label.Place}}}
-	n.Code = append(n.Code, stmt.Code...)
-	return n, nil
-}
-
 // NewReturnStmt returns a return statement.
 // A return statement can be of the following types -
 //	- an empty return: In this case the argument expr is empty.
@@ -674,31 +1322,19 @@ func NewLabelStmt(label, stmt *Node) (AstNode, error) {
 //	  expression.
 func NewReturnStmt(expr ...*Node) (*ReturnStmt, error) {
 	n := &ReturnStmt{Node{"", []string{}}}
-	if len(expr) == 0 {
-		// The return statement is empty.
-		// The defer statements need to be inserted before the return stmt (and
-		// not at the end of function block as was the previous misconception).
-		// When defer stmt is used, the return stmt for main() is also inserted
-		// when all the defer calls from stack are popped and inserted in IR.
-		if deferStack.Len > 0 {
-			// Return statement insertion will be handled when defer
-			// stack is emptied and the deferred calls are inserted.
-			return n, nil
-		} else {
-			n.Code = append(n.Code, "ret,")
-			return n, nil
-		}
-	} else {
-		if deferStack.Len == 0 {
-			retExpr := utils.SplitAndSanitize(expr[0].Place, ",")
-			n.Code = append(n.Code, expr[0].Code...)
-			for k, v := range retExpr {
-				n.Code = append(n.Code, fmt.Sprintf("=, return.%d, %s", k, v))
-			}
-			n.Code = append(n.Code, "ret,")
-		}
-		return n, nil
+	// An empty return statement returns the named results, if any.
+	values := currFunc().results
+	if len(expr) > 0 {
+		n.Code = append(n.Code, expr[0].Code...)
+		values = utils.SplitAndSanitize(expr[0].Place, ",")
+	}
+	values, code, err := returnValues(values)
+	if err != nil {
+		return nil, err
 	}
+	n.Code = append(n.Code, code...)
+	n.Code = append(n.Code, retCode(values))
+	return n, nil
 }
 
 // --- [ Blocks ] --------------------------------------------------------------
@@ -729,6 +1365,13 @@ func NewBlockMarker() (*Node, error) {
 
 // NewIfStmt returns an if statement.
 func NewIfStmt(typ int, args ...*Node) (*Node, error) {
+	cond := args[0]
+	if typ > 2 {
+		cond = args[1]
+	}
+	if kind := KindOf(cond.Place); kind != BOOLEAN && kind != NIL {
+		return nil, ErrNonBoolCond(exprName(cond.Place), GetType(kind), "if")
+	}
 	n := &Node{"", args[0].Code}
 	afterLabel := NewLabel()
 	elseLabel := NewLabel()
@@ -762,6 +1405,7 @@ func NewIfStmt(typ int, args ...*Node) (*Node, error) {
 
 	case 3:
 		n.Code = utils.AppendCode(
+			n.Code,
 			args[1].Code,
 			fmt.Sprintf("blt, %s, %s, 1", afterLabel, args[1].Place),
 			args[2].Code,
@@ -769,6 +1413,7 @@ func NewIfStmt(typ int, args ...*Node) (*Node, error) {
 
 	case 4, 5:
 		n.Code = utils.AppendCode(
+			n.Code,
 			args[1].Code,
 			fmt.Sprintf("blt, %s, %s, 1", elseLabel, args[1].Place),
 			args[2].Code,
@@ -778,54 +1423,139 @@ func NewIfStmt(typ int, args ...*Node) (*Node, error) {
 		)
 	}
 	n.Code = append(n.Code, fmt.Sprintf("label, %s", afterLabel))
+	n.Place = afterLabel
+	branchTargets[afterLabel] = ""
 	return n, nil
 }
 
 // NewSwitchStmt returns a switch statement.
-func NewSwitchStmt(expr, caseClause *Node) (*SwitchStmt, error) {
-	n := &SwitchStmt{Node{"", expr.Code}}
+// The accepted variants of the variadic arguments (args) are -
+//	- Expression, ExprCaseClauses
+//	- ExprCaseClauses
+//	- SimpleStmt, Expression, ExprCaseClauses
+//	- SimpleStmt, ExprCaseClauses
+// The cardinal argument `typ` determines the index of the production rule
+// invoked starting from top.
+func NewSwitchStmt(typ int, args ...*Node) (*SwitchStmt, error) {
+	n := &SwitchStmt{Node{"", []string{}}}
+	caseClause := args[len(args)-1]
+	if typ > 1 {
+		n.Code = append(n.Code, args[0].Code...) // init stmt
+	}
+	// A switch statement without an expression is equivalent to one
+	// switching on true.
+	tag := ""
+	switch typ {
+	case 0:
+		n.Code = append(n.Code, args[0].Code...)
+		tag = args[0].Place
+	case 2:
+		n.Code = append(n.Code, args[1].Code...)
+		tag = args[1].Place
+	}
+
+	// The code of caseClause is of the form -
+	//	{ expression code, case values, statement code }
+	// repeated for each of the case clauses.
 	caseLabels := []string{}
-	caseStmts := caseClause.Code
-	// SplitAndSanitize cannot be used here as removal of empty entries
-	// seems to be causing erroneous index calculations.
-	// Regression caused in "test/codegen/switch.go".
-	caseTemporaries := strings.Split(caseClause.Place, ",")
 	afterLabel := NewLabel()
 	defaultLabel := afterLabel
-	// The last value in caseTemporaries will be the place value returned by
-	// Empty (arising from the rule RepeatExprCaseClause -> Empty).
-	// This has to be ignored.
-	for k, v := range caseTemporaries[:len(caseTemporaries)-1] {
+	for k := 0; k < len(caseClause.Code); k += 3 {
 		caseLabel := NewLabel()
 		caseLabels = append(caseLabels, caseLabel)
-		n.Code = append(n.Code, caseStmts[2*k])
-		if strings.TrimSpace(v) == "default" {
+		n.Code = append(n.Code, caseClause.Code[k])
+		if caseClause.Code[k+1] == "default" {
+			if defaultLabel != afterLabel {
+				return nil, fmt.Errorf("multiple defaults in switch")
+			}
 			defaultLabel = caseLabel
-		} else {
-			n.Code = append(n.Code, fmt.Sprintf("beq, %s, %s, %s", caseLabel, expr.Place, v))
+			continue
+		}
+		for _, v := range utils.SplitAndSanitize(caseClause.Code[k+1], ",") {
+			if tag != "" {
+				code, err := caseCompare(caseLabel, tag, v)
+				if err != nil {
+					return nil, err
+				}
+				n.Code = append(n.Code, code...)
+				continue
+			}
+			if kind := KindOf(v); kind != BOOLEAN && kind != NIL {
+				return nil, fmt.Errorf("invalid case %s in switch (mismatched types %s and bool)",
+					exprName(v), GetType(kind))
+			}
+			n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, 1", tac.BEQ, caseLabel, v))
 		}
 	}
 	n.Code = append(n.Code, fmt.Sprintf("%s, %s", tac.JMP, defaultLabel))
+
 	for k, v := range caseLabels {
-		n.Code = utils.AppendCode(
-			n.Code,
-			fmt.Sprintf("label, %s", v),
-			caseStmts[2*k+1],
-			fmt.Sprintf("%s, %s", tac.JMP, afterLabel),
-		)
+		n.Code = append(n.Code, fmt.Sprintf("label, %s", v))
+		stmts := []string{}
+		for _, stmt := range strings.Split(caseClause.Code[3*k+2], "\n") {
+			if stmt := strings.TrimSpace(stmt); stmt != "" {
+				stmts = append(stmts, stmt)
+			}
+		}
+		fallsThrough := false
+		for i, stmt := range stmts {
+			switch stmt {
+			case "break":
+				// A break statement terminates the switch statement
+				// and not an enclosing loop.
+				n.Code = append(n.Code, fmt.Sprintf("%s, %s", tac.JMP, afterLabel))
+			case "fallthrough":
+				switch {
+				case i != len(stmts)-1:
+					return nil, fmt.Errorf("fallthrough statement out of place")
+				case k == len(caseLabels)-1:
+					return nil, fmt.Errorf("cannot fallthrough final case in switch")
+				}
+				// Control flows into the next case clause.
+				fallsThrough = true
+			default:
+				n.Code = append(n.Code, stmt)
+			}
+		}
+		if !fallsThrough {
+			n.Code = append(n.Code, fmt.Sprintf("%s, %s", tac.JMP, afterLabel))
+		}
 	}
 	n.Code = append(n.Code, fmt.Sprintf("label, %s", afterLabel))
+	// A labeled break statement referring to the switch statement
+	// terminates it.
+	n.Place = afterLabel
+	branchTargets[afterLabel] = ""
 	return n, nil
 }
 
-// NewExprCaseClause returns an expression case clause.
+// caseCompare returns the code for branching to the label of a case clause
+// when a value of the case equals the tag of a switch statement. The integers
+// are compared directly (at compile time when both are constants), whereas the comparison of floating-point numbers and
+// strings yields a boolean.
+func caseCompare(caseLabel, tag, v string) ([]string, error) {
+	eq, err := NewRelExpr(&Node{EQ, []string{}}, &Node{tag, []string{}}, &Node{v, []string{}})
+	if err != nil {
+		return nil, fmt.Errorf("invalid case %s in switch on %s (mismatched types %s and %s)",
+			exprName(v), exprName(tag), typeOf(v), typeOf(tag))
+	}
+	if isFloatExpr(tag, v) || isStringExpr(tag, v) {
+		return append(eq.Code, fmt.Sprintf("%s, %s, %s, 1", tac.BEQ, caseLabel, eq.Place)), nil
+	}
+	return branchCode(tac.BEQ, caseLabel, tag, v)
+}
+
+// NewExprCaseClause returns an expression case clause. The code of the returned
+// node is of the form -
+//	{ expression code, case values, statement code }
+// where the case values are "default" for a default case.
 func NewExprCaseClause(expr, stmtList *Node) (*Node, error) {
-	n := &Node{expr.Place, []string{}}
+	n := &Node{"", []string{}}
 	var exprCode, stmtCode string
 	for _, v := range expr.Code {
 		exprCode += fmt.Sprintf("%s\n", v)
 	}
-	n.Code = append(n.Code, exprCode)
+	n.Code = append(n.Code, exprCode, expr.Place)
 	for _, v := range stmtList.Code {
 		stmtCode += fmt.Sprintf("%s\n", v)
 	}
@@ -835,9 +1565,7 @@ func NewExprCaseClause(expr, stmtList *Node) (*Node, error) {
 
 // AppendExprCaseClause appends an expression case clause to a list of same.
 func AppendExprCaseClause(expr, exprList *Node) (*Node, error) {
-	n := &Node{"", append(expr.Code, exprList.Code...)}
-	n.Place = fmt.Sprintf("%s, %s", expr.Place, exprList.Place)
-	return n, nil
+	return &Node{"", append(expr.Code, exprList.Code...)}, nil
 }
 
 // NewForStmt returns a for statement.
@@ -845,6 +1573,7 @@ func AppendExprCaseClause(expr, exprList *Node) (*Node, error) {
 //	- Block
 //	- Condition, Block
 //	- ForClause, Block
+//	- RangeClause, Block
 // The cardinal argument `typ` determines the index of the production rule
 // invoked starting from top.
 func NewForStmt(typ int, args ...*Node) (*ForStmt, error) {
@@ -859,6 +1588,9 @@ func NewForStmt(typ int, args ...*Node) (*ForStmt, error) {
 		blockCode = args[0].Code
 
 	case 1:
+		if kind := KindOf(args[0].Place); kind != BOOLEAN && kind != NIL {
+			return nil, ErrNonBoolCond(exprName(args[0].Place), GetType(kind), "for")
+		}
 		n.Code = utils.AppendCode(
 			n.Code,
 			fmt.Sprintf("label, %s", startLabel),
@@ -876,21 +1608,50 @@ func NewForStmt(typ int, args ...*Node) (*ForStmt, error) {
 			fmt.Sprintf("blt, %s, %s, 1", afterLabel, args[0].Place),
 		)
 		blockCode = args[1].Code
+
+	case 3:
+		n.Code = utils.AppendCode(
+			n.Code,
+			args[0].Code[0], // init stmt
+			fmt.Sprintf("label, %s", startLabel),
+			args[0].Code[1], // next iteration
+			fmt.Sprintf("%s, %s, %s, 0", tac.BEQ, afterLabel, args[0].Place),
+			args[0].Code[2], // iteration variables
+		)
+		blockCode = args[1].Code
 	}
 
+	// A continue statement proceeds to the post statement, if any.
+	continueLabel := ""
+	if hasContinue(blockCode) {
+		// A labeled continue statement may refer to this statement.
+		continueLabel = startLabel
+		if typ == 2 {
+			continueLabel = NewLabel()
+		}
+	}
 	for _, v := range blockCode {
 		v := strings.TrimSpace(v)
 		switch v {
 		case "break":
 			n.Code = append(n.Code, fmt.Sprintf("%s, %s", tac.JMP, afterLabel))
 		case "continue":
-			n.Code = append(n.Code, fmt.Sprintf("%s, %s", tac.JMP, startLabel))
+			if continueLabel == "" {
+				continueLabel = startLabel
+				if typ == 2 {
+					continueLabel = NewLabel()
+				}
+			}
+			n.Code = append(n.Code, fmt.Sprintf("%s, %s", tac.JMP, continueLabel))
 		default:
 			n.Code = append(n.Code, v)
 		}
 	}
 
 	if typ == 2 {
+		if continueLabel != "" {
+			n.Code = append(n.Code, fmt.Sprintf("label, %s", continueLabel))
+		}
 		n.Code = append(n.Code, args[0].Code[2]) // post stmt
 	}
 
@@ -899,7 +1660,9 @@ func NewForStmt(typ int, args ...*Node) (*ForStmt, error) {
 		fmt.Sprintf("%s, %s", tac.JMP, startLabel),
 		fmt.Sprintf("label, %s", afterLabel),
 	)
-
+	n.Place = afterLabel
+	branchTargets[afterLabel] = continueLabel
+	currScope = currScope.parent // end of the scope of the header
 	return n, nil
 }
 
@@ -961,33 +1724,141 @@ func NewForClause(typ int, args ...*Node) (*Node, error) {
 	return nil, fmt.Errorf("NewForClause: Invalid type %d", typ)
 }
 
-// NewDeferStmt returns a defer statement.
-func NewDeferStmt(expr, args *Node) (*DeferStmt, error) {
-	// Add code corresponding to the arguments.
-	n := &DeferStmt{Node{"", args.Code}}
-	argExpr := utils.SplitAndSanitize(args.Place, ",")
-	for k, v := range argExpr {
-		n.Code = append(n.Code, fmt.Sprintf("=, %s.%d, %s", expr.Place, k, v))
+// NewRangeClause returns a range clause. The accepted variants of the variadic
+// arguments (args) are -
+//	- (none)
+//	- ExpressionList
+//	- IdentifierList (assigned)
+//	- IdentifierList (declared)
+// The code of the returned node is of the form -
+//	{ init stmt, next iteration, binding of iteration variables }
+// and the iteration ends when its place value becomes 0.
+func NewRangeClause(typ int, expr *Node, args ...*Node) (*Node, error) {
+	// A pointer to an array is dereferenced automatically, in which case
+	// the elements are loaded from the array pointed to.
+	ptr, aliased := ptrType(expr.Place)
+	if aliased && isArrayType(StripPrefix(ptr)) {
+		expr = derefArray(expr, StripPrefix(ptr))
+	}
+	types, err := rangeTypes(expr.Place)
+	if err != nil {
+		return nil, err
+	}
+	vars := []string{}
+	switch typ {
+	case 1:
+		vars = utils.SplitAndSanitize(args[0].Place, ",")
+	case 2, 3:
+		vars = args[0].Code
+	}
+	if len(vars) > len(types) {
+		if len(types) == 1 {
+			return nil, fmt.Errorf("range over %s permits only one iteration variable", RealName(expr.Place))
+		}
+		return nil, fmt.Errorf("range clause permits at most two iteration variables")
+	}
+	if len(vars) == 2 && vars[1] != "_" && isStructType(types[1]) {
+		return nil, fmt.Errorf("range over %s with values of type %s is not supported",
+			RealName(expr.Place), displayType(types[1]))
+	}
+	// The iteration variables are bound to the key and the element.
+	dst := []string{"", ""}
+	bindCode := []string{}
+	for k, v := range vars {
+		if v == "_" {
+			continue
+		}
+		switch typ {
+		case 1:
+			dst[k] = v
+			bindCode = append(bindCode, writeBack(v)...)
+		case 2:
+			if symEntry, found := resolve(v); found {
+				dst[k] = symEntry.symbols[0]
+			} else {
+				return nil, ErrUndefined(v)
+			}
+		case 3:
+			if _, found := GetSymbol(v); found {
+				return nil, ErrShortDecl
+			}
+			dst[k] = RenameVariable(v)
+			insertTyped(v, types[k], dst[k])
+			if isArrayType(types[k]) {
+				// The elements are copied into the array declared
+				// before the first iteration.
+				expr.Code = append(expr.Code, fmt.Sprintf("%s, %s, %d", tac.DECL, dst[k], sizeOf(types[k])))
+			}
+		}
+		if arrType, ok := arrayType(dst[k]); (ok || isArrayType(types[k])) && arrType != types[k] {
+			return nil, fmt.Errorf("cannot assign type %s to %s (type %s) in range",
+				displayType(types[k]), exprName(dst[k]), typeOf(dst[k]))
+		}
+	}
+	if arrType, ok := arrayType(expr.Place); ok && dst[1] != "" && !aliased {
+		// The range expression is evaluated once, hence the elements are
+		// those of a copy of the array made before the first iteration.
+		t := NewTmp()
+		insertTyped(t, arrType, t)
+		size := strconv.Itoa(sizeOf(arrType))
+		expr.Code = append(expr.Code, fmt.Sprintf("%s, %s, %s", tac.DECL, t, size))
+		expr = &Node{t, append(expr.Code, copyArray(t, expr.Place, size)...)}
+	}
+	entry, code := rangeCode(expr.Place, dst[0], dst[1])
+	code[0] = append(expr.Code, code[0]...)
+	code[2] = append(code[2], bindCode...)
+	n := &Node{entry, []string{}}
+	for _, v := range code {
+		n.Code = append(n.Code, strings.Join(v, "\n"))
 	}
-	n.Place = NewTmp()
-	// Push the code for the actual function call to the defer stack.
-	deferCode := make(DeferStackItem, 0)
-	deferCode = append(deferCode, fmt.Sprintf("call, %s", expr.Place))
-	deferCode = append(deferCode, fmt.Sprintf("store, %s", n.Place))
-	deferStack.Push(deferCode)
 	return n, nil
 }
 
 // NewIOStmt returns an I/O statement.
+//
+// Deprecated: The statements are retained as aliases of the functions of the fmt
+// package, which is implemented in format.go.
 func NewIOStmt(typ string, expr *Node) (*Node, error) {
 	n := &Node{"", expr.Code}
 	switch typ {
 	case "printInt":
+		if err := representable(expr.Place, INTEGER); err != nil {
+			return nil, err
+		}
 		// The IR for printInt is supposed to look as following -
 		//	printInt, a, a
 		n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s", typ, expr.Place, expr.Place))
-	case "printStr", "scanInt":
+	case "printStr":
+		// A string literal is declared before being printed.
+		s, code := strValue(expr.Place)
+		n.Code = append(n.Code, code...)
+		n.Code = append(n.Code, fmt.Sprintf("%s, %s", typ, s))
+	case "scanInt":
 		n.Code = append(n.Code, fmt.Sprintf("%s, %s", typ, expr.Place))
+	case "printChar":
+		if kind := KindOf(expr.Place); !isInteger(kind) && kind != NIL {
+			return nil, fmt.Errorf("cannot use %s (type %s) as type rune in printChar",
+				exprName(expr.Place), GetType(kind))
+		}
+		n.Code = append(n.Code, printRune(expr.Place)...)
+	case "printFloat":
+		// A float32 value is printed by printFloat and a float64 value
+		// by printDouble.
+		kind := KindOf(expr.Place)
+		if _, ok := constVal(expr.Place); ok {
+			kind = FLOAT64
+		}
+		if !isFloat(kind) {
+			return nil, fmt.Errorf("cannot use %s (type %s) as type float64 in printFloat",
+				exprName(expr.Place), typeOf(expr.Place))
+		}
+		val, code := floatValue(expr.Place, kind)
+		n.Code = append(n.Code, code...)
+		if kind == FLOAT32 {
+			n.Code = append(n.Code, fmt.Sprintf("%s, %s", tac.PRINTFLOAT, val))
+		} else {
+			n.Code = append(n.Code, fmt.Sprintf("%s, %s", tac.PRINTDOUBLE, val))
+		}
 	}
 	return n, nil
 }
@@ -995,17 +1866,93 @@ func NewIOStmt(typ string, expr *Node) (*Node, error) {
 // NewIncDecStmt returns an increment or a decrement statement.
 func NewIncDecStmt(op string, expr *Node) (*Node, error) {
 	n := &Node{"", expr.Code}
+	if err := checkMutable(expr.Place); err != nil {
+		return nil, err
+	}
+	if err := ptrOperator(op, expr.Place); err != nil {
+		return nil, err
+	}
+	kind := KindOf(expr.Place)
+	arith, one := tac.ADD, "1"
 	switch op {
 	case INC:
-		n.Code = append(n.Code, fmt.Sprintf("+, %s, %s, 1", expr.Place, expr.Place))
 	case DEC:
-		n.Code = append(n.Code, fmt.Sprintf("-, %s, %s, 1", expr.Place, expr.Place))
+		arith = tac.SUB
 	default:
 		return nil, fmt.Errorf("Invalid operator %s", op)
 	}
+	if isFloat(kind) {
+		arith, one = floatOp(arith, kind), "1.0"
+	}
+	n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, %s", arith, expr.Place, expr.Place, one))
+	n.Code = append(n.Code, truncate(expr.Place, kind)...)
+	n.Code = append(n.Code, writeBack(expr.Place)...)
 	return n, nil
 }
 
+// writeBack returns the code for storing the value of a reference to an element
+// of an array, a slice or a map, or of a value loaded through a pointer, back
+// into its location.
+func writeBack(place string) []string {
+	symEntry, found := Lookup(place)
+	if !found {
+		return []string{}
+	}
+	switch symEntry.kind {
+	case ARRAYINT, ARRAYSTR:
+		dst := symEntry.symbols[0]
+		index := symEntry.symbols[1]
+		return []string{fmt.Sprintf("into, %s, %s, %s, %s", dst, dst, index, place)}
+	case MAPELEM:
+		return mapAssign(symEntry.symbols[0], symEntry.symbols[1], place)
+	case DEREF:
+		addr := symEntry.symbols[0]
+		op := memOp(tac.INTO, GetKind(symEntry.symbols[2]))
+		return []string{fmt.Sprintf("%s, %s, %s, %s, %s", op, addr, addr, symEntry.symbols[1], place)}
+	}
+	return []string{}
+}
+
+// snapshot returns the values assigned by a parallel assignment, along with the
+// code for evaluating them. The operands on the right are evaluated before any
+// of the assignments is carried out, hence a variable which is assigned before
+// its value is used is copied to a temporary.
+func snapshot(left, right []string) ([]string, []string) {
+	overlaps := func(a, b string) bool {
+		return a == b || strings.HasPrefix(a, b+".") || strings.HasPrefix(b, a+".")
+	}
+	code := []string{}
+	for k, v := range right {
+		assigned := false
+		for _, dst := range left[:k] {
+			assigned = assigned || overlaps(dst, v)
+		}
+		if !assigned {
+			continue
+		}
+		t := NewTmp()
+		if typ, ok := arrayType(v); ok {
+			size := strconv.Itoa(sizeOf(typ))
+			insertTyped(t, typ, t)
+			code = append(code, fmt.Sprintf("%s, %s, %s", tac.DECL, t, size))
+			code = append(code, copyArray(t, v, size)...)
+		} else if isStruct(v) {
+			code = append(code, copyStruct(t, v)...)
+		} else if symEntry, found := lookupPlace(v); found {
+			InsertSymbol(t, symEntry.kind, t, symEntry.symbols[1:])
+			code = append(code, fmt.Sprintf("%s, %s, %s", moveOp(symEntry.kind), t, v))
+		} else {
+			continue
+		}
+		if typ, ok := namedTypes[v]; ok {
+			namedTypes[t] = typ
+		}
+		exprTexts[t] = exprName(v)
+		right[k] = t
+	}
+	return right, code
+}
+
 // NewAssignStmt returns an assignment statement.
 func NewAssignStmt(typ int, op string, leftExpr, rightExpr *Node) (*Node, error) {
 	switch typ {
@@ -1014,10 +1961,40 @@ func NewAssignStmt(typ int, op string, leftExpr, rightExpr *Node) (*Node, error)
 		n.Code = append(n.Code, rightExpr.Code...)
 		leftExpr := utils.SplitAndSanitize(leftExpr.Place, ",")
 		rightExpr := utils.SplitAndSanitize(rightExpr.Place, ",")
+		if len(leftExpr) != 1 || len(rightExpr) != 1 {
+			return nil, fmt.Errorf("assignment operation %s requires single-valued expressions", op)
+		}
 		for k, v := range leftExpr {
-			n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, %s", op, v, v, rightExpr[k]))
+			if err := checkMutable(v); err != nil {
+				return nil, err
+			}
+			// The assignment operation "x op= y" is evaluated as
+			// "x = x op y".
+			op := strings.TrimSuffix(op, "=")
+			if _, err := namedOperands(op, v, rightExpr[k]); err != nil {
+				return nil, err
+			}
+			if err := ptrOperator(op, v, rightExpr[k]); err != nil {
+				return nil, err
+			}
+			code, err := []string{}, error(nil)
+			if isFloatExpr(v, rightExpr[k]) {
+				code, err = floatAssignOp(op, v, rightExpr[k])
+			} else if isStringExpr(v, rightExpr[k]) {
+				code, err = strAssignOp(op, v, rightExpr[k])
+			} else if _, err = intKind(op, v, rightExpr[k]); err == nil {
+				code, err = binaryOpCode(op, v, v, rightExpr[k])
+				code = append(code, truncate(v, KindOf(v))...)
+			}
+			if err != nil {
+				return nil, err
+			}
+			n.Code = append(n.Code, code...)
 			if symEntry, found := Lookup(v); found {
 				switch symEntry.kind {
+				case MAPELEM, DEREF:
+					n.Code = append(n.Code, writeBack(v)...)
+					continue
 				case ARRAYINT:
 					InsertSymbol(v, INTEGER, symEntry.symbols)
 				case ARRAYSTR:
@@ -1042,45 +2019,68 @@ func NewAssignStmt(typ int, op string, leftExpr, rightExpr *Node) (*Node, error)
 		n := &Node{"", append(leftExpr.Code, rightExpr.Code...)}
 		leftExpr := utils.SplitAndSanitize(leftExpr.Place, ",")
 		rightExpr := utils.SplitAndSanitize(rightExpr.Place, ",")
+		if len(leftExpr) == 2 && len(rightExpr) == 1 {
+			// The comma-ok form of an index expression on a map or
+			// of a receive operation.
+			if code, ok := commaOk(rightExpr[0], leftExpr[1]); ok {
+				n.Code = append(n.Code, fmt.Sprintf("=, %s, %s", leftExpr[0], rightExpr[0]))
+				n.Code = append(n.Code, writeBack(leftExpr[0])...)
+				n.Code = append(n.Code, code...)
+				n.Code = append(n.Code, writeBack(leftExpr[1])...)
+				return n, nil
+			}
+		}
 		if len(leftExpr) != len(rightExpr) {
 			return nil, ErrCountMismatch(len(leftExpr), len(rightExpr))
 		}
+		var code []string
+		rightExpr, code = snapshot(leftExpr, rightExpr)
+		n.Code = append(n.Code, code...)
 		for k, v := range leftExpr {
-			if currScope.symTab[RealName(v)].kind == POINTER {
-				if strings.HasPrefix(rightExpr[k], PTR) {
-					varName := RealName(StripPrefix(rightExpr[k]))
-					currScope.symTab[RealName(v)].symbols[1] = currScope.symTab[varName].symbols[0]
-				} else {
-					varName := RealName(rightExpr[k])
-					currScope.symTab[RealName(v)].symbols[1] = currScope.symTab[varName].symbols[1]
-				}
+			if err := checkMutable(v); err != nil {
+				return nil, err
+			}
+			if err := checkNamed(v, rightExpr[k]); err != nil {
+				return nil, err
+			}
+			if err := checkStruct(v, rightExpr[k]); err != nil {
+				return nil, err
+			}
+			if code, ok := assignStruct(v, rightExpr[k]); ok {
+				n.Code = append(n.Code, code...)
 				continue
 			}
-			if strings.HasPrefix(rightExpr[k], DRF) {
-				if strings.HasPrefix(v, DRF) {
-					symEntry, _ := Lookup(RealName(StripPrefix(v)))
-					leftVar := symEntry.symbols[1]
-					symEntry, _ = Lookup(RealName(StripPrefix(rightExpr[k])))
-					rightVar := symEntry.symbols[1]
-					n.Code = append(n.Code, fmt.Sprintf("=, %s, %s", leftVar, rightVar))
-				} else {
-					symEntry, _ := Lookup(RealName(v))
-					leftVar := symEntry.symbols[0]
-					symEntry, _ = Lookup(RealName(StripPrefix(rightExpr[k])))
-					rightVar := symEntry.symbols[1]
-					n.Code = append(n.Code, fmt.Sprintf("=, %s, %s", leftVar, rightVar))
+			if err := checkArray(v, rightExpr[k]); err != nil {
+				return nil, err
+			}
+			if code, ok := assignArray(v, rightExpr[k]); ok {
+				n.Code = append(n.Code, code...)
+				continue
+			}
+			if isFloatExpr(v, rightExpr[k]) {
+				code, err := floatAssign(v, rightExpr[k])
+				if err != nil {
+					return nil, err
 				}
-			} else if strings.HasPrefix(v, DRF) {
-				symEntry, _ := Lookup(RealName(StripPrefix(v)))
-				if len(symEntry.symbols) != 2 {
-					varName := RealName(symEntry.symbols[0])
-					varType := GetType(symEntry.kind)
-					return nil, ErrIndirection(varName, varType)
+				n.Code = append(n.Code, code)
+				if symEntry, found := Lookup(v); found && symEntry.kind == DEREF {
+					n.Code = append(n.Code, writeBack(v)...)
 				}
-				varName := symEntry.symbols[1]
-				n.Code = append(n.Code, fmt.Sprintf("=, %s, %s", varName, rightExpr[k]))
 			} else {
-				n.Code = append(n.Code, fmt.Sprintf("=, %s, %s", v, rightExpr[k]))
+				rightVal, code, err := funcValue(rightExpr[k])
+				if err != nil {
+					return nil, err
+				}
+				if err := checkIntAssign(v, rightVal); err != nil {
+					return nil, err
+				}
+				if err := checkFuncAssign(v, rightVal); err != nil {
+					return nil, err
+				}
+				n.Code = append(n.Code, code...)
+				rightVal, code = strValue(rightVal)
+				n.Code = append(n.Code, code...)
+				n.Code = append(n.Code, fmt.Sprintf("=, %s, %s", v, rightVal))
 				if symEntry, found := Lookup(v); found {
 					// The IR notation for assigning an array member to a
 					// variable is of the form -
@@ -1088,6 +2088,9 @@ func NewAssignStmt(typ int, op string, leftExpr, rightExpr *Node) (*Node, error)
 					// destination appears twice above because of the way
 					// register spilling is currently being handled.
 					switch symEntry.kind {
+					case MAPELEM, DEREF:
+						n.Code = append(n.Code, writeBack(v)...)
+						continue
 					case ARRAYINT:
 						InsertSymbol(v, INTEGER, symEntry.symbols)
 					case ARRAYSTR:
@@ -1113,21 +2116,69 @@ func NewAssignStmt(typ int, op string, leftExpr, rightExpr *Node) (*Node, error)
 		} else {
 			n.Code = rightExpr.Code
 			expr := utils.SplitAndSanitize(rightExpr.Place, ",")
+			if len(leftExpr.Code) == 2 && len(expr) == 1 {
+				// The comma-ok form of an index expression on a map or
+				// of a receive operation. The values assigned to the
+				// blank identifier are discarded in temporaries.
+				renamed := []string{}
+				for _, v := range leftExpr.Code {
+					if v == "_" {
+						renamed = append(renamed, NewTmp())
+					} else if symEntry, found := resolve(v); found {
+						renamed = append(renamed, symEntry.symbols[0])
+					} else {
+						return nil, ErrUndefined(v)
+					}
+				}
+				if code, ok := commaOk(expr[0], renamed[1]); ok {
+					n.Code = append(n.Code, fmt.Sprintf("=, %s, %s", renamed[0], expr[0]))
+					n.Code = append(n.Code, code...)
+					return n, nil
+				}
+			}
 			if len(leftExpr.Code) != len(expr) {
 				return nil, ErrCountMismatch(len(leftExpr.Code), len(expr))
 			}
-			for k, v := range leftExpr.Code {
-				if symEntry, found := Lookup(v); found {
-					renamedVar := symEntry.symbols[0]
-					if strings.HasPrefix(expr[k], ARR) {
-						return nil, ErrDeclArr
-					} else if symEntry.kind != POINTER {
-						n.Code = append(n.Code, fmt.Sprintf("=, %s, %s", renamedVar, expr[k]))
-					}
+			expr, code, err := funcValues(expr)
+			if err != nil {
+				return nil, err
+			}
+			n.Code = append(n.Code, code...)
+			renamed := []string{}
+			for _, v := range leftExpr.Code {
+				if symEntry, found := resolve(v); found {
+					renamed = append(renamed, symEntry.symbols[0])
 				} else {
 					return nil, ErrUndefined(v)
 				}
 			}
+			expr, code = snapshot(renamed, expr)
+			n.Code = append(n.Code, code...)
+			for k, renamedVar := range renamed {
+				if err := checkNamed(renamedVar, expr[k]); err != nil {
+					return nil, err
+				}
+				if err := checkStruct(renamedVar, expr[k]); err != nil {
+					return nil, err
+				}
+				if code, ok := assignStruct(renamedVar, expr[k]); ok {
+					n.Code = append(n.Code, code...)
+				} else if isArrayType(expr[k]) {
+					return nil, ErrDeclArr
+				} else if err := checkArray(renamedVar, expr[k]); err != nil {
+					return nil, err
+				} else if code, ok := assignArray(renamedVar, expr[k]); ok {
+					n.Code = append(n.Code, code...)
+				} else if isFloatExpr(renamedVar, expr[k]) {
+					code, err := floatAssign(renamedVar, expr[k])
+					if err != nil {
+						return nil, err
+					}
+					n.Code = append(n.Code, code)
+				} else {
+					n.Code = append(n.Code, fmt.Sprintf("=, %s, %s", renamedVar, expr[k]))
+				}
+			}
 		}
 		return n, nil
 	}
@@ -1138,75 +2189,101 @@ func NewAssignStmt(typ int, op string, leftExpr, rightExpr *Node) (*Node, error)
 // NewShortDecl returns a short variable declaration.
 func NewShortDecl(identList *Node, exprList AstNode) (*Node, error) {
 	n := &Node{"", []string{}}
-	// TODO: Multiple struct initializations using short declaration are not
-	// handled currently.
 	switch exprList := exprList.(type) {
-	case *StructType:
-		// The following index calculations assume that struct names
-		// cannot include a semicolon ':'.
-		structLen := exprList.Len
-		structName := identList.Code[0]
-		// keeping structName in the symbol table with type as Struct
-		InsertSymbol(structName, STRUCT, structName)
-		// The individual struct member initializers can contain
-		// expressions whose code need to be added before the members
-		// are initialized.
-		n.Code = append(n.Code, exprList.Code[2*structLen:]...)
-
-		// Add code for struct member initializations.
-		var varName, varVal string
-		for k, v := range exprList.Code[:2*structLen] {
-			if k%2 == 0 {
-				// Member names are located at even locations.
-				varName = v
-			} else {
-				// (Initialized) member values are located at odd locations.
-				varVal = v
-				renamedVar := RenameVariable(structName + "." + varName)
-				InsertSymbol(structName+"."+varName, INTEGER, renamedVar)
-				n.Code = append(n.Code, fmt.Sprintf("=, %s, %s", renamedVar, varVal))
-			}
-		}
-
 	case *Node:
 		n.Code = exprList.Code
 		expr := utils.SplitAndSanitize(exprList.Place, ",")
+		if len(identList.Code) == 2 && len(expr) == 1 {
+			// The comma-ok form of an index expression on a map or
+			// of a receive operation.
+			if typ, ok := commaOkType(expr[0]); ok {
+				renamed := []string{}
+				for _, v := range identList.Code {
+					if _, found := GetSymbol(v); found {
+						return nil, ErrShortDecl
+					}
+					renamed = append(renamed, RenameVariable(v))
+				}
+				insertTyped(identList.Code[0], typ, renamed[0])
+				InsertSymbol(identList.Code[1], BOOLEAN, renamed[1])
+				code, _ := commaOk(expr[0], renamed[1])
+				n.Code = append(n.Code, fmt.Sprintf("declInt, %s, %s", renamed[0], expr[0]))
+				n.Code = append(n.Code, code...)
+				return n, nil
+			}
+		}
 		if numIdent := len(identList.Code); numIdent != len(expr) {
 			return nil, ErrCountMismatch(numIdent, len(expr))
 		}
+		expr, code, err := funcValues(expr)
+		if err != nil {
+			return nil, err
+		}
+		n.Code = append(n.Code, code...)
 		for k, v := range identList.Code {
 			renamedVar := RenameVariable(v)
 			if _, found := GetSymbol(v); !found {
-				if strings.HasPrefix(expr[k], PTR) {
-					// The symbol table entry of a pointer (&var) is of the form -
-					// 	{ type, renamedVar, (variable name which is being pointed to) }
-					InsertSymbol(v, POINTER, renamedVar, StripPrefix(expr[k]))
-				} else if currScope.symTab[RealName(expr[k])].kind == POINTER {
-					InsertSymbol(v, POINTER, renamedVar, currScope.symTab[RealName(expr[k])].symbols[1])
+				if isStruct(expr[k]) {
+					n.Code = append(n.Code, copyStruct(v, expr[k])...)
+					continue
+				} else if typ, ok := ptrType(expr[k]); ok {
+					if isNil(expr[k]) {
+						return nil, ErrUntypedNil
+					}
+					insertTyped(v, typ, renamedVar)
+				} else if isArrayType(expr[k]) {
+					// The length of an array is retained for slicing it.
+					insertTyped(v, expr[k], renamedVar)
+				} else if typ, ok := arrayType(expr[k]); ok {
+					// An array is copied into the declared array.
+					insertTyped(v, typ, renamedVar)
+					if named, ok := namedTypes[expr[k]]; ok {
+						namedTypes[renamedVar] = named
+					}
+					n.Code = append(n.Code, fmt.Sprintf("%s, %s, %d", tac.DECL, renamedVar, sizeOf(typ)))
+					code, _ := assignArray(renamedVar, expr[k])
+					n.Code = append(n.Code, code...)
+					continue
+				} else if symEntry, ok := sliceEntry(expr[k]); ok {
+					InsertSymbol(v, SLICE, renamedVar, symEntry.symbols[1])
+				} else if symEntry, ok := mapEntry(expr[k]); ok {
+					InsertSymbol(v, MAP, renamedVar, symEntry.symbols[1:])
+				} else if symEntry, ok := chanEntry(expr[k]); ok {
+					InsertSymbol(v, CHANNEL, renamedVar, symEntry.symbols[1])
+				} else if symEntry, found := Lookup(expr[k]); found && symEntry.kind == MAPELEM {
+					insertTyped(v, symEntry.symbols[2], renamedVar)
+				} else if symEntry, ok := funcEntry(expr[k]); ok {
+					InsertSymbol(v, FUNCVAL, renamedVar, symEntry.symbols[1])
+				} else if kind := KindOf(expr[k]); kind == BOOLEAN || kind == STRING || kind == BYTE ||
+					kind == RUNE || kind == INTERFACE || isFloat(kind) {
+					InsertSymbol(v, kind, renamedVar)
 				} else {
 					InsertSymbol(v, INTEGER, renamedVar)
 				}
+				if named, ok := namedTypes[expr[k]]; ok {
+					namedTypes[renamedVar] = named
+				}
 			} else {
 				return nil, ErrShortDecl
 			}
-			if strings.HasPrefix(expr[k], ARR) {
+			if GetPrefix(expr[k]) == ARR {
+				n.Code = append(n.Code, declareArray(renamedVar, expr[k])...)
+			} else if isArrayType(expr[k]) {
 				// TODO: rename arrays
 				n.Code = append(n.Code, fmt.Sprintf("decl, %s, %s", renamedVar, StripPrefix(expr[k])))
-			} else if strings.HasPrefix(expr[k], DRF) {
-				varName := RealName(StripPrefix(expr[k]))
-				if symEntry, ok := GetSymbol(varName); !ok {
-					return nil, ErrUndefined(varName)
-				} else {
-					varType := GetType(symEntry.kind)
-					if len(symEntry.symbols) != 2 {
-						return nil, ErrIndirection(varName, varType)
-					} else {
-						n.Code = append(n.Code, fmt.Sprintf("=, %s, %s", renamedVar, symEntry.symbols[1]))
-					}
-				}
-			} else if strings.HasPrefix(expr[k], STR) {
+			} else if GetPrefix(expr[k]) == STR {
 				n.Code = append(n.Code, fmt.Sprintf("declStr, %s, %s", renamedVar, StripPrefix(expr[k])))
-			} else if currScope.symTab[v].kind != POINTER {
+			} else if kind := KindOf(expr[k]); isFloat(kind) {
+				code, err := assignFloat(renamedVar, kind, expr[k])
+				if err != nil {
+					return nil, err
+				}
+				n.Code = append(n.Code, code)
+			} else {
+				// An untyped constant is of the default type int.
+				if err := representable(expr[k], INTEGER); err != nil {
+					return nil, err
+				}
 				// TODO: Add remaining types
 				n.Code = append(n.Code, fmt.Sprintf("declInt, %s, %s", renamedVar, expr[k]))
 			}
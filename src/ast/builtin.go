@@ -0,0 +1,836 @@
+// This file implements the builtin functions and the slice operations. A slice
+// is represented by the address of a header allocated on the heap, which is of
+// the form -
+//	{ address of the first element, length, capacity }
+// A header is never modified once it has been initialized. Operations which
+// change the length or capacity of a slice (slicing, append) instead allocate a
+// new header, hence a slice can be copied by simply copying its header address.
+
+package ast
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shivansh/gogo/src/tac"
+	"github.com/shivansh/gogo/src/utils"
+)
+
+// Builtin function names.
+const (
+	LEN     = "len"
+	CAP     = "cap"
+	MAKE    = "make"
+	APPEND  = "append"
+	DELETE  = "delete"
+	NEW     = "new"
+	CLOSE   = "close"
+	PANIC   = "panic"
+	RECOVER = "recover"
+	// The following builtins are only available to the runtime.
+	SBRK      = "sbrk"
+	EXIT      = "exit"
+	LOADWORD  = "loadWord"
+	STOREWORD = "storeWord"
+	LOADBYTE  = "loadByte"
+	STOREBYTE = "storeByte"
+	GOSWITCH  = "goswitch"
+	GETFP     = "getfp"
+	FUNCTAB   = "functab"
+	// ITOA converts an integer to its decimal representation.
+	ITOA = "strconv.Itoa"
+	// The functions of the fmt package are implemented in format.go.
+	PRINT   = "fmt.Print"
+	PRINTLN = "fmt.Println"
+	PRINTF  = "fmt.Printf"
+	SCAN    = "fmt.Scan"
+)
+
+// Indices of the fields in a slice header.
+const (
+	slicePtr = iota
+	sliceLen
+	sliceCap
+)
+
+// isBuiltin determines whether a name refers to a builtin function.
+func isBuiltin(name string) bool {
+	switch name {
+	case LEN, CAP, MAKE, APPEND, DELETE, NEW, CLOSE, PANIC, RECOVER, ITOA, PRINT, PRINTLN, PRINTF, SCAN:
+		return true
+	case SBRK, EXIT, LOADWORD, STOREWORD, LOADBYTE, STOREBYTE, GOSWITCH, GETFP, FUNCTAB:
+		return PkgName == "runtime"
+	}
+	return false
+}
+
+// NewSliceType returns a slice type. The place attribute of the returned node
+// is of the form "slice:<element type>".
+func NewSliceType(elem *Node) (*Node, error) {
+	return &Node{SLC + ":" + elem.Place, []string{}}, nil
+}
+
+// NewTypeArgs returns the arguments of a call in which the first argument is a
+// type.
+func NewTypeArgs(typ, exprList *Node) (*Node, error) {
+	return &Node{fmt.Sprintf("%s, %s", typ.Place, exprList.Place), exprList.Code}, nil
+}
+
+// NewSlice returns the indices of a slice expression. The omitted indices are
+// represented by empty values in the place attribute of the returned node.
+func NewSlice(low, high, max *Node) (*Node, error) {
+	n := &Node{"", []string{}}
+	indices := []string{}
+	for _, v := range []*Node{low, high, max} {
+		if v == nil {
+			indices = append(indices, "")
+			continue
+		}
+		indices = append(indices, v.Place)
+		n.Code = append(n.Code, v.Code...)
+	}
+	n.Place = strings.Join(indices, ",")
+	return n, nil
+}
+
+// insertTyped creates a symbol table entry for a variable of the given type.
+// The symbol table entry of a slice is of the form -
+//	{ renamedVar, type of elements }
+// and that of a map is of the form -
+//	{ renamedVar, key type, element type }
+// and that of a channel is of the form -
+//	{ renamedVar, element type }
+// whereas an array is declared as described in arrayLen (or in arrays.go). A
+// type which does not have a corresponding symkind is taken to be int, and
+// the variable of a named type is recorded in namedTypes.
+func insertTyped(key, typ, renamedVar string) {
+	setNamed(renamedVar, typ)
+	typ = underlying(typ)
+	if GetPrefix(typ) == ARRINT || GetPrefix(typ) == ARRSTR {
+		InsertSymbol(key, KindOf(typ), renamedVar, StripPrefix(typ))
+		return
+	}
+	if GetPrefix(typ) == ARR {
+		length, elem := arrayParts(typ)
+		InsertSymbol(key, ARRAY, renamedVar, length, elem)
+		return
+	}
+	switch kind := GetKind(typ); kind {
+	case NIL:
+		InsertSymbol(key, INTEGER, renamedVar)
+	case SLICE:
+		InsertSymbol(key, SLICE, renamedVar, StripPrefix(typ))
+	case MAP:
+		keyType, elemType := mapTypes(typ)
+		InsertSymbol(key, MAP, renamedVar, keyType, elemType)
+	case FUNCVAL:
+		InsertSymbol(key, FUNCVAL, renamedVar, typ)
+	case POINTER:
+		InsertSymbol(key, POINTER, renamedVar, StripPrefix(typ))
+	case CHANNEL:
+		InsertSymbol(key, CHANNEL, renamedVar, StripPrefix(typ))
+	default:
+		InsertSymbol(key, kind, renamedVar)
+	}
+}
+
+// sliceEntry returns the symbol table entry of a slice.
+func sliceEntry(place string) (*SymTabEntry, bool) {
+	if symEntry, found := lookupPlace(place); found && symEntry.kind == SLICE {
+		return symEntry, true
+	}
+	return derefEntry(place, SLICE)
+}
+
+// derefEntry returns the symbol table entry of a slice, a map or a channel
+// which is loaded from memory (see deref), as it would be for a variable.
+func derefEntry(place string, kind symkind) (*SymTabEntry, bool) {
+	symEntry, found := lookupPlace(place)
+	if !found || symEntry.kind != DEREF {
+		return nil, false
+	}
+	typ := underlying(symEntry.symbols[2])
+	if GetKind(typ) != kind {
+		return nil, false
+	}
+	if kind == MAP {
+		keyType, elemType := mapTypes(typ)
+		return &SymTabEntry{kind, []string{place, keyType, elemType}}, true
+	}
+	return &SymTabEntry{kind, []string{place, StripPrefix(typ)}}, true
+}
+
+// arrayLen returns the length of an array. The symbol table entry of an array
+// of integers (or strings) is of the form -
+//	{ renamedVar, length }
+func arrayLen(place string) (string, bool) {
+	if symEntry, found := lookupPlace(place); found {
+		switch symEntry.kind {
+		case INTEGER, STRING:
+			if len(symEntry.symbols) == 2 {
+				return symEntry.symbols[1], true
+			}
+		case ARRAY:
+			return symEntry.symbols[1], true
+		}
+	}
+	return "", false
+}
+
+// elemKind returns the kind of a reference to an element of a slice (or an
+// array) with the given element type.
+func elemKind(typ string) symkind {
+	if GetKind(typ) == STRING {
+		return ARRAYSTR
+	}
+	return ARRAYINT
+}
+
+// NewBuiltinCall returns a call to a builtin function.
+func NewBuiltinCall(name string, args *Node) (*Node, error) {
+	n := &Node{"", args.Code}
+	argExpr, spreads := spreadArgs(utils.SplitAndSanitize(args.Place, ","))
+	if spreads && name != APPEND {
+		return nil, fmt.Errorf("invalid use of ... with builtin %s", name)
+	}
+	switch name {
+	case LEN, CAP:
+		if len(argExpr) != 1 {
+			return nil, ErrArgCount(name, len(argExpr), 1)
+		}
+		if _, ok := sliceEntry(argExpr[0]); ok {
+			n.Place = NewTmp()
+			InsertSymbol(n.Place, INTEGER, n.Place)
+			field := sliceLen
+			if name == CAP {
+				field = sliceCap
+			}
+			n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, %d", tac.FROM, n.Place, argExpr[0], field))
+		} else if _, ok := mapEntry(argExpr[0]); ok && name == LEN {
+			n.Place = NewTmp()
+			InsertSymbol(n.Place, INTEGER, n.Place)
+			n.Code = utils.AppendCode(
+				n.Code,
+				fmt.Sprintf("%s, %s", tac.ARG, argExpr[0]),
+				fmt.Sprintf("%s, %s, 1", tac.CALL, RuntimeFunc("maplen")),
+				fmt.Sprintf("%s, %s", tac.STORE, n.Place),
+			)
+		} else if _, ok := chanEntry(argExpr[0]); ok {
+			fn := "chanlen"
+			if name == CAP {
+				fn = "chancap"
+			}
+			n.Place = NewTmp()
+			InsertSymbol(n.Place, INTEGER, n.Place)
+			n.Code = append(n.Code, runtimeCall(n.Place, fn, argExpr[0])...)
+		} else if length, ok := arrayLen(argExpr[0]); ok {
+			n.Place = length
+		} else if typ, ok := ptrType(argExpr[0]); ok && isArrayType(StripPrefix(typ)) {
+			n.Place, _ = arrayParts(StripPrefix(typ))
+		} else if KindOf(argExpr[0]) == STRING && name == LEN {
+			length, code, err := strLen(argExpr[0])
+			if err != nil {
+				return nil, err
+			}
+			n.Place = length
+			n.Code = append(n.Code, code...)
+		} else {
+			return nil, ErrArgType(name, argExpr[0])
+		}
+
+	case MAKE:
+		if len(argExpr) > 0 && GetKind(argExpr[0]) == MAP {
+			// The size hint of a map is accepted but ignored, as the
+			// hash table is grown by the runtime.
+			if len(argExpr) > 2 {
+				return nil, fmt.Errorf("invalid operation: %s expects 1 or 2 arguments; found %d", name, len(argExpr))
+			}
+			keyType, _ := mapTypes(argExpr[0])
+			var code []string
+			n.Place, code = newMap(keyType)
+			n.Code = append(n.Code, code...)
+			insertTyped(n.Place, argExpr[0], n.Place)
+			break
+		}
+		if len(argExpr) > 0 && GetKind(argExpr[0]) == CHANNEL {
+			if len(argExpr) > 2 {
+				return nil, fmt.Errorf("invalid operation: %s expects 1 or 2 arguments; found %d", name, len(argExpr))
+			}
+			size := "0"
+			if len(argExpr) == 2 {
+				size = argExpr[1]
+				if kind := KindOf(size); !isInteger(kind) && kind != NIL {
+					return nil, fmt.Errorf("non-integer buffer argument to make(%s)", displayType(argExpr[0]))
+				}
+			}
+			var code []string
+			n.Place, code = newChan(argExpr[0], size)
+			n.Code = append(n.Code, code...)
+			insertTyped(n.Place, argExpr[0], n.Place)
+			break
+		}
+		if len(argExpr) < 2 || len(argExpr) > 3 {
+			return nil, fmt.Errorf("invalid operation: %s expects 2 or 3 arguments; found %d", name, len(argExpr))
+		}
+		if GetKind(argExpr[0]) != SLICE {
+			return nil, fmt.Errorf("invalid argument: cannot make %s", argExpr[0])
+		}
+		length, capacity := argExpr[1], argExpr[1]
+		if len(argExpr) == 3 {
+			capacity = argExpr[2]
+		}
+		code, err := makeSliceCheck(length, capacity)
+		if err != nil {
+			return nil, err
+		}
+		n.Code = append(n.Code, code...)
+		// The memory allocated by the runtime is zeroed, hence only the
+		// elements holding strings are initialized.
+		elem := StripPrefix(argExpr[0])
+		words, err := NewArithExpr(AST, &Node{capacity, []string{}}, &Node{strconv.Itoa(sizeOf(elem)), []string{}})
+		if err != nil {
+			return nil, err
+		}
+		size, err := NewArithExpr(LSH, words, &Node{"2", []string{}})
+		if err != nil {
+			return nil, err
+		}
+		ptr := NewTmp()
+		n.Code = utils.AppendCode(
+			n.Code,
+			size.Code,
+			fmt.Sprintf("%s, %s", tac.ARG, size.Place),
+			fmt.Sprintf("%s, %s, 1", tac.CALL, RuntimeFunc("malloc")),
+			fmt.Sprintf("%s, %s", tac.STORE, ptr),
+		)
+		if !isZeroed(elem) {
+			n.Code = append(n.Code, zeroFill(ptr, words.Place, elem)...)
+		}
+		var header []string
+		n.Place, header = newSliceHeader(ptr, length, capacity)
+		n.Code = append(n.Code, header...)
+		InsertSymbol(n.Place, SLICE, n.Place, elem)
+
+	case APPEND:
+		if len(argExpr) == 0 {
+			return nil, fmt.Errorf("missing arguments to %s", name)
+		}
+		symEntry, ok := sliceEntry(argExpr[0])
+		if !ok {
+			return nil, ErrArgType(name, argExpr[0])
+		}
+		// The runtime returns a slice of the required length which shares
+		// the underlying array of the original slice when its capacity is
+		// sufficient. The appended values are then copied past the length
+		// of the original slice.
+		oldLen, newLen, ptr := NewTmp(), NewTmp(), NewTmp()
+		n.Place = NewTmp()
+		InsertSymbol(n.Place, SLICE, n.Place, symEntry.symbols[1])
+		count := strconv.Itoa(len(argExpr) - 1)
+		if spreads {
+			// The elements of a spread slice are appended.
+			if len(argExpr) != 2 {
+				return nil, fmt.Errorf("can only use ... with final argument in list")
+			}
+			if elems, ok := sliceEntry(argExpr[1]); !ok || elems.symbols[1] != symEntry.symbols[1] {
+				return nil, fmt.Errorf("cannot use %s (type %s) as type %s in %s",
+					RealName(argExpr[1]), typeOf(argExpr[1]), typeOf(argExpr[0]), name)
+			}
+			count = NewTmp()
+			n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, %d", tac.FROM, count, argExpr[1], sliceLen))
+		}
+		n.Code = utils.AppendCode(
+			n.Code,
+			fmt.Sprintf("%s, %s, %s, %d", tac.FROM, oldLen, argExpr[0], sliceLen),
+			fmt.Sprintf("%s, %s, %s, %s", tac.ADD, newLen, oldLen, count),
+			fmt.Sprintf("%s, %s", tac.ARG, argExpr[0]),
+			fmt.Sprintf("%s, %s", tac.ARG, newLen),
+			fmt.Sprintf("%s, %d", tac.ARG, sizeOf(symEntry.symbols[1])),
+			fmt.Sprintf("%s, %s, 3", tac.CALL, RuntimeFunc("growslice")),
+			fmt.Sprintf("%s, %s", tac.STORE, n.Place),
+			fmt.Sprintf("%s, %s, %s, %d", tac.FROM, ptr, n.Place, slicePtr),
+		)
+		elem := symEntry.symbols[1]
+		if spreads {
+			n.Code = append(n.Code, appendSlice(ptr, oldLen, argExpr[1], count, sizeOf(elem))...)
+			break
+		}
+		for k, v := range argExpr[1:] {
+			index := oldLen
+			if k > 0 {
+				index = NewTmp()
+				n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, %d", tac.ADD, index, oldLen, k))
+			}
+			addr, code := elemAddr(ptr, index, sizeOf(elem))
+			store, err := storeElem(addr, 0, elem, v, name)
+			if err != nil {
+				return nil, err
+			}
+			n.Code = utils.AppendCode(n.Code, code, store)
+		}
+
+	case DELETE:
+		if len(argExpr) != 2 {
+			return nil, ErrArgCount(name, len(argExpr), 2)
+		}
+		symEntry, ok := mapEntry(argExpr[0])
+		if !ok {
+			return nil, ErrArgType(name, argExpr[0])
+		}
+		if err := checkKey(argExpr[1], symEntry.symbols[1], "argument to delete"); err != nil {
+			return nil, err
+		}
+		key, code := strValue(argExpr[1])
+		n.Code = utils.AppendCode(
+			n.Code,
+			code,
+			fmt.Sprintf("%s, %s", tac.ARG, argExpr[0]),
+			fmt.Sprintf("%s, %s", tac.ARG, key),
+			fmt.Sprintf("%s, %s, 2", tac.CALL, RuntimeFunc("mapdelete")),
+		)
+
+	case NEW:
+		if len(argExpr) != 1 {
+			return nil, ErrArgCount(name, len(argExpr), 1)
+		}
+		return newValue(argExpr[0])
+
+	case CLOSE:
+		if len(argExpr) != 1 {
+			return nil, ErrArgCount(name, len(argExpr), 1)
+		}
+		if _, ok := chanEntry(argExpr[0]); !ok {
+			return nil, ErrArgType(name, argExpr[0])
+		}
+		n.Code = append(n.Code,
+			fmt.Sprintf("%s, %s", tac.ARG, argExpr[0]),
+			fmt.Sprintf("%s, %s, 1", tac.CALL, RuntimeFunc("closechan")),
+		)
+
+	case PANIC:
+		if len(argExpr) != 1 {
+			return nil, ErrArgCount(name, len(argExpr), 1)
+		}
+		msg, code, err := panicValue(argExpr[0])
+		if err != nil {
+			return nil, err
+		}
+		n.Code = utils.AppendCode(
+			n.Code,
+			code,
+			fmt.Sprintf("%s, %s", tac.ARG, msg),
+			fmt.Sprintf("%s, %s, 1", tac.CALL, RuntimeFunc("gopanic")),
+		)
+
+	case RECOVER:
+		// The message of the panic being recovered from is returned,
+		// which is 0 (nil) when the goroutine is not panicking.
+		if len(argExpr) != 0 {
+			return nil, ErrArgCount(name, len(argExpr), 0)
+		}
+		n.Place = NewTmp()
+		InsertSymbol(n.Place, INTERFACE, n.Place)
+		n.Code = append(n.Code, runtimeCall(n.Place, "gorecover")...)
+
+	case SBRK:
+		if len(argExpr) != 1 {
+			return nil, ErrArgCount(name, len(argExpr), 1)
+		}
+		n.Place = NewTmp()
+		InsertSymbol(n.Place, INTEGER, n.Place)
+		n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s", tac.SBRK, n.Place, argExpr[0]))
+
+	case EXIT:
+		if len(argExpr) != 1 {
+			return nil, ErrArgCount(name, len(argExpr), 1)
+		}
+		n.Code = append(n.Code, fmt.Sprintf("%s, %s", tac.EXIT, argExpr[0]))
+
+	case LOADWORD, LOADBYTE:
+		// loadWord(p, i) loads the i'th word and loadByte(p, i) loads
+		// the i'th byte past the address p.
+		if len(argExpr) != 2 {
+			return nil, ErrArgCount(name, len(argExpr), 2)
+		}
+		op := tac.FROM
+		if name == LOADBYTE {
+			op = tac.FROMB
+		}
+		n.Place = NewTmp()
+		InsertSymbol(n.Place, INTEGER, n.Place)
+		n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, %s", op, n.Place, argExpr[0], argExpr[1]))
+
+	case STOREWORD, STOREBYTE:
+		// storeWord(p, i, v) stores v in the i'th word and storeByte(p,
+		// i, v) stores v in the i'th byte past the address p.
+		if len(argExpr) != 3 {
+			return nil, ErrArgCount(name, len(argExpr), 3)
+		}
+		op := tac.INTO
+		if name == STOREBYTE {
+			op = tac.INTOB
+		}
+		n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, %s, %s", op, argExpr[0], argExpr[0], argExpr[1], argExpr[2]))
+
+	case GOSWITCH:
+		// goswitch(from, to) suspends the goroutine from, which resumes
+		// past the call, and resumes the goroutine to.
+		if len(argExpr) != 2 {
+			return nil, ErrArgCount(name, len(argExpr), 2)
+		}
+		resume := NewLabel()
+		n.Code = append(n.Code,
+			fmt.Sprintf("%s, %s, %s, %s", tac.SWAP, resume, argExpr[0], argExpr[1]),
+			fmt.Sprintf("%s, %s", tac.LABEL, resume),
+		)
+
+	case GETFP:
+		// getfp() returns the frame pointer of the calling function.
+		if len(argExpr) != 0 {
+			return nil, ErrArgCount(name, len(argExpr), 0)
+		}
+		n.Place = NewTmp()
+		InsertSymbol(n.Place, INTEGER, n.Place)
+		n.Code = append(n.Code, fmt.Sprintf("%s, %s", tac.FP, n.Place))
+
+	case FUNCTAB:
+		// functab() returns the address of the table of the functions
+		// of the program, which is placed in its data section.
+		if len(argExpr) != 0 {
+			return nil, ErrArgCount(name, len(argExpr), 0)
+		}
+		n.Place = NewTmp()
+		InsertSymbol(n.Place, INTEGER, n.Place)
+		n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s", tac.ADDR, n.Place, RuntimeFunc("functab")))
+
+	case ITOA:
+		if len(argExpr) != 1 {
+			return nil, ErrArgCount(name, len(argExpr), 1)
+		}
+		if kind := KindOf(argExpr[0]); kind != INTEGER && kind != NIL {
+			return nil, fmt.Errorf("cannot use %s (type %s) as type int in argument to %s",
+				exprName(argExpr[0]), GetType(kind), name)
+		}
+		n.Place = NewTmp()
+		InsertSymbol(n.Place, STRING, n.Place)
+		n.Code = append(n.Code,
+			fmt.Sprintf("%s, %s", tac.ARG, argExpr[0]),
+			fmt.Sprintf("%s, %s, 1", tac.CALL, RuntimeFunc("itoa")),
+			fmt.Sprintf("%s, %s", tac.STORE, n.Place),
+		)
+
+	case PRINT, PRINTLN:
+		code, err := newPrint(name, argExpr)
+		if err != nil {
+			return nil, err
+		}
+		n.Code = append(n.Code, code...)
+
+	case PRINTF:
+		code, err := newPrintf(argExpr)
+		if err != nil {
+			return nil, err
+		}
+		n.Code = append(n.Code, code...)
+
+	case SCAN:
+		code, err := newScan(argExpr)
+		if err != nil {
+			return nil, err
+		}
+		n.Code = append(n.Code, code...)
+	}
+	return n, nil
+}
+
+// appendSlice returns the code for copying the given number of elements of the
+// slice src past the given length of the underlying array at ptr, where each
+// element occupies size words.
+func appendSlice(ptr, length, src, count string, size int) []string {
+	elems, i, j, t, words := NewTmp(), NewTmp(), NewTmp(), NewTmp(), NewTmp()
+	loop, end := NewLabel(), NewLabel()
+	return []string{
+		fmt.Sprintf("%s, %s, %s, %d", tac.FROM, elems, src, slicePtr),
+		fmt.Sprintf("%s, %s, %s, %d", tac.MUL, words, count, size),
+		fmt.Sprintf("%s, %s, %s, %d", tac.MUL, j, length, size),
+		fmt.Sprintf("%s, %s, 0", tac.EQ, i),
+		fmt.Sprintf("%s, %s", tac.LABEL, loop),
+		fmt.Sprintf("%s, %s, %s, %s", tac.BGE, end, i, words),
+		fmt.Sprintf("%s, %s, %s, %s", tac.FROM, t, elems, i),
+		fmt.Sprintf("%s, %s, %s, %s, %s", tac.INTO, ptr, ptr, j, t),
+		fmt.Sprintf("%s, %s, %s, 1", tac.ADD, i, i),
+		fmt.Sprintf("%s, %s, %s, 1", tac.ADD, j, j),
+		fmt.Sprintf("%s, %s", tac.JMP, loop),
+		fmt.Sprintf("%s, %s", tac.LABEL, end),
+	}
+}
+
+// newSliceHeader returns the code for allocating and initializing a slice
+// header, along with the temporary holding its address.
+func newSliceHeader(ptr, length, capacity string) (string, []string) {
+	h := NewTmp()
+	return h, []string{
+		fmt.Sprintf("%s, %d", tac.ARG, 3*tac.WordSize),
+		fmt.Sprintf("%s, %s, 1", tac.CALL, RuntimeFunc("malloc")),
+		fmt.Sprintf("%s, %s", tac.STORE, h),
+		fmt.Sprintf("%s, %s, %s, %d, %s", tac.INTO, h, h, slicePtr, ptr),
+		fmt.Sprintf("%s, %s, %s, %d, %s", tac.INTO, h, h, sliceLen, length),
+		fmt.Sprintf("%s, %s, %s, %d, %s", tac.INTO, h, h, sliceCap, capacity),
+	}
+}
+
+// newSliceLit returns a slice literal, whose elements are held by an array
+// allocated on the heap.
+func newSliceLit(elem string, val *Node) (*Node, error) {
+	// A function declared at top level is stored as a function value.
+	values, c, err := funcValues(utils.SplitAndSanitize(val.Place, ","))
+	if err != nil {
+		return nil, err
+	}
+	ptr := NewTmp()
+	n := &Node{"", utils.AppendCode(val.Code, c, newBlock(ptr, len(values)*sizeOf(elem)))}
+	if !isZeroed(elem) {
+		n.Code = append(n.Code, zeroFill(ptr, strconv.Itoa(len(values)*sizeOf(elem)), elem)...)
+	}
+	code, err := storeElems(ptr, elem, values, "slice literal")
+	if err != nil {
+		return nil, err
+	}
+	length := strconv.Itoa(len(values))
+	var header []string
+	n.Place, header = newSliceHeader(ptr, length, length)
+	n.Code = utils.AppendCode(n.Code, code, header)
+	InsertSymbol(n.Place, SLICE, n.Place, elem)
+	return n, nil
+}
+
+// NewPrimaryExprSlice returns an AST node for PrimaryExpr Slice.
+func NewPrimaryExprSlice(expr, slice *Node) (*Node, error) {
+	n, err := primaryExprSlice(expr, slice)
+	indices := strings.Split(slice.Place, ",")
+	for k, v := range indices {
+		if v != "" {
+			indices[k] = exprName(v)
+		}
+	}
+	if indices[2] == "" {
+		indices = indices[:2]
+	}
+	return nameExpr(n, err, "%s[%s]", exprName(expr.Place), strings.Join(indices, ":"))
+}
+
+// primaryExprSlice returns a slice of a string, an array or a slice.
+func primaryExprSlice(expr, slice *Node) (*Node, error) {
+	n := &Node{"", expr.Code}
+	indices := strings.Split(slice.Place, ",")
+	low, high, max := indices[0], indices[1], indices[2]
+	var ptr, length, capacity, elemType string
+	if symEntry, ok := sliceEntry(expr.Place); ok {
+		elemType = symEntry.symbols[1]
+		ptr, length, capacity = NewTmp(), NewTmp(), NewTmp()
+		for _, v := range []string{ptr, length, capacity} {
+			InsertSymbol(v, INTEGER, v)
+		}
+		n.Code = utils.AppendCode(
+			n.Code,
+			fmt.Sprintf("%s, %s, %s, %d", tac.FROM, ptr, expr.Place, slicePtr),
+			fmt.Sprintf("%s, %s, %s, %d", tac.FROM, length, expr.Place, sliceLen),
+			fmt.Sprintf("%s, %s, %s, %d", tac.FROM, capacity, expr.Place, sliceCap),
+		)
+	} else if arrLen, ok := arrayLen(expr.Place); ok {
+		// NOTE: Slicing an array does not move it to the heap, hence the
+		// resulting slice must not outlive the function declaring a
+		// local array.
+		elemType = INT
+		if KindOf(expr.Place) == STRING {
+			elemType = STR
+		}
+		ptr, length, capacity = NewTmp(), arrLen, arrLen
+		InsertSymbol(ptr, INTEGER, ptr)
+		n.Code = append(n.Code, fmt.Sprintf("=, %s, %s", ptr, expr.Place))
+	} else {
+		return nil, fmt.Errorf("cannot slice %s", RealName(expr.Place))
+	}
+	if low == "" {
+		low = "0"
+	}
+	if high == "" {
+		high = length
+	}
+	if max == "" {
+		max = capacity
+	}
+	n.Code = append(n.Code, slice.Code...)
+
+	// The indices are in range if 0 <= low <= high <= max <= cap.
+	failLabel := NewLabel()
+	okLabel := NewLabel()
+	check := []string{}
+	if !re.MatchString(low) {
+		check = append(check, fmt.Sprintf("%s, %s, %s, 0", tac.BLT, failLabel, low))
+	} else if val, _ := strconv.Atoi(low); val < 0 {
+		return nil, ErrNegIndex(val)
+	}
+	for _, v := range [][2]string{{low, high}, {high, max}, {max, capacity}} {
+		code, err := branchCode(tac.BGT, failLabel, v[0], v[1])
+		if err != nil {
+			return nil, err
+		}
+		check = append(check, code...)
+	}
+	if len(check) > 0 && !NoChecks {
+		n.Code = utils.AppendCode(
+			n.Code,
+			check,
+			fmt.Sprintf("%s, %s", tac.JMP, okLabel),
+			fmt.Sprintf("label, %s", failLabel),
+			fmt.Sprintf("%s, %s, 0", tac.CALL, RuntimeFunc("panicSlice")),
+			fmt.Sprintf("label, %s", okLabel),
+		)
+	}
+
+	// Evaluate the fields of the new header.
+	offset, err := NewArithExpr(AST, &Node{low, []string{}}, &Node{strconv.Itoa(sizeOf(elemType) * tac.WordSize), []string{}})
+	if err != nil {
+		return nil, err
+	}
+	newPtr, err := NewArithExpr(ADD, &Node{ptr, []string{}}, offset)
+	if err != nil {
+		return nil, err
+	}
+	newLen, err := NewArithExpr(SUB, &Node{high, []string{}}, &Node{low, []string{}})
+	if err != nil {
+		return nil, err
+	}
+	newCap, err := NewArithExpr(SUB, &Node{max, []string{}}, &Node{low, []string{}})
+	if err != nil {
+		return nil, err
+	}
+	n.Code = utils.AppendCode(n.Code, newPtr.Code, newLen.Code, newCap.Code)
+	var header []string
+	n.Place, header = newSliceHeader(newPtr.Place, newLen.Place, newCap.Place)
+	n.Code = append(n.Code, header...)
+	InsertSymbol(n.Place, SLICE, n.Place, elemType)
+	return n, nil
+}
+
+// indexCheck returns the code which verifies that 0 <= index < length, and
+// calls the runtime to panic otherwise.
+func indexCheck(index, length string) ([]string, error) {
+	if re.MatchString(index) && re.MatchString(length) {
+		i, _ := strconv.Atoi(index)
+		n, _ := strconv.Atoi(length)
+		if i < 0 || i >= n {
+			return nil, fmt.Errorf("invalid argument: index %d out of bounds [0:%d]", i, n)
+		}
+		return []string{}, nil
+	}
+	if val, err := strconv.Atoi(index); err == nil && val < 0 {
+		return nil, ErrNegIndex(val)
+	}
+	if NoChecks {
+		return []string{}, nil
+	}
+	code := []string{}
+	failLabel := NewLabel()
+	okLabel := NewLabel()
+	if !re.MatchString(index) {
+		code = append(code, fmt.Sprintf("%s, %s, %s, 0", tac.BLT, failLabel, index))
+	}
+	branch, err := branchCode(tac.BLT, okLabel, index, length)
+	if err != nil {
+		return nil, err
+	}
+	return utils.AppendCode(
+		code,
+		branch,
+		fmt.Sprintf("label, %s", failLabel),
+		fmt.Sprintf("%s, %s", tac.ARG, index),
+		fmt.Sprintf("%s, %s", tac.ARG, length),
+		fmt.Sprintf("%s, %s, 2", tac.CALL, RuntimeFunc("panicIndex")),
+		fmt.Sprintf("label, %s", okLabel),
+	), nil
+}
+
+// makeSliceCheck returns the code which verifies that 0 <= length <= capacity
+// for a call to make.
+func makeSliceCheck(length, capacity string) ([]string, error) {
+	for _, v := range []string{length, capacity} {
+		if val, err := strconv.Atoi(v); err == nil && val < 0 {
+			return nil, fmt.Errorf("negative len argument in make: %d", val)
+		}
+	}
+	failLabel := NewLabel()
+	okLabel := NewLabel()
+	code := []string{}
+	if !re.MatchString(length) {
+		code = append(code, fmt.Sprintf("%s, %s, %s, 0", tac.BLT, failLabel, length))
+	}
+	branch, err := branchCode(tac.BLE, okLabel, length, capacity)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 && len(branch) == 1 && branch[0] == fmt.Sprintf("%s, %s", tac.JMP, okLabel) {
+		// The check is satisfied at compile time.
+		return nil, nil
+	}
+	return utils.AppendCode(
+		code,
+		branch,
+		fmt.Sprintf("label, %s", failLabel),
+		fmt.Sprintf("%s, %s, 0", tac.CALL, RuntimeFunc("panicMakeSlice")),
+		fmt.Sprintf("label, %s", okLabel),
+	), nil
+}
+
+// branchCode returns the code for a conditional branch to label, where either
+// of the operands can be an integer constant. The branch is evaluated at
+// compile time when both the operands are constants.
+func branchCode(op, label, left, right string) ([]string, error) {
+	if re.MatchString(left) && re.MatchString(right) {
+		l, err := strconv.Atoi(left)
+		if err != nil {
+			return nil, err
+		}
+		r, err := strconv.Atoi(right)
+		if err != nil {
+			return nil, err
+		}
+		taken := false
+		switch op {
+		case tac.BLT:
+			taken = l < r
+		case tac.BLE:
+			taken = l <= r
+		case tac.BGT:
+			taken = l > r
+		case tac.BGE:
+			taken = l >= r
+		case tac.BEQ:
+			taken = l == r
+		case tac.BNE:
+			taken = l != r
+		}
+		if taken {
+			return []string{fmt.Sprintf("%s, %s", tac.JMP, label)}, nil
+		}
+		return []string{}, nil
+	}
+	if re.MatchString(left) {
+		// The first operand of a branch has to be a variable, hence the
+		// operands are swapped and the comparison is mirrored.
+		left, right = right, left
+		switch op {
+		case tac.BLT:
+			op = tac.BGT
+		case tac.BLE:
+			op = tac.BGE
+		case tac.BGT:
+			op = tac.BLT
+		case tac.BGE:
+			op = tac.BLE
+		}
+	}
+	return []string{fmt.Sprintf("%s, %s, %s, %s", op, label, left, right)}, nil
+}
@@ -0,0 +1,392 @@
+// This file implements the goroutines and the channels. A channel is
+// represented by the address of a header maintained by the runtime, and the
+// zero value of a channel (nil) is represented by 0. The operations on a
+// channel are lowered to calls to the runtime, which switches to another
+// goroutine when an operation blocks.
+//
+// A go statement forks the goroutine executing it - the frame of the current
+// function is copied onto the stack of a new goroutine, which resumes past the
+// fork, makes the call and exits. The arguments of the call are evaluated
+// before the fork, and those held by variables are copied into temporaries as
+// the variables may be modified before the new goroutine runs. The elements of
+// a channel are restricted to the values which occupy a single word.
+
+package ast
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shivansh/gogo/src/tac"
+	"github.com/shivansh/gogo/src/utils"
+)
+
+// recvInfo describes the value received by a receive operation.
+type recvInfo struct {
+	ok       string // temporary reporting whether the value was sent
+	elemType string
+}
+
+// received maps the temporaries holding the values received from channels to
+// their descriptions, which are used by the comma-ok form of a receive.
+var received = make(map[string]recvInfo)
+
+// Indices of the words of a case of a select statement, as laid out by the
+// runtime (see selectgo).
+const (
+	caseElem = 1
+	caseOk   = 2
+	caseChan = 5
+	caseSend = 6
+	// caseWords is the number of words occupied by a case.
+	caseWords = 7
+)
+
+// NewChanType returns a channel type. The place attribute of the returned node
+// is of the form "chan:<element type>".
+func NewChanType(elem *Node) (*Node, error) {
+	if err := checkChanElem(elem.Place); err != nil {
+		return nil, err
+	}
+	return &Node{CHN + ":" + elem.Place, []string{}}, nil
+}
+
+// checkChanElem verifies that a type can be the element type of a channel.
+func checkChanElem(typ string) error {
+	switch GetKind(typ) {
+	case INTEGER, BYTE, RUNE, STRING, BOOLEAN, POINTER, SLICE, MAP, FUNCVAL, CHANNEL:
+		if !isArrayType(typ) {
+			return nil
+		}
+	}
+	return fmt.Errorf("channels of type %s are not supported", displayType(typ))
+}
+
+// chanEntry returns the symbol table entry of a channel, which is of the
+// form -
+//	{ renamedVar, element type }
+func chanEntry(place string) (*SymTabEntry, bool) {
+	if symEntry, found := lookupPlace(place); found && symEntry.kind == CHANNEL {
+		return symEntry, true
+	}
+	return derefEntry(place, CHANNEL)
+}
+
+// newChan returns the code for creating a channel of the given type with a
+// buffer of the given size, along with the temporary holding its address. The
+// zero value of the elements is passed to the runtime, which is received once
+// the channel is closed.
+func newChan(typ, size string) (string, []string) {
+	c := NewTmp()
+	code := []string{}
+	zero := "0"
+	if GetKind(StripPrefix(typ)) == STRING {
+		zero, code = strValue(STR + ":\"\"")
+	}
+	return c, append(code, runtimeCall(c, "makechan", size, zero)...)
+}
+
+// newRecv returns a receive operation. The value received is held by a
+// temporary, which is recorded in received.
+func newRecv(expr *Node) (*Node, error) {
+	symEntry, ok := chanEntry(expr.Place)
+	if !ok {
+		return nil, fmt.Errorf("invalid operation: <-%s (receive from non-chan type %s)",
+			exprName(expr.Place), typeOf(expr.Place))
+	}
+	n := &Node{NewTmp(), expr.Code}
+	elemType := symEntry.symbols[1]
+	recv := recvInfo{NewTmp(), elemType}
+	insertTyped(n.Place, elemType, n.Place)
+	InsertSymbol(recv.ok, BOOLEAN, recv.ok)
+	received[n.Place] = recv
+	n.Code = append(n.Code,
+		fmt.Sprintf("%s, %s", tac.ARG, expr.Place),
+		fmt.Sprintf("%s, %s, 1", tac.CALL, RuntimeFunc("chanrecv")),
+		fmt.Sprintf("%s, %s", tac.STORE, n.Place),
+		fmt.Sprintf("%s, %s, 1", tac.STORE, recv.ok),
+	)
+	return n, nil
+}
+
+// sendValue returns the value sent on a channel, along with the code for
+// evaluating it.
+func sendValue(ch, val string) (string, []string, error) {
+	symEntry, ok := chanEntry(ch)
+	if !ok {
+		return "", nil, fmt.Errorf("invalid operation: %s <- %s (send to non-chan type %s)",
+			exprName(ch), exprName(val), typeOf(ch))
+	}
+	elemType := symEntry.symbols[1]
+	kind := GetKind(elemType)
+	val, code, err := funcValue(val)
+	if err != nil {
+		return "", nil, err
+	}
+	if re.MatchString(val) && isInteger(kind) {
+		if err := representable(val, kind); err != nil {
+			return "", nil, err
+		}
+	} else if valKind := KindOf(val); valKind != kind && valKind != NIL {
+		return "", nil, fmt.Errorf("cannot use %s (type %s) as type %s in send",
+			exprName(val), typeOf(val), displayType(elemType))
+	}
+	val, c := strValue(val)
+	return val, append(code, c...), nil
+}
+
+// NewSendStmt returns a send statement.
+func NewSendStmt(ch, val *Node) (*Node, error) {
+	n := &Node{"", append(ch.Code, val.Code...)}
+	v, code, err := sendValue(ch.Place, val.Place)
+	if err != nil {
+		return nil, err
+	}
+	n.Code = utils.AppendCode(
+		n.Code,
+		code,
+		fmt.Sprintf("%s, %s", tac.ARG, ch.Place),
+		fmt.Sprintf("%s, %s", tac.ARG, v),
+		fmt.Sprintf("%s, %s, 2", tac.CALL, RuntimeFunc("chansend")),
+	)
+	return n, nil
+}
+
+// NewGoStmt returns a go statement, which is of the form -
+//	g = newg()
+//	fork child, g
+//	ready(g)
+//	goto done
+//	child: call
+//	goexit()
+//	done:
+// The function value and the arguments are evaluated by the current goroutine,
+// whereas the call itself is made by the new goroutine.
+func NewGoStmt(expr, args *Node) (*Node, error) {
+	n := &Node{"", utils.AppendCode(nil, expr.Code, args.Code)}
+	argExpr, code, err := funcValues(utils.SplitAndSanitize(args.Place, ","))
+	if err != nil {
+		return nil, err
+	}
+	n.Code = append(n.Code, code...)
+	for k, v := range argExpr {
+		symEntry, found := lookupPlace(v)
+		if !found || isStruct(v) {
+			continue
+		}
+		if _, ok := arrayLen(v); ok {
+			continue
+		}
+		switch kind := symEntry.kind; kind {
+		case FLOAT32, FLOAT64:
+			var c []string
+			argExpr[k], c = copyValue(v, GetType(kind))
+			n.Code = append(n.Code, c...)
+		case INTEGER, STRING, BOOLEAN, BYTE, RUNE, SLICE, MAP, FUNCVAL, POINTER, CHANNEL:
+			t := NewTmp()
+			InsertSymbol(t, kind, t, symEntry.symbols[1:])
+			setNamed(t, namedTypes[v])
+			n.Code = append(n.Code, fmt.Sprintf("=, %s, %s", t, v))
+			argExpr[k] = t
+		}
+	}
+	call, err := NewPrimaryExprArgs(&Node{expr.Place, []string{}}, &Node{strings.Join(argExpr, ","), []string{}})
+	if err != nil {
+		return nil, err
+	}
+	g := NewTmp()
+	childLabel, doneLabel := NewLabel(), NewLabel()
+	n.Code = utils.AppendCode(
+		n.Code,
+		fmt.Sprintf("%s, %s, 0", tac.CALL, RuntimeFunc("newg")),
+		fmt.Sprintf("%s, %s", tac.STORE, g),
+		fmt.Sprintf("%s, %s, %s", tac.FORK, childLabel, g),
+		fmt.Sprintf("%s, %s", tac.ARG, g),
+		fmt.Sprintf("%s, %s, 1", tac.CALL, RuntimeFunc("ready")),
+		fmt.Sprintf("%s, %s", tac.JMP, doneLabel),
+		fmt.Sprintf("%s, %s", tac.LABEL, childLabel),
+		call.Code,
+		fmt.Sprintf("%s, %s, 0", tac.CALL, RuntimeFunc("goexit")),
+		fmt.Sprintf("%s, %s", tac.LABEL, doneLabel),
+	)
+	return n, nil
+}
+
+// chanRange returns the code for receiving the values of a channel until it
+// is closed, which is of the form -
+//	{ initialization, next value, binding of the value }
+// along with the temporary which becomes 0 when the iteration ends.
+func chanRange(ch, elem string) (string, [3][]string) {
+	val, ok := NewTmp(), NewTmp()
+	var code [3][]string
+	code[1] = []string{
+		fmt.Sprintf("%s, %s", tac.ARG, ch),
+		fmt.Sprintf("%s, %s, 1", tac.CALL, RuntimeFunc("chanrecv")),
+		fmt.Sprintf("%s, %s", tac.STORE, val),
+		fmt.Sprintf("%s, %s, 1", tac.STORE, ok),
+	}
+	if elem != "" {
+		code[2] = []string{fmt.Sprintf("=, %s, %s", elem, val)}
+	}
+	return ok, code
+}
+
+// NewCommCase returns the case of a comm clause of a select statement. The
+// code of the returned node is of the form -
+//	{ evaluation code, operation, binding code }
+// where the operation is "send, channel, value", "recv, channel, value, ok"
+// or "default", and the binding code assigns the value received. A new scope
+// begins at the case, which ends with the clause.
+// The accepted variants of the variadic arguments (args) are -
+//	- Channel, Value
+//	- UnaryOp, Channel
+//	- ExpressionList, Channel
+//	- IdentifierList, Channel
+//	- IdentifierList, Channel
+//	- (none)
+// The cardinal argument `typ` determines the index of the production rule
+// invoked starting from top.
+func NewCommCase(typ int, args ...*Node) (*Node, error) {
+	NewScope()
+	switch typ {
+	case 0:
+		ch, val := args[0], args[1]
+		v, code, err := sendValue(ch.Place, val.Place)
+		if err != nil {
+			return nil, err
+		}
+		evalCode := utils.AppendCode(ch.Code, val.Code, code)
+		return &Node{"", []string{
+			strings.Join(evalCode, "\n"),
+			fmt.Sprintf("send, %s, %s", ch.Place, v),
+			"",
+		}}, nil
+	case 1:
+		if args[0].Place != ARROW {
+			return nil, fmt.Errorf("select case must be receive, send or assign recv")
+		}
+	case 5:
+		return &Node{"", []string{"", "default", ""}}, nil
+	}
+	ch := args[len(args)-1]
+	recv, err := newRecv(ch)
+	if err != nil {
+		return nil, err
+	}
+	ok := received[recv.Place].ok
+	var bind *Node
+	switch typ {
+	case 2:
+		bind, err = NewAssignStmt(1, "=", args[0], &Node{recv.Place, []string{}})
+	case 3:
+		bind, err = NewAssignStmt(2, "=", args[0], &Node{recv.Place, []string{}})
+	case 4:
+		bind, err = NewShortDecl(args[0], &Node{recv.Place, []string{}})
+	default:
+		bind = &Node{"", []string{}}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Node{"", []string{
+		strings.Join(ch.Code, "\n"),
+		fmt.Sprintf("recv, %s, %s, %s", ch.Place, recv.Place, ok),
+		strings.Join(bind.Code, "\n"),
+	}}, nil
+}
+
+// NewCommClause returns a comm clause of a select statement, whose code is
+// that of the case followed by the code of the statements.
+func NewCommClause(commCase, stmtList *Node) (*Node, error) {
+	currScope = currScope.parent // end of the scope of the clause
+	return &Node{"", append(commCase.Code, strings.Join(stmtList.Code, "\n"))}, nil
+}
+
+// AppendCommClause appends a comm clause to a list of same.
+func AppendCommClause(clause, clauseList *Node) (*Node, error) {
+	return &Node{"", append(clause.Code, clauseList.Code...)}, nil
+}
+
+// NewSelectStmt returns a select statement. The cases are passed to the
+// runtime in an array, and the index of the case which proceeds is returned.
+func NewSelectStmt(clauses *Node) (*Node, error) {
+	n := &Node{"", []string{}}
+	afterLabel := NewLabel()
+	cases, index := NewTmp(), NewTmp()
+	// The code of clauses is of the form -
+	//	{ evaluation code, operation, binding code, statement code }
+	// repeated for each of the comm clauses.
+	ops := [][]string{}
+	hasDefault := false
+	for k := 0; k < len(clauses.Code); k += 4 {
+		n.Code = append(n.Code, clauses.Code[k])
+		op := utils.SplitAndSanitize(clauses.Code[k+1], ",")
+		if op[0] == "default" {
+			if hasDefault {
+				return nil, fmt.Errorf("multiple defaults in select")
+			}
+			hasDefault = true
+		} else {
+			ops = append(ops, op)
+		}
+	}
+	n.Code = append(n.Code, runtimeCall(cases, "malloc", strconv.Itoa(caseWords*tac.WordSize*len(ops)))...)
+	for i, op := range ops {
+		n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, %d, %s", tac.INTO, cases, cases, caseWords*i+caseChan, op[1]))
+		if op[0] == "send" {
+			n.Code = append(n.Code,
+				fmt.Sprintf("%s, %s, %s, %d, 1", tac.INTO, cases, cases, caseWords*i+caseSend),
+				fmt.Sprintf("%s, %s, %s, %d, %s", tac.INTO, cases, cases, caseWords*i+caseElem, op[2]),
+			)
+		}
+	}
+	block := 1
+	if hasDefault {
+		block = 0
+	}
+	n.Code = append(n.Code, runtimeCall(index, "selectgo", cases, strconv.Itoa(len(ops)), strconv.Itoa(block))...)
+
+	caseLabels := []string{}
+	i := 0
+	for k := 0; k < len(clauses.Code); k += 4 {
+		caseLabel := NewLabel()
+		caseLabels = append(caseLabels, caseLabel)
+		if clauses.Code[k+1] == "default" {
+			n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, -1", tac.BEQ, caseLabel, index))
+			continue
+		}
+		n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, %d", tac.BEQ, caseLabel, index, i))
+		i++
+	}
+	n.Code = append(n.Code, fmt.Sprintf("%s, %s", tac.JMP, afterLabel))
+
+	i = 0
+	for k, caseLabel := range caseLabels {
+		n.Code = append(n.Code, fmt.Sprintf("%s, %s", tac.LABEL, caseLabel))
+		if op := utils.SplitAndSanitize(clauses.Code[4*k+1], ","); op[0] != "default" {
+			if op[0] == "recv" {
+				n.Code = append(n.Code,
+					fmt.Sprintf("%s, %s, %s, %d", tac.FROM, op[2], cases, caseWords*i+caseElem),
+					fmt.Sprintf("%s, %s, %s, %d", tac.FROM, op[3], cases, caseWords*i+caseOk),
+				)
+			}
+			i++
+		}
+		for _, stmt := range strings.Split(clauses.Code[4*k+2]+"\n"+clauses.Code[4*k+3], "\n") {
+			switch stmt = strings.TrimSpace(stmt); stmt {
+			case "":
+			case "break":
+				// A break statement terminates the select statement.
+				n.Code = append(n.Code, fmt.Sprintf("%s, %s", tac.JMP, afterLabel))
+			default:
+				n.Code = append(n.Code, stmt)
+			}
+		}
+		n.Code = append(n.Code, fmt.Sprintf("%s, %s", tac.JMP, afterLabel))
+	}
+	n.Code = append(n.Code, fmt.Sprintf("%s, %s", tac.LABEL, afterLabel))
+	n.Place = afterLabel
+	branchTargets[afterLabel] = ""
+	return n, nil
+}
@@ -0,0 +1,183 @@
+// This file implements the types byte and rune, the rune literals and the
+// indexing of strings. A string is represented by the address of its contents,
+// which are terminated by a null byte. The values of both the types occupy a
+// word, where a byte is kept in the range [0, 255] by truncating the results of
+// the operations on bytes.
+
+package ast
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/shivansh/gogo/src/tac"
+	"github.com/shivansh/gogo/src/utils"
+)
+
+// isInteger determines whether a kind is an integer kind.
+func isInteger(kind symkind) bool {
+	return kind == INTEGER || kind == BYTE || kind == RUNE
+}
+
+// NewRuneLit returns a rune literal, which is an untyped integer constant
+// holding the value of the Unicode code point.
+func NewRuneLit(lit string) (*Node, error) {
+	val, _, tail, err := strconv.UnquoteChar(lit[1:len(lit)-1], '\'')
+	if err != nil || tail != "" {
+		return nil, fmt.Errorf("invalid rune literal %s", lit)
+	}
+	return &Node{strconv.Itoa(int(val)), []string{}}, nil
+}
+
+// printRune returns the code for printing the UTF-8 encoding of a rune. A
+// constant is encoded during compilation, and an ASCII character is printed as
+// is. The runtime encodes the remaining runes, hence it prints the bytes as
+// they are.
+func printRune(place string) []string {
+	val, err := strconv.Atoi(place)
+	if err != nil && PkgName != "runtime" {
+		return []string{
+			fmt.Sprintf("%s, %s", tac.ARG, place),
+			fmt.Sprintf("%s, %s, 1", tac.CALL, RuntimeFunc("printrune")),
+		}
+	}
+	if err != nil || val >= 0 && val < utf8.RuneSelf {
+		return []string{fmt.Sprintf("%s, %s", tac.PRINTCHAR, place)}
+	}
+	s, code := strValue(fmt.Sprintf("%s:%s", STR, strconv.Quote(string(rune(val)))))
+	return append(code, fmt.Sprintf("%s, %s", tac.PRINTSTR, s))
+}
+
+// intKind evaluates the kind of the result of a binary operation on integers.
+// A constant takes the kind of the other operand, and an operand whose kind is
+// not an integer kind is reported. The operands of a shift need not be of the
+// same kind.
+func intKind(op, left, right string) (symkind, error) {
+	kinds := [2]symkind{}
+	for k, v := range []string{left, right} {
+		if kinds[k] = KindOf(v); !isInteger(kinds[k]) {
+			return NIL, ErrOperator(op, exprName(v), typeOf(v))
+		}
+	}
+	switch {
+	case re.MatchString(left):
+		kinds[0] = kinds[1]
+	case re.MatchString(right), op == LSH, op == RSH:
+		kinds[1] = kinds[0]
+	}
+	// A constant takes the type of the other operand, which it has to be
+	// representable by, whereas the count of a shift is untyped.
+	if re.MatchString(left) != re.MatchString(right) {
+		for k, v := range []string{left, right} {
+			if k == 1 && (op == LSH || op == RSH) {
+				continue
+			}
+			if err := representable(v, kinds[k]); err != nil {
+				return NIL, err
+			}
+		}
+	}
+	if kinds[0] != kinds[1] {
+		return NIL, fmt.Errorf("invalid operation: %s %s %s (mismatched types %s and %s)",
+			exprName(left), op, exprName(right), typeOf(left), typeOf(right))
+	}
+	return kinds[0], nil
+}
+
+// truncate returns the code for truncating the value of a variable to its
+// kind, which is required only for the bytes.
+func truncate(place string, kind symkind) []string {
+	if kind != BYTE {
+		return []string{}
+	}
+	return []string{fmt.Sprintf("%s, %s, %s, 255", tac.AND, place, place)}
+}
+
+// representable verifies that a constant can be represented by a value of the
+// given integer kind, where an int (as well as a rune) occupies 32 bits.
+func representable(place string, kind symkind) error {
+	val, err := strconv.ParseInt(place, 10, 64)
+	if err != nil {
+		return nil
+	}
+	switch {
+	case kind == BYTE && (val < 0 || val > math.MaxUint8),
+		isInteger(kind) && (val < math.MinInt32 || val > math.MaxInt32):
+		return fmt.Errorf("constant %d overflows %s", val, GetType(kind))
+	}
+	return nil
+}
+
+// strLen returns the length of a string, along with the code for evaluating
+// it. The length of a string literal is evaluated during compilation.
+func strLen(place string) (string, []string, error) {
+	if GetPrefix(place) == STR {
+		s, err := strconv.Unquote(StripPrefix(place))
+		if err != nil {
+			return "", nil, err
+		}
+		return strconv.Itoa(len(s)), []string{}, nil
+	}
+	length := NewTmp()
+	InsertSymbol(length, INTEGER, length)
+	return length, []string{
+		fmt.Sprintf("%s, %s", tac.ARG, place),
+		fmt.Sprintf("%s, %s, 1", tac.CALL, RuntimeFunc("strlen")),
+		fmt.Sprintf("%s, %s", tac.STORE, length),
+	}, nil
+}
+
+// indexString returns the byte of a string at the given index. The symbol
+// table entry of the byte is of the form -
+//	{ renamedVar, index expression }
+// where the index expression is used for reporting an assignment to it.
+func indexString(n *Node, expr, index *Node) (*Node, error) {
+	length, code, err := strLen(expr.Place)
+	if err != nil {
+		return nil, err
+	}
+	s, declCode := strValue(expr.Place)
+	check, err := indexCheck(index.Place, length)
+	if err != nil {
+		return nil, err
+	}
+	n.Code = utils.AppendCode(
+		expr.Code,
+		index.Code,
+		declCode,
+		code,
+		check,
+		fmt.Sprintf("%s, %s, %s, %s", tac.FROMB, n.Place, s, index.Place),
+	)
+	elem := fmt.Sprintf("%s[%s]", exprName(expr.Place), exprName(index.Place))
+	InsertSymbol(n.Place, BYTE, n.Place, elem)
+	return n, nil
+}
+
+// checkMutable returns an error if a place refers to a constant or to a byte
+// of a string, as the strings are immutable.
+func checkMutable(place string) error {
+	if isConst(place) {
+		return fmt.Errorf("cannot assign to constant %s", StripPrefix(place))
+	}
+	if symEntry, found := Lookup(place); found && symEntry.kind == BYTE && len(symEntry.symbols) > 1 {
+		return fmt.Errorf("cannot assign to %s (strings are immutable)", symEntry.symbols[1])
+	}
+	return nil
+}
+
+// checkIntAssign verifies that a value can be assigned to a variable when any
+// of them is a byte or a rune.
+func checkIntAssign(dst, src string) error {
+	dstKind, srcKind := KindOf(dst), KindOf(src)
+	if re.MatchString(src) {
+		return representable(src, dstKind)
+	}
+	if isInteger(dstKind) && isInteger(srcKind) && dstKind != srcKind {
+		return fmt.Errorf("cannot use %s (type %s) as type %s in assignment",
+			RealName(src), GetType(srcKind), GetType(dstKind))
+	}
+	return nil
+}
@@ -0,0 +1,557 @@
+// This file implements the function literals and function values. A function
+// value is represented by the address of a closure allocated on the heap, which
+// is of the form -
+//	{ address of the function, box of captured variable 0, ... }
+// where a box is a heap allocated word holding the value of a variable which is
+// shared between a function and the function literals declared in it. A call
+// through a function value passes the closure in $v1, which is retrieved by a
+// function literal on entry, and is ignored by the functions declared at top
+// level.
+
+package ast
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/shivansh/gogo/src/tac"
+	"github.com/shivansh/gogo/src/utils"
+)
+
+// funcCtx represents the context of the function (or the function literal)
+// being declared.
+type funcCtx struct {
+	name  string
+	scope *SymInfo // scope of the parameters
+	// env holds the closure of a function literal.
+	env string
+	// captures contains the variables of the enclosing functions which are
+	// referenced by a function literal, in the order of their boxes in its
+	// closure.
+	captures []string
+	// boxed contains the variables of the function which are captured by
+	// the function literals declared in it, or whose addresses are taken.
+	boxed map[string]bool
+	// blocks maps the first member of each struct of the function whose
+	// address is taken to the members of the struct, which are held by a
+	// block allocated on entry. An array member is prefixed by "arr:", as
+	// the block holds its address instead, and a floating-point member is
+	// prefixed by its type. A floating-point variable whose address is
+	// taken is held by a block of its own.
+	blocks map[string][]string
+	// results contains the named results and resultTypes the types of the
+	// results, whereas resultDecl contains the code for declaring the
+	// named results.
+	results     []string
+	resultTypes []string
+	resultDecl  []string
+	// defers contains the code of the calls deferred by the function,
+	// which is run by the code at the label deferExit. The record of the
+	// call being run is held by deferRec.
+	defers    [][]string
+	deferExit string
+	deferRec  string
+	// variadic holds the element type of the final parameter of a variadic
+	// function.
+	variadic string
+	// labels contains the labels of the function.
+	labels map[string]*labelInfo
+}
+
+var (
+	// funcStack contains the contexts of the functions being declared,
+	// the innermost being at the top.
+	funcStack []*funcCtx
+	// funcLits contains the code of the function literals declared in the
+	// current top level function, which is placed after its code.
+	funcLits []string
+	// funcLitIndex is used for naming the function literals.
+	funcLitIndex int
+)
+
+// pushFunc begins the context of a function whose parameters are declared in
+// the current scope.
+func pushFunc() {
+	funcStack = append(funcStack, &funcCtx{
+		scope:  currScope,
+		boxed:  make(map[string]bool),
+		blocks: make(map[string][]string),
+	})
+}
+
+// popFunc ends the context of the innermost function.
+func popFunc() *funcCtx {
+	ctx := currFunc()
+	funcStack = funcStack[:len(funcStack)-1]
+	return ctx
+}
+
+// currFunc returns the context of the innermost function.
+func currFunc() *funcCtx {
+	if len(funcStack) == 0 {
+		return &funcCtx{}
+	}
+	return funcStack[len(funcStack)-1]
+}
+
+// encloses determines whether the scope inner is nested in the scope outer.
+func encloses(outer, inner *SymInfo) bool {
+	for s := inner; s != nil; s = s.parent {
+		if s == outer {
+			return true
+		}
+	}
+	return false
+}
+
+// resolve returns the symbol table entry of a variable. A variable declared in
+// an enclosing function is captured by the function literals which reference
+// it, and is moved to a box by the function declaring it.
+func resolve(v string) (*SymTabEntry, bool) {
+	var scope *SymInfo
+	for s := currScope; s != nil; s = s.parent {
+		if _, ok := s.symTab[v]; ok {
+			scope = s
+			break
+		}
+	}
+	symEntry, found := Lookup(v)
+	if scope == nil || len(funcStack) < 2 {
+		// Globals, functions and types are not captured.
+		return symEntry, found
+	}
+	switch symEntry.kind {
+	case INTEGER, BOOLEAN:
+		if len(symEntry.symbols) > 1 {
+			// Arrays are not captured.
+			return symEntry, found
+		}
+	case SLICE, MAP, FUNCVAL, POINTER, CHANNEL:
+	default:
+		return symEntry, found
+	}
+	// The variable is captured when it is declared in an enclosing function
+	// and not in the innermost one.
+	owner := -1
+	for k := len(funcStack) - 1; k >= 0; k-- {
+		if encloses(funcStack[k].scope, scope) {
+			owner = k
+			break
+		}
+	}
+	if owner == -1 || owner == len(funcStack)-1 {
+		return symEntry, found
+	}
+	renamedVar := symEntry.symbols[0]
+	funcStack[owner].boxed[renamedVar] = true
+	for _, ctx := range funcStack[owner+1:] {
+		if !utils.Contains(ctx.captures, renamedVar) {
+			ctx.captures = append(ctx.captures, renamedVar)
+		}
+	}
+	return symEntry, found
+}
+
+// NewFuncType returns a function type. The place attribute of the returned
+// node is of the form "func:(<param type 0>;...)(<result type 0>;...)". As the
+// values of variadic functions are not supported, neither are their types.
+func NewFuncType(params, result *Node) (*Node, error) {
+	paramTypes := utils.SplitAndSanitize(params.Place, ",")
+	if len(paramTypes) > 0 && GetPrefix(paramTypes[len(paramTypes)-1]) == VRD {
+		return nil, fmt.Errorf("variadic function types are not supported")
+	}
+	results := []string{}
+	if result != nil {
+		results = utils.SplitAndSanitize(result.Place, ",")
+	}
+	return &Node{funcType(paramTypes, results), []string{}}, nil
+}
+
+// funcType returns the function type with the given parameter and result
+// types.
+func funcType(params, results []string) string {
+	return fmt.Sprintf("%s:(%s)(%s)", FNC, strings.Join(params, ";"), strings.Join(results, ";"))
+}
+
+// funcParts returns the parameter and result types of a function type, where
+// the types are separated by the semicolons outside of the parentheses of the
+// function types nested in them.
+func funcParts(typ string) ([]string, []string) {
+	parts, depth, start := [][]string{}, 0, 0
+	s := StripPrefix(typ)
+	for k, c := range s {
+		switch c {
+		case '(':
+			if depth == 0 {
+				parts, start = append(parts, []string{}), k+1
+			}
+			depth++
+		case ';', ')':
+			if depth == 1 {
+				if t := strings.TrimSpace(s[start:k]); t != "" {
+					parts[len(parts)-1] = append(parts[len(parts)-1], t)
+				}
+				start = k + 1
+			}
+			if c == ')' {
+				depth--
+			}
+		}
+	}
+	if len(parts) != 2 {
+		return nil, nil
+	}
+	return parts[0], parts[1]
+}
+
+// sigTypes returns the types of a list of parameters (or results) in the form
+// in which they are compared, which is that of the types of the parameters of
+// a declared function (see paramTypes), where a struct is passed as its
+// members. The function types in the list are compared by their signatures.
+func sigTypes(types []string) []string {
+	sig := []string{}
+	for _, v := range types {
+		kind := GetKind(v)
+		switch {
+		case isStructType(v):
+			sig = append(sig, sigTypes(flatTypes([]string{v}))...)
+		case isNamedType(v), kind == POINTER, isArrayType(v):
+			sig = append(sig, v)
+		case kind == FUNCVAL:
+			params, results := funcParts(v)
+			sig = append(sig, funcType(sigTypes(params), sigTypes(results)))
+		case kind == NIL:
+			sig = append(sig, INT)
+		default:
+			sig = append(sig, GetType(kind))
+		}
+	}
+	return sig
+}
+
+// sameFunc verifies that the value at src can be used where a value of the
+// type typ is expected, when typ is a function type. The signatures of the
+// function types are compared, where the types of the parameters of a function
+// value are only known by their kinds (see paramTypes).
+func sameFunc(typ, src string) bool {
+	typ = underlying(typ)
+	symEntry, ok := funcEntry(src)
+	if GetKind(typ) != FUNCVAL || !ok || !strings.Contains(typ, ":") {
+		return true
+	}
+	wantParams, wantResults := funcParts(typ)
+	haveParams, haveResults := funcParts(underlying(symEntry.symbols[1]))
+	return strings.Join(sigTypes(wantParams), ",") == strings.Join(sigTypes(haveParams), ",") &&
+		strings.Join(sigTypes(wantResults), ",") == strings.Join(sigTypes(haveResults), ",")
+}
+
+// checkFuncAssign verifies that the value at src can be assigned to dst, when
+// dst is a function value.
+func checkFuncAssign(dst, src string) error {
+	if symEntry, ok := funcEntry(dst); ok && !sameFunc(symEntry.symbols[1], src) {
+		return fmt.Errorf("cannot use %s (type %s) as type %s in assignment",
+			exprName(src), typeOf(src), typeOf(dst))
+	}
+	return nil
+}
+
+// funcEntry returns the symbol table entry of a function value, which is of the
+// form -
+//	{ renamedVar, function type }
+// where the function value may also be an element loaded from memory (see
+// deref) or from a map.
+func funcEntry(place string) (*SymTabEntry, bool) {
+	symEntry, found := lookupPlace(place)
+	if !found {
+		return nil, false
+	}
+	switch symEntry.kind {
+	case FUNCVAL:
+		return symEntry, true
+	case DEREF, MAPELEM:
+		if typ := underlying(symEntry.symbols[2]); GetKind(typ) == FUNCVAL {
+			return &SymTabEntry{FUNCVAL, []string{place, typ}}, true
+		}
+	}
+	return nil, false
+}
+
+// funcResults returns the results of a function type in the form used by the
+// symbol table entry of a function.
+func funcResults(typ string) []string {
+	_, results := funcParts(typ)
+	return append([]string{fmt.Sprintf("%d", len(results))}, results...)
+}
+
+// NewFuncLitMarker returns a marker non-terminal used in the production rule
+// for function literals.
+func NewFuncLitMarker(signature *Node) (*Node, error) {
+	ctx := currFunc()
+	if ctx.variadic != "" {
+		return nil, fmt.Errorf("variadic function literals are not supported")
+	}
+	funcLitIndex++
+	ctx.name = fmt.Sprintf("%s.func%d", funcStack[0].name, funcLitIndex)
+	ctx.env = RenameVariable("env")
+	n := &Node{funcType(paramTypes(signature.Code), utils.SplitAndSanitize(signature.Place, ",")), []string{
+		fmt.Sprintf("func, %s", FuncName(ctx.name)),
+	}}
+	n.Code = append(n.Code, paramCode(signature.Code)...)
+	n.Code = append(n.Code, fmt.Sprintf("%s, %s, 1", tac.STORE, ctx.env))
+	return n, nil
+}
+
+// NewFuncLit returns a function literal. The code of the function literal is
+// placed after the enclosing top level function, and the returned node holds
+// the code for allocating its closure.
+func NewFuncLit(marker, body *Node) (*Node, error) {
+	code := funcCode(marker, body)
+	if err := checkBranches(code); err != nil {
+		return nil, err
+	}
+	currScope = currScope.parent // end of the scope of the parameters
+	ctx := popFunc()
+	funcLits = append(funcLits, boxVars(ctx, code)...)
+
+	// The boxes of the captured variables are referred to by "box:<var>"
+	// until the enclosing function determines them.
+	n := &Node{NewTmp(), []string{}}
+	f := NewTmp()
+	n.Code = utils.AppendCode(
+		n.Code,
+		fmt.Sprintf("%s, %d", tac.ARG, (len(ctx.captures)+1)*tac.WordSize),
+		fmt.Sprintf("%s, %s, 1", tac.CALL, RuntimeFunc("malloc")),
+		fmt.Sprintf("%s, %s", tac.STORE, n.Place),
+		fmt.Sprintf("%s, %s, %s", tac.ADDR, f, FuncName(ctx.name)),
+		fmt.Sprintf("%s, %s, %s, 0, %s", tac.INTO, n.Place, n.Place, f),
+	)
+	for k, v := range ctx.captures {
+		n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, %d, box:%s", tac.INTO, n.Place, n.Place, k+1, v))
+	}
+	InsertSymbol(n.Place, FUNCVAL, n.Place, marker.Place)
+	exprTexts[n.Place] = "func literal"
+	return n, nil
+}
+
+// funcValue returns a function value for a place which refers to a function
+// declared at top level (or to a method bound to its receiver), along with the
+// code for allocating its closure. Other places are returned unchanged.
+func funcValue(place string) (string, []string, error) {
+	if GetPrefix(place) == MTH {
+		return methodValue(place)
+	}
+	symEntry, found := globalSymTab[place]
+	if !found || symEntry.kind != FUNCTION {
+		return place, []string{}, nil
+	}
+	returnLen, _ := strconv.Atoi(symEntry.symbols[0])
+	if params := symEntry.symbols[returnLen+1:]; len(params) > 0 && GetPrefix(params[len(params)-1]) == VRD {
+		return "", nil, fmt.Errorf("values of variadic functions are not supported")
+	}
+	c, f := NewTmp(), NewTmp()
+	InsertSymbol(c, FUNCVAL, c, funcType(symEntry.symbols[returnLen+1:], symEntry.symbols[1:returnLen+1]))
+	exprTexts[c] = RealName(place)
+	return c, []string{
+		fmt.Sprintf("%s, %d", tac.ARG, tac.WordSize),
+		fmt.Sprintf("%s, %s, 1", tac.CALL, RuntimeFunc("malloc")),
+		fmt.Sprintf("%s, %s", tac.STORE, c),
+		fmt.Sprintf("%s, %s, %s", tac.ADDR, f, FuncName(place)),
+		fmt.Sprintf("%s, %s, %s, 0, %s", tac.INTO, c, c, f),
+	}, nil
+}
+
+// funcValues applies funcValue to a list of places.
+func funcValues(places []string) ([]string, []string, error) {
+	code := []string{}
+	for k, v := range places {
+		var c []string
+		var err error
+		if places[k], c, err = funcValue(v); err != nil {
+			return nil, nil, err
+		}
+		code = append(code, c...)
+	}
+	return places, code, nil
+}
+
+// newIndirectCall returns a call through a function value.
+func newIndirectCall(symEntry *SymTabEntry, expr, args *Node) (*Node, error) {
+	n := &Node{"", append(expr.Code, args.Code...)}
+	argExpr, code, err := funcValues(utils.SplitAndSanitize(args.Place, ","))
+	if err != nil {
+		return nil, err
+	}
+	n.Code = append(n.Code, code...)
+	if err := callCode(n, tac.CALLR, expr.Place, argExpr, funcResults(symEntry.symbols[1])); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// boxVars rewrites the code of a function so that the variables it shares
+// with function literals are accessed through their boxes. Such a variable is
+// loaded from its box before an instruction referencing it, and is stored back
+// after an instruction modifying it. A new box is allocated whenever a captured
+// variable is declared. The members of a struct whose address is taken are
+// accessed similarly through their words in its block.
+func boxVars(ctx *funcCtx, code []string) []string {
+	if len(ctx.boxed) == 0 && len(ctx.captures) == 0 && len(ctx.blocks) == 0 {
+		return code
+	}
+	lines := []string{}
+	for _, v := range code {
+		lines = append(lines, strings.Split(v, "\n")...)
+	}
+	// The boxes are initialized after the parameters are declared and the
+	// closure is retrieved.
+	k := 0
+	params, declared := make(map[string]bool), make(map[string]bool)
+	for ; k < len(lines); k++ {
+		fields := utils.SplitAndSanitize(lines[k], ",")
+		if len(fields) < 2 || (fields[0] != tac.FUNC && fields[0] != tac.PARAM && fields[1] != ctx.env) {
+			break
+		}
+		params[fields[1]] = true
+	}
+	for _, v := range lines[k:] {
+		if fields := utils.SplitAndSanitize(v, ","); len(fields) > 1 && fields[0] == tac.DECLInt {
+			declared[fields[1]] = true
+		}
+	}
+
+	boxes := make(map[string]string)
+	rename := make(map[string]string)
+	offset := make(map[string]int)
+	out := append([]string{}, lines[:k]...)
+	for i, v := range ctx.captures {
+		boxes[v] = NewTmp()
+		rename[v] = RenameVariable(RealName(v))
+		out = append(out, fmt.Sprintf("%s, %s, %s, %d", tac.FROM, boxes[v], ctx.env, i+1))
+	}
+	boxed := []string{}
+	for v := range ctx.boxed {
+		boxed = append(boxed, v)
+	}
+	sort.Strings(boxed)
+	blocks := []string{}
+	for v := range ctx.blocks {
+		blocks = append(blocks, v)
+	}
+	sort.Strings(blocks)
+	// addrs maps the variables referenced by "box:" to the addresses of
+	// their boxes (or blocks).
+	addrs := make(map[string]string)
+	for v, b := range boxes {
+		addrs[v] = b
+	}
+	arrays := make(map[string]string)
+	kinds := make(map[string]symkind)
+	for _, v := range blocks {
+		b := NewTmp()
+		addrs[v] = b
+		stores, off := []string{}, 0
+		for _, m := range ctx.blocks[v] {
+			prefix := ""
+			if strings.Contains(m, ":") {
+				prefix, m = GetPrefix(m), StripPrefix(m)
+			}
+			kind := GetKind(prefix)
+			store := fmt.Sprintf("%s, %s, %s, %d, %s", memOp(tac.INTO, kind), b, b, off, m)
+			if prefix == ARR {
+				// The address of an array is stored once the array
+				// is declared.
+				arrays[m] = store
+			} else {
+				boxes[m], rename[m], offset[m], kinds[m] = b, m, off, kind
+			}
+			if params[m] {
+				stores = append(stores, store)
+			}
+			off += words([]symkind{kind})
+		}
+		out = append(out, newBlock(b, off)...)
+		out = append(out, stores...)
+	}
+	for _, v := range boxed {
+		boxes[v] = NewTmp()
+		rename[v] = v
+		addrs[v] = boxes[v]
+		if params[v] {
+			out = append(out, newBox(boxes[v])...)
+			out = append(out, fmt.Sprintf("%s, %s, %s, 0, %s", tac.INTO, boxes[v], boxes[v], v))
+		} else if !declared[v] {
+			out = append(out, newBox(boxes[v])...)
+		}
+	}
+
+	for _, line := range lines[k:] {
+		fields := utils.Split(line, ",")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		load, store := []string{}, []string{}
+		changed := false
+		for i, v := range fields[1:] {
+			if strings.HasPrefix(v, "box:") {
+				fields[i+1] = addrs[strings.TrimPrefix(v, "box:")]
+				changed = true
+				continue
+			}
+			b, ok := boxes[v]
+			if !ok {
+				continue
+			}
+			changed = true
+			fields[i+1] = rename[v]
+			if i == 0 && fields[0] == tac.DECLInt && ctx.boxed[v] {
+				load = append(load, newBox(b)...)
+			} else if s := fmt.Sprintf("%s, %s, %s, %d", memOp(tac.FROM, kinds[v]), rename[v], b, offset[v]); !utils.Contains(load, s) {
+				load = append(load, s)
+			}
+			if i == 0 && !readsDst(fields[0]) {
+				store = append(store, fmt.Sprintf("%s, %s, %s, %d, %s", memOp(tac.INTO, kinds[v]), b, b, offset[v], rename[v]))
+			}
+		}
+		if fields[0] == tac.DECL && arrays[fields[1]] != "" {
+			store = append(store, arrays[fields[1]])
+			changed = true
+		}
+		if !changed {
+			out = append(out, line)
+			continue
+		}
+		out = append(out, load...)
+		out = append(out, strings.Join(fields, ", "))
+		out = append(out, store...)
+	}
+	return out
+}
+
+// readsDst determines whether an operator only reads its destination.
+func readsDst(op string) bool {
+	// A floating-point operator is suffixed by its precision.
+	op, _ = tac.SplitOp(op)
+	switch op {
+	case tac.ARG, tac.RET, tac.EXIT, tac.CALLR, tac.INTO, tac.PRINTINT, tac.PRINTSTR,
+		tac.PRINTFLOAT, tac.PRINTDOUBLE, tac.BEQ, tac.BNE, tac.BLT, tac.BLE, tac.BGT, tac.BGE:
+		return true
+	}
+	return false
+}
+
+// newBox returns the code for allocating a box.
+func newBox(b string) []string {
+	return newBlock(b, 1)
+}
+
+// newBlock returns the code for allocating a block of the given number of
+// words.
+func newBlock(b string, n int) []string {
+	return []string{
+		fmt.Sprintf("%s, %d", tac.ARG, n*tac.WordSize),
+		fmt.Sprintf("%s, %s, 1", tac.CALL, RuntimeFunc("malloc")),
+		fmt.Sprintf("%s, %s", tac.STORE, b),
+	}
+}
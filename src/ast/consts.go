@@ -0,0 +1,238 @@
+// This file implements the constant declarations. A constant does not occupy
+// any storage, instead its value is substituted wherever it is used so that
+// the expressions involving constants are evaluated during compilation. The
+// symbol table entry of a constant is of the form -
+//	{ value, type }
+// where the type is empty for an untyped constant.
+
+package ast
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/shivansh/gogo/src/utils"
+)
+
+var (
+	// constDecl determines whether a constant declaration is being parsed,
+	// which is the only place where iota is defined.
+	constDecl bool
+	// iotaVal is the index of the constant specification being parsed.
+	iotaVal int
+	// prevConst is the type and the expression list of the last constant
+	// specification, which is repeated when a specification in a group
+	// omits its expression list.
+	prevConst *Node
+	// iotaExprs holds the expressions which depend on iota. The place of
+	// such an expression is of the form "iota:<index in iotaExprs>", and it
+	// is evaluated separately for each specification in which it occurs.
+	iotaExprs []func(int) (*Node, error)
+
+	ErrMissingInit = errors.New("missing init expr for const declaration")
+)
+
+// NewConstMarker marks the beginning of a constant declaration.
+func NewConstMarker() (*Node, error) {
+	constDecl = true
+	iotaVal = 0
+	prevConst = nil
+	return &Node{"", []string{}}, nil
+}
+
+// NewConstDecl returns a constant declaration, which does not generate any
+// code.
+func NewConstDecl() (*Node, error) {
+	constDecl = false
+	iotaExprs = nil
+	return &Node{"", []string{}}, nil
+}
+
+// NewConstSpec returns a constant specification. When the expression list is
+// omitted, the type and the expression list of the previous specification in
+// the group are repeated.
+func NewConstSpec(typ int, args ...*Node) (*Node, error) {
+	n := &Node{"", []string{}}
+	switch typ {
+	case 0:
+		if prevConst == nil {
+			return nil, ErrMissingInit
+		}
+	case 1:
+		prevConst = &Node{"", utils.SplitAndSanitize(args[1].Place, ",")}
+		n.Code = args[1].Code
+	case 2:
+		if GetKind(args[1].Place) == NIL {
+			return nil, fmt.Errorf("invalid constant type %s", args[1].Place)
+		}
+		prevConst = &Node{args[1].Place, utils.SplitAndSanitize(args[2].Place, ",")}
+		n.Code = args[2].Code
+	}
+	idents, expr := args[0].Code, prevConst.Code
+	if len(idents) > len(expr) {
+		return nil, ErrMissingInit
+	} else if len(idents) < len(expr) {
+		return nil, errors.New("extra init expr")
+	}
+	for k, v := range idents {
+		val, err := evalIota(&Node{expr[k], n.Code}, iotaVal)
+		if err != nil {
+			return nil, err
+		}
+		place, ok := constValue(val.Place, val.Code)
+		if !ok {
+			return nil, fmt.Errorf("const initializer for %s is not a constant", v)
+		}
+		if prevConst.Place != "" {
+			if place, err = typedConst(place, GetKind(prevConst.Place)); err != nil {
+				return nil, err
+			}
+		}
+		if v != "_" {
+			InsertSymbol(v, CONSTANT, place, prevConst.Place)
+		}
+	}
+	iotaVal++
+	return &Node{"", []string{}}, nil
+}
+
+// NewIota returns the place of iota, whose value is the index of the constant
+// specification in which it occurs.
+func NewIota() *Node {
+	return iotaExpr(func(val int) (*Node, error) {
+		return &Node{strconv.Itoa(val), []string{}}, nil
+	})
+}
+
+// iotaExpr returns an expression which depends on iota.
+func iotaExpr(eval func(int) (*Node, error)) *Node {
+	iotaExprs = append(iotaExprs, eval)
+	return &Node{fmt.Sprintf("%s:%d", IOTA, len(iotaExprs)-1), []string{}}
+}
+
+// isIotaExpr determines whether any of the places depends on iota.
+func isIotaExpr(places ...string) bool {
+	for _, v := range places {
+		if GetPrefix(v) == IOTA {
+			return true
+		}
+	}
+	return false
+}
+
+// evalIota evaluates an expression for the given value of iota.
+func evalIota(expr *Node, val int) (*Node, error) {
+	if !isIotaExpr(expr.Place) {
+		return expr, nil
+	}
+	k, _ := strconv.Atoi(StripPrefix(expr.Place))
+	return iotaExprs[k](val)
+}
+
+// constValue returns the value of a constant expression, given the code of the
+// expression list it belongs to. A boolean literal is held by a temporary, and
+// the expression list is constant only if its code merely initializes such
+// temporaries. The value of a boolean constant is of the form "bool:<0 or 1>".
+func constValue(place string, code []string) (string, bool) {
+	val := ""
+	for _, v := range code {
+		fields := utils.SplitAndSanitize(v, ",")
+		if len(fields) != 3 || fields[0] != "=" || KindOf(fields[1]) != BOOLEAN ||
+			(fields[2] != "0" && fields[2] != "1") {
+			val = ""
+			break
+		}
+		if fields[1] == place {
+			val = fields[2]
+		}
+	}
+	switch {
+	case isConst(place):
+		return place, true
+	case val != "":
+		return BOOL + ":" + val, true
+	}
+	return "", false
+}
+
+// isConst determines whether a place holds the value of a constant.
+func isConst(place string) bool {
+	return re.MatchString(place) || GetPrefix(place) == FLT || GetPrefix(place) == STR
+}
+
+// typedConst converts the value of a constant to the given type.
+func typedConst(place string, kind symkind) (string, error) {
+	var err error
+	switch {
+	case isInteger(kind) && (re.MatchString(place) || GetPrefix(place) == FLT):
+		if place, err = intConst(place); err != nil {
+			return "", err
+		}
+		return place, representable(place, kind)
+	case isFloat(kind) && (re.MatchString(place) || GetPrefix(place) == FLT):
+		val, _ := constVal(place)
+		if kind == FLOAT32 {
+			if math.Abs(val) > math.MaxFloat32 {
+				return "", fmt.Errorf("constant %v overflows float32", val)
+			}
+			val = float64(float32(val))
+		}
+		return floatConst(val), nil
+	case kind == STRING && GetPrefix(place) == STR, kind == BOOLEAN && GetPrefix(place) == BOOL:
+		return place, nil
+	}
+	return "", fmt.Errorf("cannot use %s as type %s in constant declaration",
+		StripPrefix(place), GetType(kind))
+}
+
+// constNode returns the value of a constant. A constant whose type is not the
+// default type of its value is held by a temporary of that type.
+func constNode(symEntry *SymTabEntry) *Node {
+	val, typ := symEntry.symbols[0], symEntry.symbols[1]
+	kind := GetKind(typ)
+	if GetPrefix(val) == BOOL {
+		kind = BOOLEAN
+		val = StripPrefix(val)
+	}
+	if isNamedType(typ) {
+		t, code := copyValue(val, typ)
+		return &Node{t, code}
+	}
+	switch kind {
+	case BYTE, RUNE, BOOLEAN:
+		t := NewTmp()
+		InsertSymbol(t, kind, t)
+		return &Node{t, []string{fmt.Sprintf("=, %s, %s", t, val)}}
+	case FLOAT32:
+		t, code := floatValue(val, kind)
+		return &Node{t, code}
+	}
+	return &Node{val, []string{}}
+}
+
+// NewArrayLength returns the length of an array type, which is a non-negative
+// integer constant.
+func NewArrayLength(expr *Node) (*Node, error) {
+	val, err := strconv.Atoi(expr.Place)
+	if err != nil || len(expr.Code) != 0 {
+		return nil, fmt.Errorf("array bound %s must be a constant", exprName(expr.Place))
+	}
+	if val < 0 {
+		return nil, fmt.Errorf("invalid array bound %d", val)
+	}
+	if err := representable(expr.Place, INTEGER); err != nil {
+		return nil, err
+	}
+	return &Node{expr.Place, []string{}}, nil
+}
+
+// NewIntLit returns an integer literal, whose value is placed in decimal.
+func NewIntLit(lit string) (*Node, error) {
+	val, err := strconv.ParseInt(lit, 0, 64)
+	if err != nil {
+		return nil, ErrOverflow(lit)
+	}
+	return &Node{strconv.FormatInt(val, 10), []string{}}, nil
+}
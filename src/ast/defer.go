@@ -0,0 +1,286 @@
+// This file implements the defer statements and the recovery from panics. The
+// deferred calls of a goroutine are held by the runtime in a list of records of
+// the form -
+//	{ next record, frame pointer, address of the code running the calls,
+//	  call site, arguments of the call... }
+// where the function value, the receiver and the arguments of a call are saved
+// when the defer statement is executed. A function which defers calls branches
+// to the code running them from each of its return statements, which is also
+// resumed by the runtime during a panic. The code runs the records of the frame
+// of the function in the reverse order of their creation, dispatching on the
+// call site of each one.
+
+package ast
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shivansh/gogo/src/tac"
+	"github.com/shivansh/gogo/src/utils"
+)
+
+// The words of a record of a deferred call which precede its arguments.
+const deferHeader = 4
+
+// NewDeferStmt returns a defer statement.
+func NewDeferStmt(expr, args *Node) (*DeferStmt, error) {
+	n := &DeferStmt{Node{"", utils.AppendCode(nil, expr.Code, args.Code)}}
+	ctx := currFunc()
+	if ctx.deferExit == "" {
+		ctx.deferExit, ctx.deferRec = NewLabel(), NewTmp()
+	}
+	callee := expr.Place
+	values, spreads := spreadArgs(utils.SplitAndSanitize(args.Place, ","))
+	recv, method, isMethod := splitMethodRef(callee)
+	if isMethod {
+		// The receiver is evaluated at the defer site, where a pointer
+		// receiver evaluates to the address of the struct.
+		recvArgs, code, err := receiverArgs(recv, method)
+		if err != nil {
+			return nil, err
+		}
+		n.Code = append(n.Code, code...)
+		values = append(recvArgs, values...)
+	} else if isFuncVal(callee) {
+		values = append([]string{callee}, values...)
+	}
+	store, load, places, words, err := deferArgs(values)
+	if err != nil {
+		return nil, err
+	}
+
+	// The record is allocated once the arguments are evaluated.
+	fp, pc, rec := NewTmp(), NewTmp(), NewTmp()
+	site := strconv.Itoa(len(ctx.defers))
+	n.Code = utils.AppendCode(
+		n.Code,
+		fmt.Sprintf("%s, %s", tac.FP, fp),
+		fmt.Sprintf("%s, %s, %s", tac.ADDR, pc, ctx.deferExit),
+		runtimeCall(rec, "deferproc", fp, pc, site, strconv.Itoa(words)),
+	)
+	for _, v := range store {
+		n.Code = append(n.Code, fmt.Sprintf(v, rec))
+	}
+
+	// The call is made with the saved values once they are loaded from the
+	// record.
+	switch {
+	case isMethod:
+		callee = fmt.Sprintf("%s:%s:%s", MTH, places[0], method)
+		places = places[1:]
+	case isFuncVal(callee):
+		callee, places = places[0], places[1:]
+	}
+	if spreads {
+		places = append(places, spread)
+	}
+	call, err := NewPrimaryExprArgs(&Node{callee, []string{}}, &Node{strings.Join(places, ", "), []string{}})
+	if err != nil {
+		return nil, err
+	}
+	code := []string{}
+	for _, v := range load {
+		code = append(code, fmt.Sprintf(v, ctx.deferRec))
+	}
+	ctx.defers = append(ctx.defers, append(code, call.Code...))
+	return n, nil
+}
+
+// isFuncVal determines whether a place refers to a function value, as opposed
+// to a function, a method or a builtin.
+func isFuncVal(place string) bool {
+	if _, found := globalSymTab[place]; found || isBuiltin(place) {
+		return false
+	}
+	symEntry, found := Lookup(RealName(place))
+	return found && symEntry.kind == FUNCVAL
+}
+
+// deferArgs returns the code for saving the values passed to a deferred call
+// in its record and for loading them into the returned places, along with the
+// number of words saved. The code is formatted with the record. Constants are
+// not saved, and a struct is saved as its members.
+func deferArgs(values []string) ([]string, []string, []string, int, error) {
+	store, load, places := []string{}, []string{}, []string{}
+	w := deferHeader
+	save := func(dst, src string) {
+		kind := KindOf(src)
+		store = append(store, fmt.Sprintf("%s, %%[1]s, %%[1]s, %d, %s", memOp(tac.INTO, kind), w, src))
+		load = append(load, fmt.Sprintf("%s, %s, %%s, %d", memOp(tac.FROM, kind), dst, w))
+		w += words([]symkind{kind})
+	}
+	for _, v := range values {
+		if isStruct(v) {
+			t := NewTmp()
+			dst := declareStruct(t, structType(v))
+			for k, member := range members(v) {
+				if _, ok := arrayLen(member); ok {
+					return nil, nil, nil, 0, fmt.Errorf("defer of %s with an array member is not supported",
+						RealName(v))
+				}
+				save(dst[k], member)
+			}
+			places = append(places, t)
+			continue
+		}
+		if isDeferConst(v) {
+			places = append(places, v)
+			continue
+		}
+		t := NewTmp()
+		declareLike(t, v)
+		save(t, v)
+		places = append(places, t)
+	}
+	return store, load, places, w - deferHeader, nil
+}
+
+// isDeferConst determines whether the value at a place is the same whenever it
+// is evaluated, hence it need not be saved for a deferred call. An array is
+// passed as its address, which does not change either.
+func isDeferConst(place string) bool {
+	if symEntry, found := globalSymTab[place]; found && symEntry.kind == FUNCTION {
+		return true
+	}
+	_, isArray := arrayLen(place)
+	return isConst(place) || GetPrefix(place) == STR || GetPrefix(place) == FLT || isNil(place) || isArray
+}
+
+// declareLike declares a temporary of the type of the value at a place.
+func declareLike(t, place string) {
+	if typ, ok := ptrType(place); ok {
+		insertTyped(t, typ, t)
+	} else if symEntry, ok := sliceEntry(place); ok {
+		InsertSymbol(t, SLICE, t, symEntry.symbols[1])
+	} else if symEntry, ok := mapEntry(place); ok {
+		InsertSymbol(t, MAP, t, symEntry.symbols[1:])
+	} else if symEntry, ok := chanEntry(place); ok {
+		InsertSymbol(t, CHANNEL, t, symEntry.symbols[1])
+	} else if symEntry, found := Lookup(place); found && symEntry.kind == MAPELEM {
+		insertTyped(t, symEntry.symbols[2], t)
+	} else if symEntry, ok := funcEntry(place); ok {
+		InsertSymbol(t, FUNCVAL, t, symEntry.symbols[1])
+	} else if kind := KindOf(place); kind == NIL {
+		InsertSymbol(t, INTEGER, t)
+	} else {
+		InsertSymbol(t, kind, t)
+	}
+	if named, ok := namedTypes[place]; ok {
+		namedTypes[t] = named
+	}
+}
+
+// deferCode returns the code of a function which defers calls. A return
+// statement assigns the returned values to the results and branches to the
+// code running the deferred calls, which then returns the results. The results
+// of a function whose results are not named are held by temporaries, which
+// are zeroed on entry as the function returns them after recovering from a
+// panic.
+func deferCode(marker, body *Node) []string {
+	ctx := currFunc()
+	types := flatTypes(ctx.resultTypes)
+	results := flatValues(ctx.results)
+	code := append([]string{}, marker.Code...)
+	if len(results) == 0 {
+		for _, v := range types {
+			t := NewTmp()
+			insertTyped(t, v, t)
+			code = append(code, zeroValue(GetKind(v), t)...)
+			results = append(results, t)
+		}
+	}
+	code = utils.AppendCode(code, ctx.resultDecl)
+	for _, v := range body.Code {
+		for _, stmt := range strings.Split(v, "\n") {
+			fields := utils.SplitAndSanitize(stmt, ",")
+			if len(fields) == 0 || fields[0] != tac.RET {
+				code = append(code, stmt)
+				continue
+			}
+			code = append(code, assignResults(results, types, fields[1:])...)
+			code = append(code, fmt.Sprintf("%s, %s", tac.JMP, ctx.deferExit))
+		}
+	}
+
+	// The records of the frame are run until none remains, where the code
+	// of the last call site follows the branches to the others.
+	fp, site, done := NewTmp(), NewTmp(), NewLabel()
+	code = utils.AppendCode(
+		code,
+		fmt.Sprintf("%s, %s", tac.LABEL, ctx.deferExit),
+		fmt.Sprintf("%s, %s", tac.FP, fp),
+		runtimeCall(ctx.deferRec, "deferpop", fp),
+		fmt.Sprintf("%s, %s, %s, 0", tac.BEQ, done, ctx.deferRec),
+		fmt.Sprintf("%s, %s, %s, 3", tac.FROM, site, ctx.deferRec),
+	)
+	labels := []string{}
+	for k := range ctx.defers {
+		labels = append(labels, NewLabel())
+		if k < len(ctx.defers)-1 {
+			code = append(code, fmt.Sprintf("%s, %s, %s, %d", tac.BEQ, labels[k], site, k))
+		}
+	}
+	for k := len(ctx.defers) - 1; k >= 0; k-- {
+		code = append(code, fmt.Sprintf("%s, %s", tac.LABEL, labels[k]))
+		code = utils.AppendCode(code, ctx.defers[k], fmt.Sprintf("%s, %s", tac.JMP, ctx.deferExit))
+	}
+	return append(code,
+		fmt.Sprintf("%s, %s", tac.LABEL, done),
+		fmt.Sprintf("%s, %s", tac.ARG, fp),
+		fmt.Sprintf("%s, %s, 1", tac.CALL, RuntimeFunc("deferreturn")),
+		retCode(results),
+	)
+}
+
+// assignResults returns the code for assigning the values returned by a return
+// statement to the results of a function. The values which are themselves
+// results are copied first, so that they are not overwritten by the others.
+func assignResults(results, types, values []string) []string {
+	code := []string{}
+	isResult := make(map[string]bool)
+	for _, v := range results {
+		isResult[v] = true
+	}
+	for k, v := range values {
+		if isResult[v] && v != results[k] {
+			t := NewTmp()
+			code = append(code, fmt.Sprintf("%s, %s, %s", moveOp(GetKind(types[k])), t, v))
+			values[k] = t
+		}
+	}
+	for k, v := range values {
+		if v != results[k] {
+			code = append(code, fmt.Sprintf("%s, %s, %s", moveOp(GetKind(types[k])), results[k], v))
+		}
+	}
+	return code
+}
+
+// isIfaceExpr determines whether any of the places refers to a value returned
+// by recover.
+func isIfaceExpr(places ...string) bool {
+	for _, v := range places {
+		if KindOf(v) == INTERFACE {
+			return true
+		}
+	}
+	return false
+}
+
+// ifaceOperands verifies the operands of a comparison involving a value
+// returned by recover, which can only be compared for equality with nil.
+func ifaceOperands(op, left, right string) error {
+	if op != EQ && op != NEQ {
+		if KindOf(left) != INTERFACE {
+			left = right
+		}
+		return ErrOperator(op, exprName(left), IFC)
+	}
+	if !isNil(left) && !isNil(right) {
+		return fmt.Errorf("invalid operation: %s %s %s (comparison of %s values is not supported)",
+			exprName(left), op, exprName(right), IFC)
+	}
+	return nil
+}
@@ -6,9 +6,16 @@ import (
 )
 
 var (
-	ErrDeclArr    = errors.New("use short declaration for declaring arrays")
-	ErrDeclStruct = errors.New("use short declaration for declaring structs")
-	ErrShortDecl  = errors.New("no new variables on left side of :=")
+	ErrDeclArr     = errors.New("use short declaration for declaring arrays")
+	ErrDeclStruct  = errors.New("use short declaration for declaring structs")
+	ErrShortDecl   = errors.New("no new variables on left side of :=")
+	ErrDivByZero   = errors.New("division by zero")
+	ErrUntypedNil  = errors.New("use of untyped nil")
+	ErrGlobalFunc  = errors.New("function values can only be assigned inside functions")
+	ErrGlobalStrct = errors.New("structs can only be initialized inside functions")
+	ErrGlobalPtr   = errors.New("pointers can only be initialized inside functions")
+	ErrGlobalArr   = errors.New("arrays can only be initialized inside functions")
+	ErrGlobalChan  = errors.New("channels can only be initialized inside functions")
 )
 
 // ErrUndefined returns an undefined variable error.
@@ -16,6 +23,16 @@ func ErrUndefined(varName string) error {
 	return fmt.Errorf("undefined: %s", varName)
 }
 
+// ErrOverflow returns a constant overflow error.
+func ErrOverflow(val string) error {
+	return fmt.Errorf("constant %s overflows int", val)
+}
+
+// ErrRedeclared returns a redeclaration error.
+func ErrRedeclared(name string) error {
+	return fmt.Errorf("%s redeclared in this block", name)
+}
+
 // ErrCountMismatch returns an assignment count mismatch error.
 func ErrCountMismatch(leftCount, rightCount int) error {
 	return fmt.Errorf("assignment count mismatch: %d = %d", leftCount, rightCount)
@@ -30,3 +47,35 @@ func ErrInvalidFunc(name string, typ string) error {
 func ErrIndirection(varName, varType string) error {
 	return fmt.Errorf("invalid indirect of %s (type %s)", varName, varType)
 }
+
+// ErrOperator returns an invalid operation error for an operator which is not
+// defined on the type of its operand.
+func ErrOperator(op, varName, varType string) error {
+	return fmt.Errorf("invalid operation: operator %s not defined on %s (type %s)", op, varName, varType)
+}
+
+// ErrNonBoolCond returns an error for a non-boolean condition.
+func ErrNonBoolCond(varName, varType, stmt string) error {
+	return fmt.Errorf("non-bool %s (type %s) used as %s condition", varName, varType, stmt)
+}
+
+// ErrNegShift returns an invalid negative shift count error.
+func ErrNegShift(count int64) error {
+	return fmt.Errorf("invalid negative shift count: %d", count)
+}
+
+// ErrNegIndex returns an invalid negative index error.
+func ErrNegIndex(index int) error {
+	return fmt.Errorf("invalid argument: index %d must not be negative", index)
+}
+
+// ErrArgCount returns an error for a call to a builtin with a wrong number of
+// arguments.
+func ErrArgCount(name string, have, want int) error {
+	return fmt.Errorf("wrong number of arguments to %s: have %d, want %d", name, have, want)
+}
+
+// ErrArgType returns an error for an argument of invalid type to a builtin.
+func ErrArgType(name, varName string) error {
+	return fmt.Errorf("invalid argument %s for %s", RealName(varName), name)
+}
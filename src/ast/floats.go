@@ -0,0 +1,472 @@
+// This file implements the floating-point types float32 and float64. Their
+// values are operated upon by the IR operators suffixed by the precision of the
+// operands, i.e. ".s" for float32 and ".d" for float64. The floating-point
+// constants are untyped, and take the type of the other operand of a binary
+// operation, or float64 when the type cannot be determined.
+
+package ast
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/shivansh/gogo/src/tac"
+)
+
+// isFloat determines whether a kind is a floating-point kind.
+func isFloat(kind symkind) bool {
+	return kind == FLOAT32 || kind == FLOAT64
+}
+
+// isFloatExpr determines whether any of the places is a floating-point value.
+func isFloatExpr(places ...string) bool {
+	for _, v := range places {
+		if isFloat(KindOf(v)) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewFloatLit returns a floating-point literal. The place attribute of the
+// returned node is of the form "float:<value>".
+func NewFloatLit(lit string) (*Node, error) {
+	val, err := strconv.ParseFloat(lit, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &Node{floatConst(val), []string{}}, nil
+}
+
+// floatConst returns the place of a floating-point constant.
+func floatConst(val float64) string {
+	return FLT + ":" + tac.F64(val).StrVal()
+}
+
+// constVal returns the value of an integer or a floating-point constant.
+func constVal(place string) (float64, bool) {
+	if re.MatchString(place) || GetPrefix(place) == FLT {
+		val, err := strconv.ParseFloat(strings.TrimPrefix(place, FLT+":"), 64)
+		return val, err == nil
+	}
+	return 0, false
+}
+
+// intConst converts a floating-point constant to an integer constant, which is
+// permitted only when the value is integral.
+func intConst(place string) (string, error) {
+	if GetPrefix(place) != FLT {
+		return place, nil
+	}
+	val, _ := constVal(place)
+	if val != math.Trunc(val) || val > math.MaxInt32 || val < math.MinInt32 {
+		return "", fmt.Errorf("constant %s truncated to integer", StripPrefix(place))
+	}
+	return strconv.Itoa(int(val)), nil
+}
+
+// floatOp returns the IR operator for an operation on floating-point operands
+// of the given kind.
+func floatOp(op string, kind symkind) string {
+	if kind == FLOAT32 {
+		return op + ".s"
+	}
+	return op + ".d"
+}
+
+// moveOp returns the IR operator for copying a value of the given kind.
+func moveOp(kind symkind) string {
+	if isFloat(kind) {
+		return floatOp(tac.EQ, kind)
+	}
+	return tac.EQ
+}
+
+// floatKind evaluates the kind of the operands of a binary operation involving
+// a floating-point value. A constant takes the kind of the other operand.
+func floatKind(op, left, right string) (symkind, error) {
+	leftKind, rightKind := KindOf(left), KindOf(right)
+	errMismatch := fmt.Errorf("invalid operation: %s %s %s (mismatched types %s and %s)",
+		exprName(left), op, exprName(right),
+		typeOf(left), typeOf(right))
+	_, leftConst := constVal(left)
+	_, rightConst := constVal(right)
+	switch {
+	case leftConst && rightConst:
+		return FLOAT64, nil
+	case leftConst:
+		leftKind = rightKind
+	case rightConst:
+		rightKind = leftKind
+	}
+	// A constant takes the type of the other operand, which is numeric.
+	if leftKind != rightKind || !isFloat(leftKind) && !isInteger(leftKind) {
+		return NIL, errMismatch
+	}
+	return leftKind, nil
+}
+
+// typeName returns the name of the type of a kind, where a value whose kind
+// cannot be determined is reported as being of an invalid type.
+func typeName(kind symkind) string {
+	if kind == NIL {
+		return "invalid type"
+	}
+	return GetType(kind)
+}
+
+// floatOperand returns the operand of an IR statement for a floating-point
+// value, where a constant is written as a decimal number.
+func floatOperand(place string) string {
+	if val, ok := constVal(place); ok {
+		return tac.F64(val).StrVal()
+	}
+	return place
+}
+
+// floatValue returns a variable holding a floating-point value of the given
+// kind, along with the code for declaring it when the value is a constant.
+func floatValue(place string, kind symkind) (string, []string) {
+	if _, ok := constVal(place); !ok {
+		return place, []string{}
+	}
+	t := NewTmp()
+	InsertSymbol(t, kind, t)
+	return t, []string{fmt.Sprintf("%s, %s, %s", floatOp(tac.EQ, kind), t, floatOperand(place))}
+}
+
+// assignFloat returns the code for assigning a value to a variable of the
+// given floating-point kind.
+func assignFloat(dst string, kind symkind, src string) (string, error) {
+	if _, ok := constVal(src); !ok {
+		if srcKind := KindOf(src); srcKind != kind && srcKind != NIL {
+			return "", fmt.Errorf("cannot use %s (type %s) as type %s in assignment",
+				RealName(src), GetType(srcKind), GetType(kind))
+		}
+	}
+	return fmt.Sprintf("%s, %s, %s", floatOp(tac.EQ, kind), dst, floatOperand(src)), nil
+}
+
+// foldFloat evaluates a binary operation on floating-point constants.
+func foldFloat(op string, leftval, rightval float64) (float64, error) {
+	switch op {
+	case ADD:
+		return leftval + rightval, nil
+	case SUB:
+		return leftval - rightval, nil
+	case AST:
+		return leftval * rightval, nil
+	case DIV:
+		if rightval == 0 {
+			return 0, ErrDivByZero
+		}
+		return leftval / rightval, nil
+	default:
+		return 0, fmt.Errorf("invalid operation: operator %s not defined on untyped float", op)
+	}
+}
+
+// floatOpCode returns the IR statements which evaluate "left op right" into
+// dst, where the operands are floating-point values of the given kind.
+func floatOpCode(op, dst, left, right string, kind symkind) ([]string, error) {
+	switch op {
+	case ADD, SUB, DIV:
+	case AST:
+		op = tac.MUL
+	default:
+		varName := left
+		if _, ok := constVal(left); ok {
+			varName = right
+		}
+		return nil, ErrOperator(op, RealName(varName), GetType(kind))
+	}
+	if val, ok := constVal(right); ok && val == 0 && op == DIV {
+		return nil, ErrDivByZero
+	}
+	return []string{fmt.Sprintf("%s, %s, %s, %s", floatOp(op, kind), dst, floatOperand(left),
+		floatOperand(right))}, nil
+}
+
+// newFloatArith returns an arithmetic expression involving a floating-point
+// value. An expression on integer values, where a floating-point constant is
+// converted to an integer, is returned by NewArithExpr.
+func newFloatArith(op string, leftexpr, rightexpr *Node) (*Node, error) {
+	n := &Node{"", append(leftexpr.Code, rightexpr.Code...)}
+	leftval, leftConst := constVal(leftexpr.Place)
+	rightval, rightConst := constVal(rightexpr.Place)
+	if leftConst && rightConst {
+		val, err := foldFloat(op, leftval, rightval)
+		if err != nil {
+			return nil, err
+		}
+		n.Place = floatConst(val)
+		return n, nil
+	}
+	kind, err := floatKind(op, leftexpr.Place, rightexpr.Place)
+	if err != nil {
+		return nil, err
+	}
+	if isInteger(kind) {
+		left, right, err := intOperands(leftexpr.Place, rightexpr.Place)
+		if err != nil {
+			return nil, err
+		}
+		return NewArithExpr(op, &Node{left, leftexpr.Code}, &Node{right, rightexpr.Code})
+	}
+	n.Place = NewTmp()
+	InsertSymbol(n.Place, kind, n.Place)
+	code, err := floatOpCode(op, n.Place, leftexpr.Place, rightexpr.Place, kind)
+	if err != nil {
+		return nil, err
+	}
+	n.Code = append(n.Code, code...)
+	return n, nil
+}
+
+// intOperands converts the floating-point constants among the operands of a
+// binary operation on integers to integer constants.
+func intOperands(left, right string) (string, string, error) {
+	left, err := intConst(left)
+	if err != nil {
+		return "", "", err
+	}
+	right, err = intConst(right)
+	return left, right, err
+}
+
+// newFloatRel returns a relational expression involving a floating-point
+// value, which evaluates to a boolean.
+func newFloatRel(op, leftexpr, rightexpr *Node) (*Node, error) {
+	leftval, leftConst := constVal(leftexpr.Place)
+	rightval, rightConst := constVal(rightexpr.Place)
+	if leftConst && rightConst {
+		val := false
+		switch op.Place {
+		case EQ:
+			val = leftval == rightval
+		case NEQ:
+			val = leftval != rightval
+		case LEQ:
+			val = leftval <= rightval
+		case LT:
+			val = leftval < rightval
+		case GEQ:
+			val = leftval >= rightval
+		case GT:
+			val = leftval > rightval
+		}
+		n, err := NewBoolLit(strconv.FormatBool(val))
+		if err != nil {
+			return nil, err
+		}
+		n.Code = append(append(leftexpr.Code, rightexpr.Code...), n.Code...)
+		return n, nil
+	}
+	kind, err := floatKind(op.Place, leftexpr.Place, rightexpr.Place)
+	if err != nil {
+		return nil, err
+	}
+	if isInteger(kind) {
+		left, right, err := intOperands(leftexpr.Place, rightexpr.Place)
+		if err != nil {
+			return nil, err
+		}
+		return NewRelExpr(op, &Node{left, leftexpr.Code}, &Node{right, rightexpr.Code})
+	}
+	n := &Node{NewTmp(), append(leftexpr.Code, rightexpr.Code...)}
+	InsertSymbol(n.Place, BOOLEAN, n.Place)
+	n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, %s", floatOp(op.Place, kind), n.Place,
+		floatOperand(leftexpr.Place), floatOperand(rightexpr.Place)))
+	return n, nil
+}
+
+// newFloatNeg returns the negation of a floating-point value.
+func newFloatNeg(expr *Node) (*Node, error) {
+	if val, ok := constVal(expr.Place); ok {
+		return &Node{floatConst(-val), expr.Code}, nil
+	}
+	kind := KindOf(expr.Place)
+	n := &Node{NewTmp(), expr.Code}
+	InsertSymbol(n.Place, kind, n.Place)
+	n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s", floatOp(tac.NEG, kind), n.Place, expr.Place))
+	return n, nil
+}
+
+// NewConversion returns a conversion of an expression to the given type. The
+// conversions between the integers and the floating-point values are
+// supported, where the conversion of a floating-point value to an integer
+// truncates it towards zero. An integer can also be converted to a string. A
+// constant is converted during compilation.
+func NewConversion(typ string, expr *Node) (*Node, error) {
+	if isIotaExpr(expr.Place) {
+		return iotaExpr(func(val int) (*Node, error) {
+			expr, err := evalIota(expr, val)
+			if err != nil {
+				return nil, err
+			}
+			return NewConversion(typ, expr)
+		}), nil
+	}
+	n := &Node{"", expr.Code}
+	to, from := GetKind(typ), KindOf(expr.Place)
+	if to == from {
+		n.Place = expr.Place
+		if _, ok := namedTypes[expr.Place]; ok {
+			// The value of a named type is converted to a value of
+			// the unnamed type.
+			var code []string
+			n.Place, code = copyValue(expr.Place, typ)
+			n.Code = append(n.Code, code...)
+		}
+		return n, nil
+	}
+	if to == STRING && (isInteger(from) || from == NIL) {
+		return stringConv(n, expr)
+	}
+	numeric := func(kind symkind) bool {
+		return isInteger(kind) || isFloat(kind)
+	}
+	if !numeric(to) || (!numeric(from) && from != NIL) {
+		return nil, fmt.Errorf("cannot convert %s (type %s) to type %s",
+			exprName(expr.Place), typeOf(expr.Place), typ)
+	}
+	if val, ok := constVal(expr.Place); ok {
+		switch to {
+		case INTEGER:
+			place, err := intConst(expr.Place)
+			if err != nil {
+				return nil, err
+			}
+			n.Place = place
+		case BYTE, RUNE:
+			place, err := intConst(expr.Place)
+			if err != nil {
+				return nil, err
+			}
+			if err := representable(place, to); err != nil {
+				return nil, err
+			}
+			// An untyped constant defaults to int, hence the
+			// converted value is held by a typed temporary.
+			n.Place = NewTmp()
+			InsertSymbol(n.Place, to, n.Place)
+			n.Code = append(n.Code, fmt.Sprintf("=, %s, %s", n.Place, place))
+		case FLOAT32:
+			// An untyped constant defaults to float64, hence the
+			// converted value is held by a typed temporary.
+			var code []string
+			n.Place, code = floatValue(floatConst(float64(float32(val))), to)
+			n.Code = append(n.Code, code...)
+		default:
+			n.Place = floatConst(val)
+		}
+		return n, nil
+	}
+	n.Place = NewTmp()
+	InsertSymbol(n.Place, to, n.Place)
+	if isInteger(to) && !isFloat(from) {
+		// A conversion between integers only changes the type of the
+		// value, besides truncating it to a byte.
+		n.Code = append(n.Code, fmt.Sprintf("=, %s, %s", n.Place, expr.Place))
+		n.Code = append(n.Code, truncate(n.Place, to)...)
+		return n, nil
+	}
+	// The IR operator of a conversion is of the form "cvt.<to>.<from>",
+	// where an integer is denoted by "w".
+	precision := func(kind symkind) string {
+		switch kind {
+		case FLOAT32:
+			return "s"
+		case FLOAT64:
+			return "d"
+		}
+		return "w"
+	}
+	n.Code = append(n.Code, fmt.Sprintf("%s.%s.%s, %s, %s", tac.CVT, precision(to), precision(from),
+		n.Place, expr.Place))
+	n.Code = append(n.Code, truncate(n.Place, to)...)
+	return n, nil
+}
+
+// floatAssignOp returns the code for an assignment operation "dst op= src"
+// involving a floating-point value.
+func floatAssignOp(op, dst, src string) ([]string, error) {
+	kind, err := floatKind(op, dst, src)
+	if err != nil {
+		return nil, err
+	}
+	if isInteger(kind) {
+		src, err := intConst(src)
+		if err != nil {
+			return nil, err
+		}
+		return binaryOpCode(op, dst, dst, src)
+	}
+	return floatOpCode(op, dst, dst, src, kind)
+}
+
+// floatAssign returns the code for an assignment involving a floating-point
+// value, where a floating-point constant can be assigned to an integer if it
+// is integral.
+func floatAssign(dst, src string) (string, error) {
+	kind := KindOf(dst)
+	if isFloat(kind) {
+		return assignFloat(dst, kind, src)
+	}
+	if GetPrefix(src) == FLT && isInteger(kind) {
+		val, err := intConst(src)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("=, %s, %s", dst, val), nil
+	}
+	return "", fmt.Errorf("cannot use %s (type %s) as type %s in assignment",
+		exprName(src), typeOf(src), typeName(kind))
+}
+
+// paramCode returns the declarations of the parameters of a function, where a
+// floating-point parameter is declared by an operator suffixed by its
+// precision.
+func paramCode(params []string) []string {
+	code := []string{}
+	for _, v := range params {
+		if kind := KindOf(v); isFloat(kind) {
+			code = append(code, fmt.Sprintf("%s, %s", floatOp(tac.PARAM, kind), v))
+		} else {
+			code = append(code, fmt.Sprintf("%s, %s", tac.PARAM, v))
+		}
+	}
+	return code
+}
+
+// paramTypes returns the types of the parameters of a function, where a named
+// type (or the type of a pointer or an array) is retained.
+func paramTypes(params []string) []string {
+	types := []string{}
+	for _, v := range params {
+		if typ, ok := namedTypes[v]; ok {
+			types = append(types, typ)
+			continue
+		}
+		if typ, ok := ptrType(v); ok {
+			types = append(types, typ)
+			continue
+		}
+		if typ, ok := arrayType(v); ok {
+			types = append(types, typ)
+			continue
+		}
+		if symEntry, ok := funcEntry(v); ok {
+			types = append(types, symEntry.symbols[1])
+			continue
+		}
+		kind := KindOf(v)
+		if kind == NIL {
+			kind = INTEGER
+		}
+		types = append(types, GetType(kind))
+	}
+	return types
+}
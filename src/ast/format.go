@@ -0,0 +1,276 @@
+// This file implements the functions of the fmt package, which is provided as
+// builtins. The arguments are printed one at a time, where an integer, a
+// string or a floating-point value is printed directly by the IR and the other
+// representations (hexadecimal, booleans, padded values) are formatted by the
+// runtime. A format string of Printf is parsed at compile time, hence it must
+// be a constant. The supported verbs are -
+//	%d %s %c %v %x
+// which may be preceded by the flags '-' and '0' and a width.
+
+package ast
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shivansh/gogo/src/tac"
+)
+
+// Flags of a formatting verb, as interpreted by the runtime.
+const (
+	fmtLeft = 1 << iota // pad with spaces on the right
+	fmtZero             // pad with leading zeros
+	fmtSign             // place the leading zeros after a minus sign
+)
+
+// verb is a formatting verb of a format string.
+type verb struct {
+	c     byte
+	width int // minimum width in runes, 0 if absent
+	flags int
+}
+
+// newPrint returns the code for a call to Print or Println. Println separates
+// its operands by spaces and appends a newline, whereas Print separates them
+// by spaces only when neither of them is a string.
+func newPrint(name string, args []string) ([]string, error) {
+	code := []string{}
+	for k, v := range args {
+		if k > 0 && (name == PRINTLN || KindOf(v) != STRING && KindOf(args[k-1]) != STRING) {
+			code = append(code, printLit(" ")...)
+		}
+		argCode, err := formatArg(name, verb{c: 'v'}, v)
+		if err != nil {
+			return nil, err
+		}
+		code = append(code, argCode...)
+	}
+	if name == PRINTLN {
+		code = append(code, printLit(`\n`)...)
+	}
+	return code, nil
+}
+
+// newPrintf returns the code for a call to Printf.
+func newPrintf(args []string) ([]string, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("not enough arguments in call to %s", PRINTF)
+	}
+	if GetPrefix(args[0]) != STR {
+		return nil, fmt.Errorf("non-constant format string in call to %s", PRINTF)
+	}
+	format := StripPrefix(args[0])
+	if strings.HasPrefix(format, "`") {
+		format = strconv.Quote(strings.Trim(format, "`"))
+	}
+	format = format[1 : len(format)-1]
+	code := []string{}
+	argNum, start := 1, 0
+	for i := 0; i < len(format); i++ {
+		switch format[i] {
+		case '\\':
+			i++ // skip the escaped character
+			continue
+		case '%':
+		default:
+			continue
+		}
+		code = append(code, printLit(format[start:i])...)
+		v, n := parseVerb(format[i+1:])
+		i += n
+		start = i + 1
+		switch {
+		case v.c == 0:
+			return nil, fmt.Errorf("%s format %%%s is missing verb at end of string", PRINTF, format[i-n+1:])
+		case v.c == '%':
+			code = append(code, printLit("%")...)
+			continue
+		case strings.IndexByte("dscvx", v.c) == -1:
+			return nil, fmt.Errorf("%s format %%%s has unknown verb %c", PRINTF, format[i-n+1:i+1], v.c)
+		case argNum >= len(args):
+			return nil, fmt.Errorf("%s format %%%s reads arg #%d, but call has %d args",
+				PRINTF, format[i-n+1:i+1], argNum, len(args)-1)
+		}
+		argCode, err := formatArg(PRINTF, v, args[argNum])
+		if err != nil {
+			return nil, err
+		}
+		code = append(code, argCode...)
+		argNum++
+	}
+	if argNum < len(args) {
+		return nil, fmt.Errorf("%s call needs %d args but has %d args", PRINTF, argNum-1, len(args)-1)
+	}
+	return append(code, printLit(format[start:])...), nil
+}
+
+// parseVerb parses the flags, the width and the verb following a '%' in a
+// format string, and returns the verb along with the number of bytes parsed.
+// The verb is 0 if the format string ends before it.
+func parseVerb(s string) (verb, int) {
+	v := verb{}
+	i := 0
+	for ; i < len(s) && (s[i] == '-' || s[i] == '0'); i++ {
+		if s[i] == '-' {
+			v.flags |= fmtLeft
+		} else {
+			v.flags |= fmtZero
+		}
+	}
+	for ; i < len(s) && s[i] >= '0' && s[i] <= '9'; i++ {
+		v.width = 10*v.width + int(s[i]-'0')
+	}
+	if v.flags&fmtLeft != 0 {
+		// Zeros are never padded on the right.
+		v.flags &^= fmtZero
+	}
+	if i == len(s) {
+		return v, i
+	}
+	v.c = s[i]
+	return v, i + 1
+}
+
+// formatArg returns the code for printing an argument of a call to the fmt
+// function name as determined by the verb.
+func formatArg(name string, v verb, arg string) ([]string, error) {
+	kind := KindOf(arg)
+	// An untyped constant is printed as a value of its default type.
+	if err := representable(arg, INTEGER); err != nil {
+		return nil, err
+	}
+	c := v.c
+	if c == 'v' {
+		switch {
+		case isInteger(kind):
+			c = 'd'
+		case kind == STRING:
+			c = 's'
+		case kind == BOOLEAN:
+			c = 't'
+		case isFloat(kind):
+			c = 'g'
+		case kind == INTERFACE:
+			c = 's'
+		}
+	}
+	code := []string{}
+	s := ""
+	switch {
+	case c == 'd' && isInteger(kind) && v.width == 0:
+		return []string{fmt.Sprintf("%s, %s, %s", tac.PRINTINT, arg, arg)}, nil
+	case c == 'c' && isInteger(kind) && v.width == 0:
+		return printRune(arg), nil
+	case (c == 'd' || c == 'x') && isInteger(kind):
+		base := 10
+		if c == 'x' {
+			base = 16
+		}
+		s = NewTmp()
+		code = runtimeCall(s, "fmtint", arg, strconv.Itoa(base))
+		v.flags |= fmtSign
+	case c == 'c' && isInteger(kind):
+		s = NewTmp()
+		code = runtimeCall(s, "runestring", arg)
+	case c == 's' && kind == STRING:
+		s, code = strValue(arg)
+	case c == 's' && kind == INTERFACE:
+		s = NewTmp()
+		code = runtimeCall(s, "fmtiface", arg)
+	case c == 't' && kind == BOOLEAN:
+		s = NewTmp()
+		code = runtimeCall(s, "fmtbool", arg)
+	case c == 'g' && isFloat(kind):
+		if v.width != 0 {
+			return nil, fmt.Errorf("%s of %s with a width is not supported", name, typeName(kind))
+		}
+		val, code := floatValue(arg, kind)
+		if kind == FLOAT32 {
+			return append(code, fmt.Sprintf("%s, %s", tac.PRINTFLOAT, val)), nil
+		}
+		return append(code, fmt.Sprintf("%s, %s", tac.PRINTDOUBLE, val)), nil
+	case v.c == 'v':
+		return nil, fmt.Errorf("%s of %s (type %s) is not supported",
+			name, exprName(arg), typeOf(arg))
+	default:
+		return nil, fmt.Errorf("%s format %%%c has arg %s of wrong type %s",
+			name, v.c, exprName(arg), typeOf(arg))
+	}
+	InsertSymbol(s, STRING, s)
+	if v.width > 0 {
+		padded := NewTmp()
+		InsertSymbol(padded, STRING, padded)
+		code = append(code, runtimeCall(padded, "fmtpad", s, strconv.Itoa(v.width), strconv.Itoa(v.flags))...)
+		s = padded
+	}
+	return append(code, fmt.Sprintf("%s, %s", tac.PRINTSTR, s)), nil
+}
+
+// panicValue returns the message printed by a panic with the given value,
+// which is the value itself for a string and its representation as formatted
+// by %v for an integer, a boolean or a recovered value.
+func panicValue(arg string) (string, []string, error) {
+	kind := KindOf(arg)
+	if kind == STRING {
+		s, code := strValue(arg)
+		return s, code, nil
+	}
+	s := NewTmp()
+	InsertSymbol(s, STRING, s)
+	switch {
+	case kind == INTERFACE:
+		return s, runtimeCall(s, "fmtiface", arg), nil
+	case isInteger(kind):
+		return s, runtimeCall(s, "fmtint", arg, "10"), nil
+	case kind == BOOLEAN:
+		return s, runtimeCall(s, "fmtbool", arg), nil
+	}
+	return "", nil, fmt.Errorf("%s of %s (type %s) is not supported",
+		PANIC, exprName(arg), typeOf(arg))
+}
+
+// newScan returns the code for a call to Scan, which reads the integers
+// pointed to by its arguments.
+func newScan(args []string) ([]string, error) {
+	code := []string{}
+	for _, v := range args {
+		typ, ok := ptrType(v)
+		if !ok || typ == PTR+":" {
+			return nil, fmt.Errorf("%s of %s is not a pointer", SCAN, exprName(v))
+		}
+		kind := GetKind(underlying(StripPrefix(typ)))
+		if !isInteger(kind) {
+			return nil, fmt.Errorf("%s of %s (type %s) is not supported", SCAN, exprName(v), typ)
+		}
+		t := NewTmp()
+		code = append(code,
+			fmt.Sprintf("%s, %s", tac.SCANINT, t),
+			fmt.Sprintf("%s, %s, %s, 0, %s", memOp(tac.INTO, kind), v, v, t),
+		)
+	}
+	return code, nil
+}
+
+// printLit returns the code for printing a segment of a string literal, which
+// is empty if the segment is empty.
+func printLit(lit string) []string {
+	if lit == "" {
+		return []string{}
+	}
+	s, code := strValue(fmt.Sprintf("%s:\"%s\"", STR, lit))
+	return append(code, fmt.Sprintf("%s, %s", tac.PRINTSTR, s))
+}
+
+// runtimeCall returns the code for calling a runtime function whose result is
+// stored in dst.
+func runtimeCall(dst, fn string, args ...string) []string {
+	code := []string{}
+	for _, v := range args {
+		code = append(code, fmt.Sprintf("%s, %s", tac.ARG, v))
+	}
+	return append(code,
+		fmt.Sprintf("%s, %s, %d", tac.CALL, RuntimeFunc(fn), len(args)),
+		fmt.Sprintf("%s, %s", tac.STORE, dst),
+	)
+}
@@ -0,0 +1,131 @@
+// This file implements the labels of statements along with the break, continue
+// and goto statements referring to them. A label is scoped to the function it
+// is declared in, hence it is renamed similar to a variable. A labeled break
+// or continue statement is placed as one of -
+//	"break, <label>"
+//	"continue, <label>"
+// until the enclosing for or switch statement having the label resolves it to
+// a jump, since the label is declared after the statement is parsed.
+
+package ast
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/shivansh/gogo/src/tac"
+)
+
+// labelInfo is the state of a label of a function.
+type labelInfo struct {
+	renamed string
+	defined bool
+	used    bool
+}
+
+// branchTargets maps the label following each for and switch statement to the
+// label which its continue statements jump to, which is empty for a switch
+// statement. The place of such a statement is the label following it.
+var branchTargets = make(map[string]string)
+
+// labelOf returns the state of a label of the current function.
+func labelOf(name string) *labelInfo {
+	ctx := currFunc()
+	if ctx.labels == nil {
+		ctx.labels = make(map[string]*labelInfo)
+	}
+	if _, ok := ctx.labels[name]; !ok {
+		ctx.labels[name] = &labelInfo{renamed: RenameVariable(name)}
+	}
+	return ctx.labels[name]
+}
+
+// NewBranchStmt returns a break, continue or goto statement, as determined by
+// the keyword kwd, which refers to a label.
+func NewBranchStmt(kwd string, label *Node) (*Node, error) {
+	info := labelOf(label.Place)
+	info.used = true
+	if kwd == "goto" {
+		return &Node{"", []string{fmt.Sprintf("%s, %s", tac.JMP, info.renamed)}}, nil
+	}
+	return &Node{"", []string{fmt.Sprintf("%s, %s", kwd, label.Place)}}, nil
+}
+
+// NewLabelStmt returns a labeled statement. The labeled break and continue
+// statements referring to a labeled for or switch statement are resolved here.
+func NewLabelStmt(label, stmt *Node) (AstNode, error) {
+	info := labelOf(label.Place)
+	if info.defined {
+		return nil, fmt.Errorf("label %s already defined", label.Place)
+	}
+	info.defined = true
+	n := &LabeledStmt{Node{"", []string{fmt.Sprintf("%s, %s", tac.LABEL, info.renamed)}}}
+	n.Code = append(n.Code, stmt.Code...)
+	if continueLabel, ok := branchTargets[stmt.Place]; ok {
+		for k, v := range n.Code {
+			switch {
+			case v == fmt.Sprintf("break, %s", label.Place):
+				n.Code[k] = fmt.Sprintf("%s, %s", tac.JMP, stmt.Place)
+			case v == fmt.Sprintf("continue, %s", label.Place) && continueLabel != "":
+				n.Code[k] = fmt.Sprintf("%s, %s", tac.JMP, continueLabel)
+			}
+		}
+	}
+	return n, nil
+}
+
+// hasContinue determines whether the code of the body of a for statement has
+// a labeled continue statement, which may refer to the statement.
+func hasContinue(code []string) bool {
+	for _, v := range code {
+		if strings.HasPrefix(strings.TrimSpace(v), "continue, ") {
+			return true
+		}
+	}
+	return false
+}
+
+// checkBranches verifies that the break and continue statements in the code
+// of the current function have been resolved, and that its labels are both
+// defined and used.
+func checkBranches(code []string) error {
+	ctx := currFunc()
+	for _, v := range code {
+		for _, stmt := range strings.Split(v, "\n") {
+			stmt = strings.TrimSpace(stmt)
+			switch stmt {
+			case "break":
+				return fmt.Errorf("break is not in a loop, switch, or select")
+			case "continue":
+				return fmt.Errorf("continue is not in a loop")
+			case "fallthrough":
+				return fmt.Errorf("fallthrough statement out of place")
+			}
+			for _, kwd := range []string{"break", "continue"} {
+				if !strings.HasPrefix(stmt, kwd+", ") {
+					continue
+				}
+				name := strings.TrimPrefix(stmt, kwd+", ")
+				if ctx.labels[name].defined {
+					return fmt.Errorf("invalid %s label %s", kwd, name)
+				}
+				return fmt.Errorf("%s label not defined: %s", kwd, name)
+			}
+		}
+	}
+	names := []string{}
+	for name := range ctx.labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		switch info := ctx.labels[name]; {
+		case !info.defined:
+			return fmt.Errorf("label %s not defined", name)
+		case !info.used:
+			return fmt.Errorf("label %s defined and not used", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+// This file implements the map operations. A map is represented by the address
+// of a hash table maintained by the runtime, and the zero value of a map (nil)
+// is represented by 0. The operations on a map are lowered to calls to the
+// runtime, which locate the entries of the hash table by the address of their
+// values.
+
+package ast
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shivansh/gogo/src/tac"
+	"github.com/shivansh/gogo/src/utils"
+)
+
+// NewMapType returns a map type. The place attribute of the returned node is of
+// the form "map:<key type>:<element type>".
+func NewMapType(key, elem *Node) (*Node, error) {
+	switch key.Place {
+	case INT, STR, BOOL:
+	default:
+		return nil, fmt.Errorf("invalid map key type %s", key.Place)
+	}
+	return &Node{fmt.Sprintf("%s:%s:%s", MP, key.Place, elem.Place), []string{}}, nil
+}
+
+// mapTypes returns the key and element types of a map type.
+func mapTypes(typ string) (string, string) {
+	s := StripPrefix(typ)
+	i := strings.Index(s, ":")
+	return s[:i], s[i+1:]
+}
+
+// mapEntry returns the symbol table entry of a map, which is of the form -
+//	{ renamedVar, key type, element type }
+func mapEntry(place string) (*SymTabEntry, bool) {
+	if symEntry, found := lookupPlace(place); found && symEntry.kind == MAP {
+		return symEntry, true
+	}
+	return derefEntry(place, MAP)
+}
+
+// checkKey verifies that a value can be used as a key of a map with the given
+// key type, where the context of its use is reported.
+func checkKey(key, keyType, context string) error {
+	if _, ok := arrayType(key); ok || isStruct(key) || !assignable(key, keyType) {
+		return fmt.Errorf("cannot use %s (type %s) as type %s in %s",
+			exprName(key), typeOf(key), keyType, context)
+	}
+	return nil
+}
+
+// NewKeyedElement returns a keyed element of a composite literal. The place
+// attribute of the returned node contains the key followed by the element.
+func NewKeyedElement(key, elem *Node) (*Node, error) {
+	return &Node{fmt.Sprintf("%s, %s", key.Place, elem.Place), append(key.Code, elem.Code...)}, nil
+}
+
+// strValue returns a value which can be passed to the runtime in place of a
+// string literal, which is first declared in the data section.
+func strValue(place string) (string, []string) {
+	if strings.HasPrefix(place, STR+":") {
+		t := NewTmp()
+		return t, []string{fmt.Sprintf("%s, %s, %s", tac.DECLSTR, t, StripPrefix(place))}
+	}
+	return place, []string{}
+}
+
+// newMap returns the code for creating an empty map with the given key type,
+// along with the temporary holding its address.
+func newMap(keyType string) (string, []string) {
+	m := NewTmp()
+	strKeys := 0
+	if keyType == STR {
+		// The runtime compares the string keys by their contents and
+		// not by their addresses.
+		strKeys = 1
+	}
+	return m, []string{
+		fmt.Sprintf("%s, %d", tac.ARG, strKeys),
+		fmt.Sprintf("%s, %s, 1", tac.CALL, RuntimeFunc("makemap")),
+		fmt.Sprintf("%s, %s", tac.STORE, m),
+	}
+}
+
+// newMapLit returns a map composite literal. The place attribute of val
+// contains the keys and the elements of the literal one after the other.
+func newMapLit(typ, val *Node) (*Node, error) {
+	keyType, elemType := mapTypes(typ.Place)
+	n := &Node{"", val.Code}
+	var code []string
+	n.Place, code = newMap(keyType)
+	n.Code = append(n.Code, code...)
+	InsertSymbol(n.Place, MAP, n.Place, keyType, elemType)
+	litVals := utils.SplitAndSanitize(val.Place, ",")
+	if err := checkElided(litVals); err != nil {
+		return nil, err
+	}
+	if len(litVals)%2 != 0 {
+		return nil, fmt.Errorf("missing key in map literal")
+	}
+	for k := 0; k < len(litVals); k += 2 {
+		key := litVals[k]
+		if GetPrefix(key) == FLD {
+			// An identifier used as a key is resolved as such.
+			ident, err := NewIdentifier(StripPrefix(key))
+			if err != nil {
+				return nil, err
+			}
+			key = ident.Place
+			n.Code = append(n.Code, ident.Code...)
+		}
+		if err := checkKey(key, keyType, "map literal"); err != nil {
+			return nil, err
+		}
+		elem, c, err := funcValue(litVals[k+1])
+		if err != nil {
+			return nil, err
+		}
+		n.Code = append(n.Code, c...)
+		n.Code = append(n.Code, mapAssign(n.Place, key, elem)...)
+	}
+	return n, nil
+}
+
+// mapAssign returns the code for assigning val to the element of a map with
+// the given key. The runtime inserts the key when it isn't already present.
+func mapAssign(m, key, val string) []string {
+	key, code := strValue(key)
+	val, valCode := strValue(val)
+	slot := NewTmp()
+	return utils.AppendCode(
+		code,
+		valCode,
+		fmt.Sprintf("%s, %s", tac.ARG, m),
+		fmt.Sprintf("%s, %s", tac.ARG, key),
+		fmt.Sprintf("%s, %s, 2", tac.CALL, RuntimeFunc("mapassign")),
+		fmt.Sprintf("%s, %s", tac.STORE, slot),
+		fmt.Sprintf("%s, %s, %s, 0, %s", tac.INTO, slot, slot, val),
+	)
+}
+
+// indexMap returns the element of a map with the given key. The zero value is
+// returned when the key is not present, which is the empty string for a map of
+// strings. The symbol table entry of the element
+// is of the form -
+//	{ map, key, element type, address of the element }
+// where the address of the element is 0 when the key is not present.
+func indexMap(n *Node, symEntry *SymTabEntry, expr, index *Node) (*Node, error) {
+	if err := checkKey(index.Place, symEntry.symbols[1], "map index"); err != nil {
+		return nil, err
+	}
+	key, code := strValue(index.Place)
+	zero, zeroCode := "0", []string{}
+	if GetKind(symEntry.symbols[2]) == STRING {
+		zero, zeroCode = strValue(STR + ":\"\"")
+	}
+	slot := NewTmp()
+	skipLabel := NewLabel()
+	n.Code = utils.AppendCode(
+		expr.Code,
+		index.Code,
+		code,
+		zeroCode,
+		fmt.Sprintf("%s, %s", tac.ARG, expr.Place),
+		fmt.Sprintf("%s, %s", tac.ARG, key),
+		fmt.Sprintf("%s, %s, 2", tac.CALL, RuntimeFunc("mapaccess")),
+		fmt.Sprintf("%s, %s", tac.STORE, slot),
+		fmt.Sprintf("=, %s, %s", n.Place, zero),
+		fmt.Sprintf("%s, %s, %s, 0", tac.BEQ, skipLabel, slot),
+		fmt.Sprintf("%s, %s, %s, 0", tac.FROM, n.Place, slot),
+		fmt.Sprintf("label, %s", skipLabel),
+	)
+	InsertSymbol(n.Place, MAPELEM, expr.Place, key, symEntry.symbols[2], slot)
+	setNamed(n.Place, symEntry.symbols[2])
+	return n, nil
+}
+
+// commaOk returns the code which evaluates whether the key of a map element
+// referred to by place was present in the map, or whether the value received
+// from a channel referred to by place was sent.
+func commaOk(place, ok string) ([]string, bool) {
+	if recv, found := received[place]; found {
+		return []string{fmt.Sprintf("=, %s, %s", ok, recv.ok)}, true
+	}
+	symEntry, found := Lookup(place)
+	if !found || symEntry.kind != MAPELEM {
+		return nil, false
+	}
+	falseLabel := NewLabel()
+	afterLabel := NewLabel()
+	return []string{
+		fmt.Sprintf("%s, %s, %s, 0", tac.BEQ, falseLabel, symEntry.symbols[3]),
+		fmt.Sprintf("=, %s, 1", ok),
+		fmt.Sprintf("%s, %s", tac.JMP, afterLabel),
+		fmt.Sprintf("label, %s", falseLabel),
+		fmt.Sprintf("=, %s, 0", ok),
+		fmt.Sprintf("label, %s", afterLabel),
+	}, true
+}
+
+// commaOkType returns the type of the value of an expression which has a
+// comma-ok form, i.e. an index expression on a map or a receive operation.
+func commaOkType(place string) (string, bool) {
+	if recv, found := received[place]; found {
+		return recv.elemType, true
+	}
+	if symEntry, found := Lookup(place); found && symEntry.kind == MAPELEM {
+		return symEntry.symbols[2], true
+	}
+	return "", false
+}
+
+// mapRange returns the code for iterating over the entries of a map, which is
+// of the form -
+//	{ initialization, next entry, binding of key and element }
+// along with the temporary holding the address of the next entry. The iteration
+// ends when the address of the next entry is 0.
+func mapRange(m string, key, elem string) (string, [3][]string) {
+	it, entry := NewTmp(), NewTmp()
+	var code [3][]string
+	code[0] = []string{
+		fmt.Sprintf("%s, %s", tac.ARG, m),
+		fmt.Sprintf("%s, %s, 1", tac.CALL, RuntimeFunc("mapiterinit")),
+		fmt.Sprintf("%s, %s", tac.STORE, it),
+	}
+	code[1] = []string{
+		fmt.Sprintf("%s, %s", tac.ARG, it),
+		fmt.Sprintf("%s, %s, 1", tac.CALL, RuntimeFunc("mapiternext")),
+		fmt.Sprintf("%s, %s", tac.STORE, entry),
+	}
+	// An entry of the hash table is of the form -
+	//	{ key, element, next entry }
+	if key != "" {
+		code[2] = append(code[2], fmt.Sprintf("%s, %s, %s, 0", tac.FROM, key, entry))
+	}
+	if elem != "" {
+		code[2] = append(code[2], fmt.Sprintf("%s, %s, %s, 1", tac.FROM, elem, entry))
+	}
+	return entry, code
+}
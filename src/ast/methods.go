@@ -0,0 +1,343 @@
+// This file implements the method declarations and calls. Since the members of
+// a struct are held in separate variables, the receiver of a method is passed
+// by passing each of its members as an argument, followed by the arguments of
+// the call. A method with a pointer receiver is passed the address of its
+// receiver instead, which is taken implicitly when the method is called on an
+// addressable struct. A method value is a function value whose closure holds
+// the address of the receiver (or of a copy of it for a value receiver), and
+// whose function is a wrapper calling the method on the receiver.
+
+package ast
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shivansh/gogo/src/tac"
+	"github.com/shivansh/gogo/src/utils"
+)
+
+// wrappers contains the names of the wrappers of the methods whose values have
+// been taken (see methodWrapper).
+var wrappers = make(map[string]bool)
+
+// NewReceiver returns the receiver of a method. The name of the receiver is
+// placed in the code attribute and its type in the place attribute of the
+// returned node, which is prefixed by "pointer:" for pointer receivers.
+func NewReceiver(typ int, name, typeName string) (*Node, error) {
+	typeName = qualifier + typeName
+	n := &Node{typeName, []string{name}}
+	if typ == 1 {
+		n.Place = PTR + ":" + typeName
+	}
+	return n, nil
+}
+
+// NewMethodMarker returns a marker non-terminal used in the production rule for
+// method declaration. The symbol table entry of a method is of the form -
+//	{ receiver type, number of results, type of result 0, ..., type of parameter 0, ... }
+// where the receiver type is either "pointer:<type>" or the name of the type,
+// and the parameters include the receiver. The type is either a struct, whose
+// members are passed for a value receiver, or another named type.
+func NewMethodMarker(recv, name, signature *Node) (*Node, error) {
+	typeName := recv.Place
+	isPtr := strings.HasPrefix(typeName, PTR+":")
+	if isPtr {
+		typeName = StripPrefix(typeName)
+	}
+	if !isStructType(typeName) && (!isNamedType(typeName) || GetKind(typeName) == POINTER) {
+		return nil, fmt.Errorf("invalid receiver type %s", typeName)
+	}
+	methodName := typeName + "." + name.Place
+	if _, found := globalSymTab[methodName]; found {
+		return nil, fmt.Errorf("method %s is already declared", methodName)
+	}
+	// The receiver is declared in the scope of the parameters (created by
+	// the signature) and precedes them.
+	n := &Node{methodName, []string{fmt.Sprintf("func, %s", FuncName(methodName))}}
+	var params []string
+	if isPtr || !isStructType(typeName) {
+		renamedVar := RenameVariable(recv.Code[0])
+		insertTyped(recv.Code[0], recv.Place, renamedVar)
+		params = []string{renamedVar}
+	} else {
+		params = declareStruct(recv.Code[0], typeName)
+	}
+	params = append(params, signature.Code...)
+	n.Code = append(n.Code, paramCode(params)...)
+
+	results := utils.SplitAndSanitize(signature.Place, ",")
+	symbols := append([]string{recv.Place, strconv.Itoa(len(results))}, results...)
+	globalSymTab[methodName] = SymTabEntry{
+		kind:    METHOD,
+		symbols: append(symbols, signatureTypes(params)...),
+	}
+	currFunc().name = methodName
+	funcSymtabCreated = true // the scope of the body is that of the parameters
+	return n, nil
+}
+
+// structOf returns the type of the struct referred to by place, which is either
+// a struct or a pointer to one.
+func structOf(place string) (string, bool) {
+	if isStruct(place) {
+		return structType(place), true
+	}
+	if typ, ok := ptrType(place); ok && isStructType(StripPrefix(typ)) {
+		return StripPrefix(typ), true
+	}
+	return "", false
+}
+
+// receiverType returns the type of the receiver referred to by place, which is
+// either a value of a struct or a named type, or a pointer to one.
+func receiverType(place string) (string, bool) {
+	if typeName, ok := structOf(place); ok {
+		return typeName, true
+	}
+	if typ, ok := namedTypes[place]; ok {
+		return typ, true
+	}
+	if typ, ok := ptrType(place); ok && isNamedType(StripPrefix(typ)) {
+		return StripPrefix(typ), true
+	}
+	return "", false
+}
+
+// methodRef returns a reference to a method bound to a receiver, which is of
+// the form "method:<receiver>:<method>".
+func methodRef(expr, selector *Node) (*Node, bool) {
+	typeName, ok := receiverType(expr.Place)
+	if !ok {
+		return nil, false
+	}
+	methodName := typeName + "." + selector.Place
+	if symEntry, found := globalSymTab[methodName]; !found || symEntry.kind != METHOD {
+		return nil, false
+	}
+	return &Node{fmt.Sprintf("%s:%s:%s", MTH, expr.Place, methodName), expr.Code}, true
+}
+
+// splitMethodRef returns the receiver and the method of a method reference.
+func splitMethodRef(place string) (string, string, bool) {
+	if GetPrefix(place) != MTH {
+		return "", "", false
+	}
+	s := StripPrefix(place)
+	i := strings.Index(s, ":")
+	return s[:i], s[i+1:], true
+}
+
+// members returns the variables holding the members of a struct, where the
+// members of a nested struct take its place.
+func members(structName string) []string {
+	symEntry, _ := Lookup(structName)
+	vars := []string{}
+	fields := globalSymTab[symEntry.symbols[1]].symbols
+	for k := 0; k < len(fields); k += 2 {
+		key := structName + "." + fields[k]
+		if isStructType(fields[k+1]) {
+			vars = append(vars, members(key)...)
+			continue
+		}
+		member, _ := Lookup(key)
+		vars = append(vars, member.symbols[0])
+	}
+	return vars
+}
+
+// declareStruct declares a struct of the given type and returns the variables
+// holding its members. The symbol table entry of a struct is of the form -
+//	{ name, type of struct }
+// where a nested struct is declared under the key of its field.
+func declareStruct(name, typeName string) []string {
+	InsertSymbol(name, STRUCT, name, typeName)
+	vars := []string{}
+	fields := globalSymTab[typeName].symbols
+	for k := 0; k < len(fields); k += 2 {
+		key := name + "." + fields[k]
+		if isStructType(fields[k+1]) {
+			vars = append(vars, declareStruct(key, fields[k+1])...)
+			continue
+		}
+		renamedVar := RenameVariable(key)
+		insertTyped(key, fields[k+1], renamedVar)
+		vars = append(vars, renamedVar)
+	}
+	return vars
+}
+
+// copyStruct declares a struct which is a copy of the struct src, and returns
+// the code for copying the members.
+func copyStruct(name, src string) []string {
+	return copyMembers(declareStruct(name, structType(src)), members(src))
+}
+
+// assignStruct returns the code for assigning the struct src to the struct
+// dst, if both the places refer to structs. The members of a struct loaded
+// through a pointer are stored back.
+func assignStruct(dst, src string) ([]string, bool) {
+	if !isStruct(dst) || !isStruct(src) {
+		return nil, false
+	}
+	code := []string{}
+	srcVars := members(src)
+	for k, v := range members(dst) {
+		code = append(code, moveMember(v, srcVars[k])...)
+		code = append(code, writeBack(v)...)
+	}
+	return code, true
+}
+
+// isStruct determines whether place refers to a struct (and not to one of its
+// members).
+func isStruct(place string) bool {
+	symEntry, found := Lookup(place)
+	return found && symEntry.kind == STRUCT && symEntry.symbols[0] == place
+}
+
+// isPtrMethod determines whether a method has a pointer receiver.
+func isPtrMethod(method string) bool {
+	return GetPrefix(globalSymTab[method].symbols[0]) == PTR
+}
+
+// receiverArgs returns the arguments passed for the receiver of a method along
+// with the code for evaluating them. The address of a struct is taken when the
+// method has a pointer receiver, whereas a pointer is dereferenced when the
+// method has a value receiver.
+func receiverArgs(recv, method string) ([]string, []string, error) {
+	typ, isPtr := ptrType(recv)
+	var n *Node
+	var err error
+	switch {
+	case isPtrMethod(method) && !isPtr:
+		n, err = addressOf(&Node{recv, []string{}})
+	case !isPtrMethod(method) && isPtr:
+		n, err = deref(recv, 0, StripPrefix(typ))
+	default:
+		return []string{recv}, []string{}, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return []string{n.Place}, n.Code, nil
+}
+
+// newMethodCall returns a call to a method.
+func newMethodCall(expr, args *Node) (*Node, error) {
+	recv, method, _ := splitMethodRef(expr.Place)
+	n := &Node{"", append(expr.Code, args.Code...)}
+	recvArgs, code, err := receiverArgs(recv, method)
+	if err != nil {
+		return nil, err
+	}
+	n.Code = append(n.Code, code...)
+	argExpr, code, err := funcValues(utils.SplitAndSanitize(args.Place, ","))
+	if err != nil {
+		return nil, err
+	}
+	n.Code = append(n.Code, code...)
+	if err := callCode(n, tac.CALL, FuncName(method), append(recvArgs, argExpr...), globalSymTab[method].symbols[1:]); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// methodValue returns a function value for a method bound to its receiver,
+// along with the code for allocating its closure. The closure holds the address
+// of the receiver, which is that of a copy of the receiver for a method with a
+// value receiver, and is called through the wrapper of the method.
+func methodValue(place string) (string, []string, error) {
+	recv, method, _ := splitMethodRef(place)
+	recvArgs, code, err := receiverArgs(recv, method)
+	if err != nil {
+		return "", nil, err
+	}
+	ptr := recvArgs[0]
+	if !isPtrMethod(method) {
+		n, err := heapAlloc(&Node{"", []string{}}, ptr, globalSymTab[method].symbols[0])
+		if err != nil {
+			return "", nil, err
+		}
+		ptr = n.Place
+		code = append(code, n.Code...)
+	}
+	wrapper, err := methodWrapper(method)
+	if err != nil {
+		return "", nil, err
+	}
+	c, f := NewTmp(), NewTmp()
+	symbols := globalSymTab[method].symbols
+	returnLen, _ := strconv.Atoi(symbols[1])
+	InsertSymbol(c, FUNCVAL, c, funcType(methodParams(method), symbols[2:returnLen+2]))
+	exprTexts[c] = exprName(recv) + method[strings.LastIndex(method, "."):]
+	code = utils.AppendCode(
+		code,
+		fmt.Sprintf("%s, %d", tac.ARG, 2*tac.WordSize),
+		fmt.Sprintf("%s, %s, 1", tac.CALL, RuntimeFunc("malloc")),
+		fmt.Sprintf("%s, %s", tac.STORE, c),
+		fmt.Sprintf("%s, %s, %s", tac.ADDR, f, wrapper),
+		fmt.Sprintf("%s, %s, %s, 0, %s", tac.INTO, c, c, f),
+		fmt.Sprintf("%s, %s, %s, 1, %s", tac.INTO, c, c, ptr),
+	)
+	return c, code, nil
+}
+
+// methodParams returns the types of the parameters of a method, excluding its
+// receiver which precedes them.
+func methodParams(method string) []string {
+	symbols := globalSymTab[method].symbols
+	returnLen, _ := strconv.Atoi(symbols[1])
+	recvLen := 1
+	if !isPtrMethod(method) {
+		recvLen = len(flatTypes([]string{symbols[0]}))
+	}
+	return symbols[returnLen+2+recvLen:]
+}
+
+// methodWrapper returns the name of the function which calls a method on the
+// receiver whose address is held by the closure of a method value. The code of
+// the wrapper is placed along with that of the function literals, once for
+// each method.
+func methodWrapper(method string) (string, error) {
+	name := FuncName(method) + ".fm"
+	if wrappers[name] {
+		return name, nil
+	}
+	symbols := globalSymTab[method].symbols
+	if GetPrefix(symbols[len(symbols)-1]) == VRD {
+		return "", fmt.Errorf("values of variadic methods are not supported")
+	}
+	wrappers[name] = true
+	typeName := StripPrefix(symbols[0])
+	if !isPtrMethod(method) {
+		typeName = symbols[0]
+	}
+	params := []string{}
+	for _, v := range methodParams(method) {
+		t := NewTmp()
+		insertTyped(t, v, t)
+		params = append(params, t)
+	}
+	env, ptr := NewTmp(), NewTmp()
+	InsertSymbol(ptr, POINTER, ptr, typeName)
+	n := &Node{"", []string{fmt.Sprintf("func, %s", name)}}
+	n.Code = utils.AppendCode(
+		n.Code,
+		paramCode(params),
+		fmt.Sprintf("%s, %s, 1", tac.STORE, env),
+		fmt.Sprintf("%s, %s, %s, 1", tac.FROM, ptr, env),
+	)
+	recvArgs, code, err := receiverArgs(ptr, method)
+	if err != nil {
+		return "", err
+	}
+	n.Code = append(n.Code, code...)
+	if err := callCode(n, tac.CALL, FuncName(method), append(recvArgs, params...), symbols[1:]); err != nil {
+		return "", err
+	}
+	n.Code = append(n.Code, retCode(flatValues(utils.SplitAndSanitize(n.Place, ","))))
+	funcLits = append(funcLits, n.Code...)
+	return name, nil
+}
@@ -0,0 +1,271 @@
+// This file implements the named types and the type aliases. A named type has
+// the same representation as the type from which it is defined, called its
+// underlying type, but is distinct from every other type. The symbol table
+// entry of a named type is of the form -
+//	{ underlying type }
+// and that of an alias is of the form -
+//	{ type denoted by the alias }
+// The names of the types of the places holding values of named types are kept
+// in namedTypes.
+
+package ast
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shivansh/gogo/src/utils"
+)
+
+// namedTypes maps the places holding values of named types to the names of
+// their types.
+var namedTypes = make(map[string]string)
+
+// NewTypeName returns a type name, where an alias is replaced by the type it
+// denotes.
+func NewTypeName(ident string) (*Node, error) {
+	ident = qualifier + ident
+	if symEntry, found := globalSymTab[ident]; found && symEntry.kind == ALIAS {
+		ident = symEntry.symbols[0]
+	}
+	return &Node{ident, []string{}}, nil
+}
+
+// NewAliasDecl returns an alias declaration. An alias of a struct type
+// declares the struct type under the name of the alias.
+func NewAliasDecl(ident string, typ AstNode) (*Node, error) {
+	ident = qualifier + ident
+	if st, ok := typ.(*StructType); ok {
+		return NewTypeDecl(&StructType{st.Node, ident, 0})
+	}
+	if _, found := globalSymTab[ident]; found {
+		return nil, ErrRedeclared(ident)
+	}
+	globalSymTab[ident] = SymTabEntry{
+		kind:    ALIAS,
+		symbols: []string{typ.place()},
+	}
+	return &Node{"", []string{}}, nil
+}
+
+// declareNamed declares a named type defined from the given type. A type
+// defined from a struct type is a struct type with the same fields.
+func declareNamed(name, typ string) error {
+	if _, found := globalSymTab[name]; found {
+		return ErrRedeclared(name)
+	}
+	if isStructType(typ) {
+		globalSymTab[name] = SymTabEntry{
+			kind:    STRUCT,
+			symbols: globalSymTab[typ].symbols,
+		}
+		return nil
+	}
+	if GetKind(typ) == NIL && !isArrayType(typ) {
+		return fmt.Errorf("undefined: %s", typ)
+	}
+	globalSymTab[name] = SymTabEntry{
+		kind:    NAMED,
+		symbols: []string{underlying(typ)},
+	}
+	return nil
+}
+
+// isNamedType determines whether a type is a named type other than a struct.
+func isNamedType(typ string) bool {
+	symEntry, found := globalSymTab[typ]
+	return found && symEntry.kind == NAMED
+}
+
+// underlying returns the underlying type of a type.
+func underlying(typ string) string {
+	if isNamedType(typ) {
+		return globalSymTab[typ].symbols[0]
+	}
+	return typ
+}
+
+// typeOf returns the name of the type of the value at a place, where a constant
+// is untyped.
+func typeOf(place string) string {
+	if typ, ok := namedTypes[place]; ok {
+		return typ
+	}
+	switch {
+	case GetPrefix(place) == FLT:
+		return "untyped float"
+	case isConst(place):
+		return "untyped " + typeName(KindOf(place))
+	}
+	if typ, ok := ptrType(place); ok {
+		if isNil(place) {
+			return "untyped " + NILPTR
+		}
+		return displayType(typ)
+	}
+	if typ, ok := arrayType(place); ok {
+		return displayType(typ)
+	}
+	if symEntry, ok := chanEntry(place); ok {
+		return displayType(CHN + ":" + symEntry.symbols[1])
+	}
+	if symEntry, ok := sliceEntry(place); ok {
+		return displayType(SLC + ":" + symEntry.symbols[1])
+	}
+	if symEntry, ok := mapEntry(place); ok {
+		return displayType(fmt.Sprintf("%s:%s:%s", MP, symEntry.symbols[1], symEntry.symbols[2]))
+	}
+	if symEntry, ok := funcEntry(place); ok && strings.Contains(symEntry.symbols[1], ":") {
+		return displayType(symEntry.symbols[1])
+	}
+	if typ := structType(place); typ != "" {
+		return typ
+	}
+	return typeName(KindOf(place))
+}
+
+// setNamed records the type of the value at a place, if the type is named.
+func setNamed(place, typ string) {
+	if isNamedType(typ) {
+		namedTypes[place] = typ
+	}
+}
+
+// sameNamed verifies that a value can be used where a value of the named type
+// of dst (or of an unnamed type) is expected. A constant can be used for a
+// value of any type with the same underlying type.
+func sameNamed(dst, src string) bool {
+	return isConst(src) || identicalNamed(namedTypes[dst], namedTypes[src])
+}
+
+// identicalNamed determines whether the values of two types, either of which
+// may be unnamed (empty), can be used for one another. A value of an unnamed
+// type can be used for a value of a named type whose underlying type is a
+// composite type, e.g. a function literal for a named function type.
+func identicalNamed(want, have string) bool {
+	named := want
+	switch {
+	case want == have:
+		return true
+	case want == "":
+		named = have
+	case have != "":
+		return false
+	}
+	typ := underlying(named)
+	switch GetKind(typ) {
+	case SLICE, MAP, FUNCVAL, POINTER, CHANNEL:
+		return true
+	}
+	return isArrayType(typ)
+}
+
+// checkNamed verifies that the value at src can be assigned to dst.
+func checkNamed(dst, src string) error {
+	typ, _ := ptrType(dst)
+	if !sameNamed(dst, src) || !samePointer(typ, src) {
+		return fmt.Errorf("cannot use %s (type %s) as type %s in assignment",
+			exprName(src), typeOf(src), typeOf(dst))
+	}
+	return nil
+}
+
+// namedOperands returns the named type of the result of a binary operation,
+// verifying that the operands are of the same type. The result of a shift is
+// of the type of its left operand.
+func namedOperands(op, left, right string) (string, error) {
+	switch {
+	case op == LSH || op == RSH, isConst(right):
+		return namedTypes[left], nil
+	case isConst(left):
+		return namedTypes[right], nil
+	case namedTypes[left] != namedTypes[right]:
+		return "", fmt.Errorf("invalid operation: %s %s %s (mismatched types %s and %s)",
+			exprName(left), op, exprName(right), typeOf(left), typeOf(right))
+	}
+	return namedTypes[left], nil
+}
+
+// checkArg verifies that a value can be passed for a parameter of the given
+// type. nil can be passed for a slice, a map, a function, a channel and a
+// pointer.
+func checkArg(arg, typ, callee string) error {
+	// An array can only be passed for a parameter of the same array type.
+	arr, _ := arrayType(arg)
+	if (arr != "" || isArrayType(underlying(typ))) && arr != underlying(typ) ||
+		!isNil(arg) && !samePointer(typ, arg) || !assignable(arg, typ) {
+		return fmt.Errorf("cannot use %s (type %s) as type %s in argument to %s",
+			exprName(arg), typeOf(arg), displayType(typ), RealName(callee))
+	}
+	if isConst(arg) || isNil(arg) {
+		return nil
+	}
+	want := ""
+	if isNamedType(typ) {
+		want = typ
+	}
+	if !identicalNamed(want, namedTypes[arg]) {
+		return fmt.Errorf("cannot use %s (type %s) as type %s in argument to %s",
+			exprName(arg), typeOf(arg), typ, RealName(callee))
+	}
+	return nil
+}
+
+// assignable determines whether the kind of the value at a place is that of
+// the given type, where an untyped constant takes the type it is used as. The
+// runtime passes strings (and the addresses of values) for integers.
+func assignable(place, typ string) bool {
+	want, have := GetKind(typ), KindOf(place)
+	switch {
+	case want == NIL || PkgName == "runtime":
+		return true
+	case isNil(place):
+		return want == POINTER || want == SLICE || want == MAP || want == FUNCVAL || want == CHANNEL
+	case re.MatchString(place):
+		return isInteger(want) || isFloat(want)
+	case GetPrefix(place) == FLT:
+		return isFloat(want)
+	case GetPrefix(place) == STR:
+		return want == STRING
+	case want == FUNCVAL && have == FUNCVAL:
+		return sameFunc(typ, place)
+	}
+	return have == NIL || have == want
+}
+
+// copyValue returns a copy of the value at a place in a temporary of the given
+// type, along with the code for copying it.
+func copyValue(place, typ string) (string, []string) {
+	kind := GetKind(typ)
+	place, code := strValue(place)
+	if isFloat(kind) {
+		var c []string
+		place, c = floatValue(place, kind)
+		code = append(code, c...)
+	}
+	t := NewTmp()
+	insertTyped(t, typ, t)
+	return t, append(code, fmt.Sprintf("%s, %s, %s", moveOp(kind), t, place))
+}
+
+// namedConversion returns the conversion of an expression to a named type,
+// which converts the expression to the underlying type. The converted value
+// is placed in a new temporary, so that the type of the expression is
+// retained.
+func namedConversion(typ string, args *Node) (*Node, error) {
+	expr := utils.SplitAndSanitize(args.Place, ",")
+	if len(expr) != 1 {
+		return nil, fmt.Errorf("conversion to %s requires a single argument", typ)
+	}
+	n, err := NewConversion(underlying(typ), &Node{expr[0], args.Code})
+	if err != nil {
+		return nil, err
+	}
+	if n.Place == expr[0] || isConst(n.Place) {
+		var code []string
+		n.Place, code = copyValue(n.Place, typ)
+		n.Code = append(n.Code, code...)
+	}
+	namedTypes[n.Place] = typ
+	return n, nil
+}
@@ -3,14 +3,44 @@
 
 package ast
 
-import "fmt"
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
 
 var (
 	tmpIndex   int // index used for naming temporaries
 	labelIndex int // index used for naming labels
 	varIndex   int // index used for renaming variables
+	// qualifier is prepended to the names of labels, functions and global
+	// variables when compiling the runtime. This is done since the runtime
+	// is placed in the same assembly file as the program being compiled,
+	// hence its names should not conflict with those of the program.
+	qualifier string
+	// exprTexts holds the source text of the expressions whose values are
+	// held by temporaries, which is how such values are reported in errors.
+	exprTexts = make(map[string]string)
+	// tmpRe matches the names of temporaries.
+	tmpRe = regexp.MustCompile("^t[0-9]+$")
 )
 
+// RuntimeFunc returns the label of a function defined in the runtime.
+func RuntimeFunc(name string) string {
+	return "runtime." + name
+}
+
+// FuncName returns the label of a function (or a method) referred to by the
+// given name in the current package. The names of the methods of the types
+// declared in an imported package and those of the functions copied from it
+// are already qualified.
+func FuncName(name string) string {
+	if strings.HasPrefix(name, qualifier) || importedNames[name] {
+		return name
+	}
+	return qualifier + name
+}
+
 // RealName extracts the original name of a variable from its renamed version.
 func RealName(s string) string {
 	i := 0
@@ -22,6 +52,58 @@ func RealName(s string) string {
 	return s
 }
 
+// exprName returns the name of the value at a place as it is reported in the
+// errors, where a constant is reported by its value and a temporary by the
+// expression it holds the value of.
+func exprName(place string) string {
+	if text, ok := exprTexts[place]; ok {
+		return text
+	}
+	if tmpRe.MatchString(place) {
+		return "value"
+	}
+	switch GetPrefix(place) {
+	case STR, FLT:
+		return StripPrefix(place)
+	}
+	return RealName(StripPrefix(place))
+}
+
+// callText returns the source text of a call of fn with the given arguments,
+// where the first argument of a builtin can be a type.
+func callText(fn string, args []string) string {
+	texts := []string{}
+	for k, v := range args {
+		switch {
+		case v == spread && k > 0:
+			texts[k-1] += spread
+		case k == 0 && isBuiltin(fn) && (GetKind(v) != NIL || isArrayType(v)):
+			texts = append(texts, displayType(v))
+		default:
+			texts = append(texts, exprName(v))
+		}
+	}
+	if _, ok := globalSymTab[fn]; ok {
+		fn = strings.TrimPrefix(fn, qualifier)
+	} else {
+		fn = exprName(fn)
+	}
+	return fmt.Sprintf("%s(%s)", fn, strings.Join(texts, ", "))
+}
+
+// nameExpr records the source text of the expression whose value is held by
+// the place of n, unless it is already known, and passes on the result of the
+// constructor of the expression.
+func nameExpr(n *Node, err error, format string, a ...interface{}) (*Node, error) {
+	if err != nil || !tmpRe.MatchString(n.Place) {
+		return n, err
+	}
+	if _, ok := exprTexts[n.Place]; !ok {
+		exprTexts[n.Place] = fmt.Sprintf(format, a...)
+	}
+	return n, nil
+}
+
 // NewTmp generates a unique temporary variable name.
 func NewTmp() string {
 	t := fmt.Sprintf("t%d", tmpIndex)
@@ -31,7 +113,7 @@ func NewTmp() string {
 
 // NewLabel generates a unique label name.
 func NewLabel() string {
-	l := fmt.Sprintf("l%d", labelIndex)
+	l := fmt.Sprintf("%sl%d", qualifier, labelIndex)
 	labelIndex++
 	return l
 }
@@ -42,7 +124,30 @@ func NewLabel() string {
 // conflict with an existing variable. The renamed variable will only occur in
 // the IR (there is no constraint on variable names in IR as of now).
 func RenameVariable(v string) string {
-	ret := fmt.Sprintf("%s.%d", v, varIndex)
+	ret := fmt.Sprintf("%s.%s%d", v, qualifier, varIndex)
 	varIndex++
 	return ret
 }
+
+// renamedKey returns the key from which a variable was renamed by
+// RenameVariable, such as the key "<struct>.<member>" of a member of a struct.
+func renamedKey(place string) (string, bool) {
+	if i := strings.LastIndex(place, "."+qualifier); i != -1 {
+		return place[:i], true
+	}
+	return "", false
+}
+
+// GoName returns the name of a function as printed in a stack trace, which is
+// qualified by the import path of its package, given the label of the function.
+func GoName(label string) string {
+	name := "main." + label
+	longest := 0
+	for path, p := range pkgs {
+		if q := p.qualifier; q != "" && strings.HasPrefix(label, q) && len(q) > longest {
+			name = path + "." + strings.TrimPrefix(label, q)
+			longest = len(q)
+		}
+	}
+	return name
+}
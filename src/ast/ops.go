@@ -12,6 +12,15 @@ const (
 	DIV = "/"
 	REM = "%"
 
+	// bitwise operators
+	BOR    = "|"
+	XOR    = "^"
+	ANDNOT = "&^"
+
+	// shift operators
+	LSH = "<<"
+	RSH = ">>"
+
 	// boolean operators
 	NOT = "!"
 	OR  = "||"
@@ -29,5 +38,11 @@ const (
 	INC = "++"
 	DEC = "--"
 
+	// The arrow is used to send a value on a channel and to receive a
+	// value from a channel.
+	ARROW = "<-"
+
+	// An ampersand can be used to define a bitwise and and an address
+	// operator, hence its name.
 	AMP = "&"
 )
@@ -0,0 +1,276 @@
+// This file implements the packages and the imports. The packages imported by a
+// program are compiled before it in the same run, where a package is compiled
+// after the packages it imports. The labels of the functions and the globals of
+// an imported package are prefixed by its qualifier, which is its import path
+// with each "/" replaced by ".", as are the names of the types declared in it so
+// that the types of different packages are distinct. The global symbol table of
+// a package is copied to that of a file importing it, where the functions are
+// keyed by their qualified names. A package-level variable is accessed by the
+// importing files through its address.
+
+package ast
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/shivansh/gogo/src/tac"
+)
+
+// pkgInfo is a compiled package.
+type pkgInfo struct {
+	name      string
+	qualifier string
+	symTab    symTabType // global symbol table of the package
+	scope     symTabType // package-level variables and constants
+	// varTypes maps the package-level variables which can be accessed
+	// through their addresses to their types.
+	varTypes map[string]string
+	// imported contains the keys of symTab copied from imported packages.
+	imported map[string]bool
+}
+
+var (
+	// pkgs maps the import paths of the compiled packages to the packages.
+	pkgs = make(map[string]*pkgInfo)
+	// imports maps the names of the packages imported by the current file
+	// to their import paths, and usedImports contains the names which have
+	// been referred to.
+	imports     = make(map[string]string)
+	usedImports = make(map[string]bool)
+	// importedNames contains the keys of the global symbol table copied from
+	// imported packages.
+	importedNames = make(map[string]bool)
+	// pkgInits contains the code initializing the package-level variables
+	// whose initial values are not constants, in the order of their
+	// declarations. It is run at the start of main, after the packages
+	// imported have been initialized.
+	pkgInits []string
+)
+
+// BeginPackage begins the compilation of a package imported by the given path,
+// which is empty for the package being built.
+func BeginPackage(path string) {
+	qualifier = ""
+	if path != "" {
+		qualifier = strings.Replace(path, "/", ".", -1) + "."
+	}
+	globalSymTab = make(symTabType)
+	currScope = &SymInfo{make(symTabType), nil}
+	addrGlobals = make(map[string]symkind)
+	importedNames = make(map[string]bool)
+}
+
+// EndPackage ends the compilation of a package imported by the given path, so
+// that it can be imported by the packages compiled after it.
+func EndPackage(path string) {
+	scope := currScope
+	for scope.parent != nil {
+		scope = scope.parent
+	}
+	p := &pkgInfo{
+		name:      PkgName,
+		qualifier: qualifier,
+		symTab:    globalSymTab,
+		scope:     scope.symTab,
+		varTypes:  make(map[string]string),
+		imported:  importedNames,
+	}
+	for name, symEntry := range p.scope {
+		if symEntry.kind == CONSTANT || strings.Contains(name, ".") {
+			// The members of a struct are keyed by "<struct>.<member>".
+			continue
+		}
+		switch place := symEntry.symbols[0]; symEntry.kind {
+		case INTEGER, STRING, BOOLEAN, BYTE, RUNE, POINTER, FLOAT32, FLOAT64:
+			if _, ok := arrayLen(place); ok {
+				break
+			}
+			if typ, err := valueType(place); err == nil {
+				p.varTypes[name] = typ
+			}
+		}
+	}
+	pkgs[path] = p
+}
+
+// BuiltinPkg determines whether an import path refers to a package whose
+// functions are provided as builtins.
+func BuiltinPkg(path string) bool {
+	return path == "strconv" || path == "fmt"
+}
+
+// isExported determines whether a name is exported, i.e. begins with an upper
+// case letter.
+func isExported(name string) bool {
+	r, _ := utf8.DecodeRuneInString(name)
+	return unicode.IsUpper(r)
+}
+
+// isPkg determines whether a name refers to a package imported by the current
+// file, which is not shadowed by a variable.
+func isPkg(name string) bool {
+	if _, ok := imports[name]; !ok {
+		return false
+	}
+	_, found := resolve(name)
+	return !found
+}
+
+// NewImportSpec returns an import specification. The package is referred to by
+// the name ident, which is the name of the package if ident is empty.
+func NewImportSpec(ident, lit string) (*Node, error) {
+	path := strings.Trim(lit, "\"`")
+	name := path
+	if !BuiltinPkg(path) {
+		p, ok := pkgs[path]
+		if !ok {
+			return nil, fmt.Errorf("cannot find package %q", path)
+		}
+		name = p.name
+		importPkg(p)
+	}
+	if ident != "" {
+		name = ident
+	}
+	if name == "_" {
+		return &Node{"", []string{}}, nil
+	}
+	if _, ok := imports[name]; ok {
+		return nil, ErrRedeclared(name)
+	}
+	imports[name] = path
+	return &Node{"", []string{}}, nil
+}
+
+// importPkg copies the global symbol table of an imported package to the
+// current one, where the functions are keyed by their qualified names.
+func importPkg(p *pkgInfo) {
+	for k, symEntry := range p.symTab {
+		if !strings.HasPrefix(k, p.qualifier) && !p.imported[k] {
+			k = p.qualifier + k
+		}
+		if _, found := globalSymTab[k]; !found {
+			globalSymTab[k] = symEntry
+			importedNames[k] = true
+		}
+	}
+}
+
+// NewSourceFile returns the top level declarations of a source file after
+// verifying that the imported packages are used. The package-level variables
+// initialized by calls to the runtime are initialized at the start of main.
+func NewSourceFile(decls *Node) (*Node, error) {
+	names := []string{}
+	for name := range imports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !usedImports[name] {
+			return nil, fmt.Errorf("%q imported and not used", imports[name])
+		}
+	}
+	if PkgName != "main" {
+		return decls, nil
+	}
+	for k, v := range decls.Code {
+		if strings.TrimSpace(v) == fmt.Sprintf("%s, main", tac.FUNC) {
+			code := append(append(decls.Code[:k+1:k+1], pkgInits...), decls.Code[k+1:]...)
+			decls = &Node{decls.Place, code}
+			break
+		}
+	}
+	pkgInits = nil
+	return decls, nil
+}
+
+// splitQualified returns the package name and the identifier of a qualified
+// identifier.
+func splitQualified(ident string) (string, string) {
+	i := strings.Index(ident, ".")
+	return ident[:i], ident[i+1:]
+}
+
+// pkgOf returns the imported package referred to by a name, after verifying
+// that the identifier can be referred to from other packages.
+func pkgOf(pkgName, ident string) (*pkgInfo, error) {
+	usedImports[pkgName] = true
+	if !isExported(ident) {
+		return nil, fmt.Errorf("cannot refer to unexported name %s.%s", pkgName, ident)
+	}
+	return pkgs[imports[pkgName]], nil
+}
+
+// NewQualifiedOperand returns an operand which is a qualified identifier, or a
+// selector of an identifier which does not refer to a package.
+func NewQualifiedOperand(n *Node) (*Node, error) {
+	pkgName, ident := splitQualified(n.Place)
+	if !isPkg(pkgName) {
+		expr, err := NewIdentifier(pkgName)
+		if err != nil {
+			return nil, err
+		}
+		return NewPrimaryExprSel(expr, &Node{ident, []string{}})
+	}
+	if path := imports[pkgName]; BuiltinPkg(path) {
+		usedImports[pkgName] = true
+		if name := path + "." + ident; isBuiltin(name) {
+			return &Node{name, []string{}}, nil
+		}
+		return nil, ErrUndefined(n.Place)
+	}
+	p, err := pkgOf(pkgName, ident)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := globalSymTab[p.qualifier+ident]; found {
+		return &Node{p.qualifier + ident, []string{}}, nil
+	}
+	symEntry, found := p.scope[ident]
+	switch {
+	case !found:
+		return nil, ErrUndefined(n.Place)
+	case symEntry.kind == CONSTANT:
+		return constNode(&symEntry), nil
+	}
+	typ, ok := p.varTypes[ident]
+	if !ok {
+		return nil, fmt.Errorf("cannot refer to variable %s of type %s from another package",
+			n.Place, GetType(symEntry.kind))
+	}
+	// The variable is accessed through its address.
+	addr := NewTmp()
+	InsertSymbol(addr, POINTER, addr, typ)
+	val, err := deref(addr, 0, typ)
+	if err != nil {
+		return nil, err
+	}
+	val.Code = append([]string{fmt.Sprintf("%s, %s, %s", tac.ADDR, addr, symEntry.symbols[0])}, val.Code...)
+	return val, nil
+}
+
+// NewQualifiedType returns a type name which is a qualified identifier.
+func NewQualifiedType(n *Node) (*Node, error) {
+	pkgName, ident := splitQualified(n.Place)
+	if !isPkg(pkgName) || BuiltinPkg(imports[pkgName]) {
+		return nil, ErrUndefined(n.Place)
+	}
+	p, err := pkgOf(pkgName, ident)
+	if err != nil {
+		return nil, err
+	}
+	symEntry, found := globalSymTab[p.qualifier+ident]
+	switch {
+	case !found:
+		return nil, ErrUndefined(n.Place)
+	case symEntry.kind == ALIAS:
+		return &Node{symEntry.symbols[0], []string{}}, nil
+	case symEntry.kind != STRUCT && symEntry.kind != NAMED:
+		return nil, fmt.Errorf("%s is not a type", n.Place)
+	}
+	return &Node{p.qualifier + ident, []string{}}, nil
+}
@@ -0,0 +1,650 @@
+// This file implements the pointers. A pointer holds the address of a value in
+// memory. A local variable whose address is taken is moved to a box, whereas a
+// local struct whose address is taken is moved to a block holding its members
+// in the order in which they are returned by members (see boxVars). A struct
+// allocated on the heap, either by new or by taking the address of a composite
+// literal, is laid out the same way. A member occupies a word, except for a
+// float64 which occupies two, and an array member is held by the address of its
+// first element. A global is addressed by its label, and is accessed through
+// its address by the functions (see boxGlobals). The symbol table entry of a
+// pointer is of the form -
+//	{ renamedVar, type of the value pointed to }
+// where the type is empty for nil, and that of a value loaded through a pointer
+// is of the form -
+//	{ address, offset (in words), type }
+// A struct loaded through a pointer is held by a temporary struct whose members
+// are loaded this way, and the address and the offset of the struct follow its
+// type in its symbol table entry. An array allocated on the heap, either by new
+// or by taking the address of an array literal, is pointed to by the address of
+// its first element, hence the array pointed to is referred to by the pointer.
+// Slices, maps, function values and channels cannot be pointed to.
+
+package ast
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/shivansh/gogo/src/tac"
+	"github.com/shivansh/gogo/src/utils"
+)
+
+// NILPTR is the name of the predeclared nil pointer.
+const NILPTR = "nil"
+
+var (
+	// structLits contains the temporary structs holding struct literals,
+	// whose addresses are taken by allocating copies on the heap.
+	structLits = make(map[string]bool)
+	// addrGlobals maps the globals whose addresses are taken to their
+	// kinds.
+	addrGlobals = make(map[string]symkind)
+)
+
+// NewPointerType returns a pointer type, which is placed as "pointer:<type>".
+func NewPointerType(elem *Node) (*Node, error) {
+	if err := checkPointee(elem.Place); err != nil {
+		return nil, err
+	}
+	return &Node{PTR + ":" + elem.Place, []string{}}, nil
+}
+
+// checkPointee verifies that the values of a type can be pointed to. The
+// fields of a struct type are verified when the struct is accessed through a
+// pointer, as the struct may not have been declared yet.
+func checkPointee(typ string) error {
+	switch GetKind(typ) {
+	case SLICE, MAP, FUNCVAL, CHANNEL:
+		return fmt.Errorf("pointers to type %s are not supported", displayType(typ))
+	}
+	return nil
+}
+
+// displayType returns a type in the form in which it is written in a program.
+// The type of a parameter whose element type is not retained (see paramTypes)
+// is a bare prefix, which is returned as is.
+func displayType(typ string) string {
+	if !strings.Contains(typ, ":") {
+		return typ
+	}
+	if GetPrefix(typ) == PTR {
+		return "*" + displayType(StripPrefix(typ))
+	}
+	if GetPrefix(typ) == CHN {
+		return "chan " + displayType(StripPrefix(typ))
+	}
+	if GetPrefix(typ) == SLC {
+		return "[]" + displayType(StripPrefix(typ))
+	}
+	if GetPrefix(typ) == VRD {
+		return "..." + displayType(StripPrefix(typ))
+	}
+	if GetPrefix(typ) == MP {
+		key, elem := mapTypes(typ)
+		return fmt.Sprintf("map[%s]%s", key, displayType(elem))
+	}
+	if isArrayType(typ) {
+		length, elem := arrayParts(typ)
+		return fmt.Sprintf("[%s]%s", length, displayType(elem))
+	}
+	if GetPrefix(typ) == FNC {
+		params, results := funcParts(typ)
+		for k := range params {
+			params[k] = displayType(params[k])
+		}
+		for k := range results {
+			results[k] = displayType(results[k])
+		}
+		switch len(results) {
+		case 0:
+			return fmt.Sprintf("func(%s)", strings.Join(params, ", "))
+		case 1:
+			return fmt.Sprintf("func(%s) %s", strings.Join(params, ", "), results[0])
+		}
+		return fmt.Sprintf("func(%s) (%s)", strings.Join(params, ", "), strings.Join(results, ", "))
+	}
+	return typ
+}
+
+// newNil returns the nil pointer, which is held by a temporary so that it can
+// be distinguished from the integer zero.
+func newNil() *Node {
+	t := NewTmp()
+	InsertSymbol(t, POINTER, t, "")
+	exprTexts[t] = NILPTR
+	return &Node{t, []string{fmt.Sprintf("=, %s, 0", t)}}
+}
+
+// ptrType returns the type of the pointer at a place, which is of the form
+// "pointer:<type>".
+func ptrType(place string) (string, bool) {
+	symEntry, found := lookupPlace(place)
+	if !found {
+		return "", false
+	}
+	switch symEntry.kind {
+	case POINTER:
+		return PTR + ":" + symEntry.symbols[1], true
+	case DEREF:
+		if typ := underlying(symEntry.symbols[2]); GetPrefix(typ) == PTR {
+			return typ, true
+		}
+	}
+	return "", false
+}
+
+// isNil determines whether place refers to the nil pointer.
+func isNil(place string) bool {
+	typ, ok := ptrType(place)
+	return ok && typ == PTR+":"
+}
+
+// isPtrExpr determines whether any of the places refers to a pointer.
+func isPtrExpr(places ...string) bool {
+	for _, v := range places {
+		if _, ok := ptrType(v); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// samePointer verifies that the value at src can be used where a value of the
+// type typ is expected, when either of them is a pointer. The nil pointer can
+// be used for a pointer of any type.
+func samePointer(typ, src string) bool {
+	srcType, ok := ptrType(src)
+	switch {
+	case GetPrefix(typ) != PTR:
+		return !ok
+	case !ok:
+		return false
+	}
+	return srcType == PTR+":" || srcType == typ
+}
+
+// ptrOperands verifies the operands of a comparison involving pointers, which
+// can only be compared for equality with pointers of the same type.
+func ptrOperands(op, left, right string) error {
+	leftType, _ := ptrType(left)
+	rightType, _ := ptrType(right)
+	if op != EQ && op != NEQ || isNil(left) && isNil(right) {
+		return ptrOperator(op, left, right)
+	}
+	if !samePointer(leftType, right) && !samePointer(rightType, left) {
+		return fmt.Errorf("invalid operation: %s %s %s (mismatched types %s and %s)",
+			exprName(left), op, exprName(right), typeOf(left), typeOf(right))
+	}
+	return nil
+}
+
+// nilRel returns the comparison of a map, a slice, a function value or a
+// channel with nil, and determines whether the comparison is one of these. The
+// values of these types other than channels can only be compared with nil. A
+// slice is nil when the address of its underlying array is.
+func nilRel(op, leftexpr, rightexpr *Node) (*Node, bool, error) {
+	val, other := leftexpr, rightexpr
+	if isNil(val.Place) {
+		val, other = other, val
+	}
+	kind := KindOf(val.Place)
+	switch {
+	case kind == CHANNEL && !isNil(other.Place):
+		return nil, false, nil
+	case kind != MAP && kind != SLICE && kind != FUNCVAL && kind != CHANNEL:
+		return nil, false, nil
+	case !isNil(other.Place):
+		return nil, true, fmt.Errorf("invalid operation: %s %s %s (%s can only be compared to nil)",
+			exprName(leftexpr.Place), op.Place, exprName(rightexpr.Place), GetType(kind))
+	case op.Place != EQ && op.Place != NEQ:
+		return nil, true, ErrOperator(op.Place, exprName(val.Place), typeOf(val.Place))
+	}
+	// The value is compared as an address.
+	ptr := NewTmp()
+	InsertSymbol(ptr, INTEGER, ptr)
+	code := append(leftexpr.Code, rightexpr.Code...)
+	if kind == SLICE {
+		code = append(code, fmt.Sprintf("%s, %s, %s, %d", tac.FROM, ptr, val.Place, slicePtr))
+	} else {
+		code = append(code, fmt.Sprintf("=, %s, %s", ptr, val.Place))
+	}
+	n, err := relExpr(op, &Node{ptr, code}, &Node{"0", []string{}})
+	return n, true, err
+}
+
+// ptrOperator returns an error for an operation other than a comparison on the
+// first of the places which refers to a pointer.
+func ptrOperator(op string, places ...string) error {
+	for _, v := range places {
+		if _, ok := ptrType(v); ok {
+			return ErrOperator(op, exprName(v), typeOf(v))
+		}
+	}
+	return nil
+}
+
+// memOp returns the IR operator for loading (or storing) a value of the given
+// kind from (or into) memory, where op is either tac.FROM or tac.INTO.
+func memOp(op string, kind symkind) string {
+	if isFloat(kind) {
+		return floatOp(op, kind)
+	}
+	return op
+}
+
+// wordsOf returns the number of words occupied by the values of the given
+// types in memory.
+func wordsOf(types []string) int {
+	kinds := []symkind{}
+	for _, v := range flatTypes(types) {
+		kinds = append(kinds, GetKind(v))
+	}
+	return words(kinds)
+}
+
+// varWords returns the number of words occupied by the values of the given
+// variables in memory.
+func varWords(vars []string) int {
+	kinds := []symkind{}
+	for _, v := range vars {
+		kinds = append(kinds, KindOf(v))
+	}
+	return words(kinds)
+}
+
+// blockVar returns the name of a variable held by a block, which is prefixed by
+// "arr:" for an array, and by its type for a floating-point variable.
+func blockVar(v string) string {
+	if _, ok := arrayLen(v); ok {
+		return ARR + ":" + v
+	}
+	if kind := KindOf(v); isFloat(kind) {
+		return GetType(kind) + ":" + v
+	}
+	return v
+}
+
+// isArrayType determines whether typ is an array type.
+func isArrayType(typ string) bool {
+	switch GetPrefix(underlying(typ)) {
+	case ARRINT, ARRSTR, ARR:
+		return true
+	}
+	return false
+}
+
+// fieldOffset returns the offset (in words) and the type of a field of a
+// struct of the given type in a block.
+func fieldOffset(typeName, field string) (int, string, bool) {
+	fields := globalSymTab[typeName].symbols
+	off := 0
+	for k := 0; k < len(fields); k += 2 {
+		if fields[k] == field {
+			return off, fields[k+1], true
+		}
+		off += wordsOf([]string{fields[k+1]})
+	}
+	return 0, "", false
+}
+
+// deref returns the value of the given type at an offset (in words) from the
+// address held by addr.
+func deref(addr string, off int, typ string) (*Node, error) {
+	n := &Node{NewTmp(), []string{}}
+	if isStructType(typ) {
+		n.Code = declareDeref(n.Place, typ, addr, off)
+		return n, nil
+	}
+	if isArrayType(typ) {
+		// An array member of a struct is loaded as the address of its
+		// first element, which is indexed like an array.
+		insertTyped(n.Place, typ, n.Place)
+	} else {
+		InsertSymbol(n.Place, DEREF, addr, strconv.Itoa(off), typ)
+		setNamed(n.Place, typ)
+	}
+	n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, %d", memOp(tac.FROM, GetKind(typ)), n.Place, addr, off))
+	return n, nil
+}
+
+// derefArray returns the array pointed to by the pointer at expr, which is
+// referred to by the address held by the pointer.
+func derefArray(expr *Node, typ string) *Node {
+	n := &Node{NewTmp(), expr.Code}
+	insertTyped(n.Place, typ, n.Place)
+	n.Code = append(n.Code, fmt.Sprintf("=, %s, %s", n.Place, expr.Place))
+	return n
+}
+
+// declareDeref declares a struct of the given type which is loaded from an
+// offset (in words) from the address held by addr, and returns the code for
+// loading its members.
+func declareDeref(name, typeName, addr string, off int) []string {
+	InsertSymbol(name, STRUCT, name, typeName, addr, strconv.Itoa(off))
+	code := []string{}
+	fields := globalSymTab[typeName].symbols
+	for k := 0; k < len(fields); k += 2 {
+		key := name + "." + fields[k]
+		if isStructType(fields[k+1]) {
+			code = append(code, declareDeref(key, fields[k+1], addr, off)...)
+			off += wordsOf([]string{fields[k+1]})
+			continue
+		}
+		t := NewTmp()
+		insertTyped(key, fields[k+1], t)
+		if isArrayType(fields[k+1]) {
+			insertTyped(t, fields[k+1], t)
+		} else {
+			InsertSymbol(t, DEREF, addr, strconv.Itoa(off), fields[k+1])
+		}
+		code = append(code, fmt.Sprintf("%s, %s, %s, %d", memOp(tac.FROM, GetKind(fields[k+1])), t, addr, off))
+		off += wordsOf([]string{fields[k+1]})
+	}
+	return code
+}
+
+// selectField returns a field of the struct of the given type pointed to by
+// the pointer at expr.
+func selectField(expr *Node, typeName, field string) (*Node, error) {
+	off, typ, ok := fieldOffset(typeName, field)
+	if !ok {
+		return nil, ErrUndefined(fmt.Sprintf("%s.%s", RealName(expr.Place), field))
+	}
+	n, err := deref(expr.Place, off, typ)
+	if err != nil {
+		return nil, err
+	}
+	n.Code = utils.AppendCode(expr.Code, n.Code)
+	return n, nil
+}
+
+// addrNode returns a pointer to a value of the given type, which is at an
+// offset (in words) from the address held by base.
+func addrNode(n *Node, base string, off int, typ string) *Node {
+	n.Place = NewTmp()
+	InsertSymbol(n.Place, POINTER, n.Place, typ)
+	if off == 0 {
+		n.Code = append(n.Code, fmt.Sprintf("=, %s, %s", n.Place, base))
+	} else {
+		n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, %d", tac.ADD, n.Place, base, off*tac.WordSize))
+	}
+	return n
+}
+
+// heapAlloc returns a pointer to a copy of the value at a place of the given
+// type, which is allocated on the heap. A struct is copied member by member,
+// where an array member is copied to a separate block.
+func heapAlloc(n *Node, place, typ string) (*Node, error) {
+	values := []string{place}
+	if isStruct(place) {
+		values = members(place)
+	}
+	n.Place = NewTmp()
+	InsertSymbol(n.Place, POINTER, n.Place, typ)
+	n.Code = append(n.Code, newBlock(n.Place, varWords(values))...)
+	off := 0
+	for _, v := range values {
+		kind := KindOf(v)
+		if length, ok := arrayLen(v); ok {
+			size, _ := strconv.Atoi(length)
+			t := NewTmp()
+			InsertSymbol(t, KindOf(v), t, length)
+			n.Code = append(n.Code, newBlock(t, size)...)
+			n.Code = append(n.Code, copyArray(t, v, length)...)
+			v = t
+		}
+		n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, %d, %s", memOp(tac.INTO, kind), n.Place, n.Place, off, v))
+		off += words([]symkind{kind})
+	}
+	return n, nil
+}
+
+// newValue returns a call to the builtin new, which allocates a zero value of
+// the given type on the heap.
+func newValue(typ string) (*Node, error) {
+	if symEntry, found := globalSymTab[typ]; found && symEntry.kind == ALIAS {
+		typ = symEntry.symbols[0]
+	}
+	if isStructType(typ) {
+		lit, err := newStructLit(typ, &Node{"", []string{}})
+		if err != nil {
+			return nil, err
+		}
+		return heapAlloc(&Node{"", lit.Code}, lit.Place, typ)
+	}
+	if isArrayType(typ) {
+		n := &Node{NewTmp(), []string{}}
+		InsertSymbol(n.Place, POINTER, n.Place, typ)
+		n.Code = newBlock(n.Place, sizeOf(typ))
+		if !isZeroed(typ) {
+			n.Code = append(n.Code, zeroFill(n.Place, strconv.Itoa(sizeOf(typ)), typ)...)
+		}
+		return n, nil
+	}
+	if GetKind(typ) == NIL {
+		return nil, fmt.Errorf("%s is not a type", RealName(typ))
+	}
+	if err := checkPointee(typ); err != nil {
+		return nil, err
+	}
+	t := NewTmp()
+	insertTyped(t, typ, t)
+	return heapAlloc(&Node{"", zeroValue(GetKind(typ), t)}, t, typ)
+}
+
+// scopeOf returns the scope in which the variable at a place is declared.
+func scopeOf(place string) *SymInfo {
+	for s := currScope; s != nil; s = s.parent {
+		if symEntry, ok := s.symTab[RealName(place)]; ok && len(symEntry.symbols) > 0 &&
+			symEntry.symbols[0] == place {
+			return s
+		}
+	}
+	return nil
+}
+
+// structRoot returns the outermost struct containing the value at a place,
+// which is either a struct or a member of one.
+func structRoot(place string) (string, bool) {
+	root := RealName(place)
+	if !isStruct(root) || (root != place && !strings.HasPrefix(place, root+".")) {
+		return "", false
+	}
+	return root, true
+}
+
+// valueType returns the type of the value held by a variable, verifying that
+// it can be pointed to.
+func valueType(place string) (string, error) {
+	if typ, ok := ptrType(place); ok {
+		return typ, nil
+	}
+	if _, ok := arrayLen(place); ok {
+		return "", fmt.Errorf("taking the address of array variables is not supported")
+	}
+	typ := typeOf(place)
+	if err := checkPointee(typ); err != nil {
+		return "", err
+	}
+	return typ, nil
+}
+
+// addressOf returns the address of the value at a place. A variable of the
+// function being declared is moved to a box (or a block), and one declared in
+// an enclosing function is addressed by its box if it is captured.
+func addressOf(expr *Node) (*Node, error) {
+	place := expr.Place
+	n := &Node{"", expr.Code}
+	errAddr := fmt.Errorf("cannot take the address of %s", exprName(place))
+	if root, ok := structRoot(place); ok {
+		return memberAddr(n, root, place)
+	}
+	if typ, ok := arrayType(place); ok && arrayLits[place] {
+		// An array literal is copied to the heap.
+		n.Place = NewTmp()
+		InsertSymbol(n.Place, POINTER, n.Place, typ)
+		n.Code = append(n.Code, newBlock(n.Place, sizeOf(typ))...)
+		n.Code = append(n.Code, copyArray(n.Place, place, strconv.Itoa(sizeOf(typ)))...)
+		return n, nil
+	}
+	symEntry, found := lookupPlace(place)
+	if !found {
+		return nil, errAddr
+	}
+	switch symEntry.kind {
+	case DEREF:
+		off, _ := strconv.Atoi(symEntry.symbols[1])
+		return addrNode(n, symEntry.symbols[0], off, symEntry.symbols[2]), nil
+	case ARRAYINT, ARRAYSTR:
+		// The address of an element of an array (or a slice) is offset
+		// from the address of its first element.
+		typ := INT
+		if symEntry.kind == ARRAYSTR {
+			typ = STR
+		}
+		base, index := symEntry.symbols[0], symEntry.symbols[1]
+		if i, err := strconv.Atoi(index); err == nil {
+			return addrNode(n, base, i, typ), nil
+		}
+		off := NewTmp()
+		n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, %d", tac.MUL, off, index, tac.WordSize))
+		n = addrNode(n, base, 0, typ)
+		n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s, %s", tac.ADD, n.Place, n.Place, off))
+		return n, nil
+	case INTEGER, STRING, BOOLEAN, BYTE, RUNE, POINTER, FLOAT32, FLOAT64:
+	default:
+		return nil, errAddr
+	}
+	scope := scopeOf(place)
+	if scope == nil {
+		return nil, errAddr
+	}
+	typ, err := valueType(place)
+	if err != nil {
+		return nil, err
+	}
+	ctx := currFunc()
+	switch {
+	case scope.parent == nil:
+		addrGlobals[place] = KindOf(place)
+		n.Place = NewTmp()
+		InsertSymbol(n.Place, POINTER, n.Place, typ)
+		n.Code = append(n.Code, fmt.Sprintf("%s, %s, %s", tac.ADDR, n.Place, place))
+		return n, nil
+	case encloses(ctx.scope, scope) && isFloat(symEntry.kind):
+		// A floating-point variable is never captured, hence it is
+		// held by a block rather than a box.
+		ctx.blocks[place] = []string{blockVar(place)}
+	case encloses(ctx.scope, scope):
+		ctx.boxed[place] = true
+	case !utils.Contains(ctx.captures, place):
+		return nil, errAddr
+	}
+	return addrNode(n, "box:"+place, 0, typ), nil
+}
+
+// memberAddr returns the address of a struct (or a member of a struct) at a
+// place, given the outermost struct containing it. A struct literal is copied
+// to the heap, whereas a struct variable is moved to a block.
+func memberAddr(n *Node, root, place string) (*Node, error) {
+	symEntry, _ := Lookup(root)
+	typ := structType(place)
+	if typ == "" {
+		var err error
+		if typ, err = valueType(place); err != nil {
+			return nil, err
+		}
+	}
+	vars := members(root)
+	if structLits[root] && root == place || len(vars) == 0 {
+		return heapAlloc(n, place, typ)
+	}
+	first := place
+	if isStruct(place) {
+		first = members(place)[0]
+	}
+	k := 0
+	for ; k < len(vars) && vars[k] != first; k++ {
+	}
+	off := varWords(vars[:k])
+	if len(symEntry.symbols) == 4 {
+		// The struct has been loaded through a pointer.
+		base, _ := strconv.Atoi(symEntry.symbols[3])
+		return addrNode(n, symEntry.symbols[2], base+off, typ), nil
+	}
+	scope := scopeOf(root)
+	switch {
+	case scope == nil || scope.parent == nil:
+		return nil, fmt.Errorf("cannot take the address of %s (global struct)", RealName(place))
+	case !encloses(currFunc().scope, scope):
+		return nil, fmt.Errorf("cannot take the address of %s", RealName(place))
+	}
+	block := []string{}
+	for _, v := range vars {
+		block = append(block, blockVar(v))
+	}
+	currFunc().blocks[vars[0]] = block
+	return addrNode(n, "box:"+vars[0], off, typ), nil
+}
+
+// boxGlobals rewrites the code of a function (along with the function literals
+// declared in it) so that the globals whose addresses are taken are accessed
+// through their addresses. Such a global is held by a local variable, which is
+// loaded from the global before an instruction referencing it, and is stored
+// back after an instruction modifying it.
+func boxGlobals(code []string) []string {
+	if len(addrGlobals) == 0 {
+		return code
+	}
+	globals := []string{}
+	for v := range addrGlobals {
+		globals = append(globals, v)
+	}
+	sort.Strings(globals)
+	out := []string{}
+	local, addr := make(map[string]string), make(map[string]string)
+	for _, v := range code {
+		for _, line := range strings.Split(v, "\n") {
+			fields := utils.Split(line, ",")
+			for i := range fields {
+				fields[i] = strings.TrimSpace(fields[i])
+			}
+			if fields[0] == tac.FUNC {
+				// The globals are held by the locals of the function.
+				for _, g := range globals {
+					local[g], addr[g] = RenameVariable(RealName(g)), NewTmp()
+				}
+			}
+			if fields[0] == tac.ADDR || fields[0] == tac.FUNC || len(local) == 0 {
+				out = append(out, line)
+				continue
+			}
+			load, store := []string{}, []string{}
+			changed := false
+			for i, v := range fields[1:] {
+				kind, ok := addrGlobals[v]
+				if !ok {
+					continue
+				}
+				changed = true
+				fields[i+1] = local[v]
+				if s := fmt.Sprintf("%s, %s, %s", tac.ADDR, addr[v], v); !utils.Contains(load, s) {
+					load = append(load, s, fmt.Sprintf("%s, %s, %s, 0", memOp(tac.FROM, kind), local[v], addr[v]))
+				}
+				if i == 0 && !readsDst(fields[0]) {
+					store = append(store, fmt.Sprintf("%s, %s, %s, 0, %s", memOp(tac.INTO, kind), addr[v], addr[v], local[v]))
+				}
+			}
+			if !changed {
+				out = append(out, line)
+				continue
+			}
+			out = append(out, load...)
+			out = append(out, strings.Join(fields, ", "))
+			out = append(out, store...)
+		}
+	}
+	return out
+}
@@ -0,0 +1,129 @@
+// This file implements the range clauses of for statements over arrays, slices,
+// strings and integers. These are lowered to a loop over an index, which is
+// incremented at the beginning of each iteration so that a continue statement
+// proceeds to the next element. The range clauses over maps and channels are
+// lowered to calls to the runtime (see maps.go and channels.go).
+
+package ast
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/shivansh/gogo/src/tac"
+)
+
+// rangeTypes returns the types of the iteration values of a range expression,
+// i.e. those of the index (key) and the element.
+func rangeTypes(place string) ([]string, error) {
+	if symEntry, ok := mapEntry(place); ok {
+		return symEntry.symbols[1:3], nil
+	}
+	if symEntry, ok := chanEntry(place); ok {
+		return symEntry.symbols[1:2], nil
+	}
+	if symEntry, ok := sliceEntry(place); ok {
+		return []string{INT, symEntry.symbols[1]}, nil
+	}
+	if symEntry, ok := lookupPlace(place); ok && symEntry.kind == ARRAY {
+		return []string{INT, symEntry.symbols[2]}, nil
+	}
+	kind := KindOf(place)
+	if _, ok := arrayLen(place); ok && kind == STRING {
+		return []string{INT, STR}, nil
+	}
+	switch kind {
+	case STRING:
+		// A string is ranged over byte-wise, where the bytes are
+		// taken to be runes.
+		return []string{INT, RUN}, nil
+	case INTEGER:
+		if _, ok := arrayLen(place); ok {
+			return []string{INT, INT}, nil
+		}
+		return []string{INT}, nil
+	}
+	return nil, fmt.Errorf("cannot range over %s", exprName(place))
+}
+
+// rangeCode returns the code for ranging over the given expression, of the
+// form -
+//	{ init stmt, next iteration, binding of iteration variables }
+// along with the place value which becomes 0 when the iteration ends. The
+// index and the element are bound to key and elem, unless these are empty.
+func rangeCode(place, key, elem string) (string, [3][]string) {
+	if _, ok := mapEntry(place); ok {
+		return mapRange(place, key, elem)
+	}
+	if _, ok := chanEntry(place); ok {
+		// The values of a channel are bound to the first iteration
+		// variable.
+		return chanRange(place, key)
+	}
+	var code [3][]string
+	idx, cond := NewTmp(), NewTmp()
+	code[0] = []string{fmt.Sprintf("=, %s, -1", idx)}
+	code[1] = []string{fmt.Sprintf("%s, %s, %s, 1", tac.ADD, idx, idx)}
+
+	if KindOf(place) == STRING {
+		if _, ok := arrayLen(place); !ok {
+			// The iteration ends at the terminating null byte.
+			s, c := strValue(place)
+			base := NewTmp()
+			code[0] = append(code[0], c...)
+			code[0] = append(code[0], fmt.Sprintf("=, %s, %s", base, s))
+			code[1] = append(code[1], fmt.Sprintf("%s, %s, %s, %s", tac.FROMB, cond, base, idx))
+			if key != "" {
+				code[2] = append(code[2], fmt.Sprintf("=, %s, %s", key, idx))
+			}
+			if elem != "" {
+				code[2] = append(code[2], fmt.Sprintf("=, %s, %s", elem, cond))
+			}
+			return cond, code
+		}
+	}
+
+	// The length and the base address are evaluated once, before the
+	// first iteration.
+	length, base := NewTmp(), place
+	if _, ok := sliceEntry(place); ok {
+		base = NewTmp()
+		code[0] = append(code[0],
+			fmt.Sprintf("%s, %s, %s, %d", tac.FROM, length, place, sliceLen),
+			fmt.Sprintf("%s, %s, %s, %d", tac.FROM, base, place, slicePtr),
+		)
+	} else if arrLen, ok := arrayLen(place); ok {
+		code[0] = append(code[0], fmt.Sprintf("=, %s, %s", length, arrLen))
+	} else {
+		code[0] = append(code[0], fmt.Sprintf("=, %s, %s", length, place))
+	}
+	next := NewLabel()
+	code[1] = append(code[1],
+		fmt.Sprintf("=, %s, 0", cond),
+		fmt.Sprintf("%s, %s, %s, %s", tac.BGE, next, idx, length),
+		fmt.Sprintf("=, %s, 1", cond),
+		fmt.Sprintf("label, %s", next),
+	)
+	if key != "" {
+		code[2] = append(code[2], fmt.Sprintf("=, %s, %s", key, idx))
+	}
+	typ := ""
+	if symEntry, ok := sliceEntry(place); ok {
+		typ = symEntry.symbols[1]
+	} else if symEntry, ok := lookupPlace(place); ok && symEntry.kind == ARRAY {
+		typ = symEntry.symbols[2]
+	}
+	if typ != "" && elem != "" {
+		addr, c := elemAddr(base, idx, sizeOf(typ))
+		code[2] = append(code[2], c...)
+		if isArrayType(typ) {
+			// An element which is an array is copied.
+			code[2] = append(code[2], copyArray(elem, addr, strconv.Itoa(sizeOf(typ)))...)
+		} else {
+			code[2] = append(code[2], fmt.Sprintf("%s, %s, %s, 0", memOp(tac.FROM, GetKind(typ)), elem, addr))
+		}
+	} else if elem != "" {
+		code[2] = append(code[2], fmt.Sprintf("%s, %s, %s, %s", tac.FROM, elem, base, idx))
+	}
+	return cond, code
+}
@@ -0,0 +1,309 @@
+// This file implements the results of functions. The first two results of a
+// call are returned in $v0 and $v1, and the remaining ones in the words pushed
+// by the caller for the arguments, the caller pushing additional words before
+// the arguments when the results returned this way outnumber the arguments. A
+// struct is passed and returned as its members, and a float64 value occupies
+// two words.
+
+package ast
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shivansh/gogo/src/tac"
+)
+
+// isStructType determines whether typ is the name of a struct type.
+func isStructType(typ string) bool {
+	symEntry, found := globalSymTab[typ]
+	return found && symEntry.kind == STRUCT
+}
+
+// flatTypes returns the types of the values which are passed for the given
+// types, where a struct is passed as its members.
+func flatTypes(types []string) []string {
+	flat := []string{}
+	for _, v := range types {
+		if isStructType(v) {
+			for k, field := range globalSymTab[v].symbols {
+				if k%2 == 1 {
+					flat = append(flat, flatTypes([]string{field})...)
+				}
+			}
+		} else {
+			flat = append(flat, v)
+		}
+	}
+	return flat
+}
+
+// flatValues returns the values which are passed for the given places, where
+// a struct is passed as its members.
+func flatValues(places []string) []string {
+	flat := []string{}
+	for _, v := range places {
+		if isStruct(v) {
+			flat = append(flat, members(v)...)
+		} else {
+			flat = append(flat, v)
+		}
+	}
+	return flat
+}
+
+// words returns the number of words occupied by the values of the given kinds.
+func words(kinds []symkind) int {
+	n := 0
+	for _, v := range kinds {
+		n++
+		if v == FLOAT64 {
+			n++
+		}
+	}
+	return n
+}
+
+// padArgs returns the code for pushing the words required for the results
+// returned on the stack which are not covered by the arguments.
+func padArgs(argLen, resultLen int) []string {
+	code := []string{}
+	for k := argLen; k < resultLen-2; k++ {
+		code = append(code, fmt.Sprintf("%s, 0", tac.ARG))
+	}
+	return code
+}
+
+// callCode appends the code for a call to the node of the call. The results
+// are described by the number of results followed by their types and those of
+// the parameters (if known), and the place attribute of the node holds the
+// places of the results. The values passed for the parameters are verified
+// against their types.
+func callCode(n *Node, op, callee string, args, results []string) error {
+	returnLen, err := strconv.Atoi(results[0])
+	if err != nil {
+		return err
+	}
+	params := results[returnLen+1:]
+	results = results[1 : returnLen+1]
+	args, code := argValues(args)
+	n.Code = append(n.Code, code...)
+	if args, params, err = variadicArgs(n, callee, args, params, op == tac.CALL); err != nil {
+		return err
+	}
+	argKinds := []symkind{}
+	for k, v := range args {
+		// A constant passed for a parameter takes its type, where an
+		// untyped floating-point constant otherwise defaults to float64.
+		kind := KindOf(v)
+		if k < len(params) {
+			if err := checkArg(v, params[k], callee); err != nil {
+				return err
+			}
+			kind = GetKind(params[k])
+		}
+		// A string literal is passed as the address of its contents, and
+		// nil is passed for a slice as an empty slice.
+		v, code := strValue(v)
+		switch {
+		case kind == SLICE && isNil(v):
+			v, code = newSliceHeader("0", "0", "0")
+		case isFloat(kind):
+			var c []string
+			v, c = floatValue(v, kind)
+			code = append(code, c...)
+		case isInteger(kind):
+			if v, err = intConst(v); err == nil {
+				err = representable(v, kind)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		args[k] = v
+		n.Code = append(n.Code, code...)
+		argKinds = append(argKinds, kind)
+	}
+	resultKinds := []symkind{}
+	for _, v := range flatTypes(results) {
+		resultKinds = append(resultKinds, GetKind(v))
+	}
+	pad := padArgs(words(argKinds), words(resultKinds))
+	n.Code = append(n.Code, pad...)
+	for _, v := range args {
+		n.Code = append(n.Code, fmt.Sprintf("%s, %s", tac.ARG, v))
+	}
+	n.Code = append(n.Code, fmt.Sprintf("%s, %s, %d", op, callee, words(argKinds)+len(pad)))
+
+	// The results are copied right after the call, before they can be
+	// overwritten by another call.
+	places, dst := []string{}, []string{}
+	for _, v := range results {
+		t := NewTmp()
+		if isStructType(v) {
+			dst = append(dst, declareStruct(t, v)...)
+		} else {
+			insertTyped(t, v, t)
+			dst = append(dst, t)
+		}
+		places = append(places, t)
+	}
+	// The array members of the results are copied from the frame of the
+	// callee once they are stored.
+	copies := arrayResults(dst)
+	// The index of a result is that of its first word.
+	for k, v := range dst {
+		store := tac.STORE
+		if isFloat(resultKinds[k]) {
+			store = floatOp(tac.STORE, resultKinds[k])
+		}
+		if w := words(resultKinds[:k]); w == 0 {
+			n.Code = append(n.Code, fmt.Sprintf("%s, %s", store, v))
+		} else {
+			n.Code = append(n.Code, fmt.Sprintf("%s, %s, %d", store, v, w))
+		}
+	}
+	n.Code = append(n.Code, copies...)
+	n.Place = strings.Join(places, ", ")
+	return nil
+}
+
+// zeroValue returns the code for declaring a variable initialized to the zero
+// value of its kind.
+func zeroValue(kind symkind, renamedVar string) []string {
+	switch kind {
+	case STRING:
+		return []string{fmt.Sprintf("declStr, %s, \"\"", renamedVar)}
+	case FLOAT32, FLOAT64:
+		return []string{fmt.Sprintf("%s, %s, 0.0", floatOp(tac.EQ, kind), renamedVar)}
+	case SLICE:
+		// The zero value of a slice is an empty slice. The header of a
+		// package level slice resides in the data section, as the
+		// runtime cannot be called outside of a function.
+		if currScope.parent == nil {
+			header := NewTmp()
+			return []string{
+				fmt.Sprintf("%s, %s, 3", tac.DECL, header),
+				fmt.Sprintf("declInt, %s, %s", renamedVar, header),
+			}
+		}
+		header, code := newSliceHeader("0", "0", "0")
+		return append(code, fmt.Sprintf("declInt, %s, %s", renamedVar, header))
+	default:
+		// The zero value of a map (function) is nil.
+		return []string{fmt.Sprintf("declInt, %s, 0", renamedVar)}
+	}
+}
+
+// declareResults declares the named results of the function being declared,
+// which are initialized to their zero values on entry.
+func declareResults(names, types []string) {
+	ctx := currFunc()
+	for k, v := range names {
+		if isStructType(types[k]) {
+			declareStruct(v, types[k])
+			ctx.resultDecl = append(ctx.resultDecl, zeroStruct(v)...)
+			ctx.results = append(ctx.results, v)
+			continue
+		}
+		renamedVar := RenameVariable(v)
+		insertTyped(v, types[k], renamedVar)
+		ctx.resultDecl = append(ctx.resultDecl, zeroValue(GetKind(types[k]), renamedVar)...)
+		ctx.results = append(ctx.results, renamedVar)
+	}
+}
+
+// returnValues returns the values returned for the given places after
+// verifying them against the results of the function being declared, along
+// with the code for evaluating them.
+func returnValues(places []string) ([]string, []string, error) {
+	types := currFunc().resultTypes
+	switch {
+	case len(places) < len(types):
+		return nil, nil, fmt.Errorf("not enough return values\n\thave %d\n\twant %d", len(places), len(types))
+	case len(places) > len(types):
+		return nil, nil, fmt.Errorf("too many return values\n\thave %d\n\twant %d", len(places), len(types))
+	}
+	code := []string{}
+	for k, v := range places {
+		var c []string
+		if GetPrefix(v) == STR {
+			v, c = strValue(v)
+		} else {
+			var err error
+			if v, c, err = funcValue(v); err != nil {
+				return nil, nil, err
+			}
+		}
+		code = append(code, c...)
+		if err := checkArg(v, types[k], ""); err != nil {
+			return nil, nil, fmt.Errorf("cannot use %s (type %s) as type %s in return argument",
+				exprName(v), typeOf(v), displayType(types[k]))
+		}
+		want := GetKind(types[k])
+		switch {
+		case want == SLICE && isNil(v):
+			// nil is returned for a slice as an empty slice.
+			v, c = newSliceHeader("0", "0", "0")
+			code = append(code, c...)
+		case isFloat(want):
+			// A constant is returned as a value of the result type.
+			v, c = floatValue(v, want)
+			code = append(code, c...)
+		case isInteger(want):
+			var err error
+			if v, err = intConst(v); err != nil {
+				return nil, nil, err
+			}
+			if re.MatchString(v) {
+				if err := representable(v, want); err != nil {
+					return nil, nil, err
+				}
+				places[k] = v
+				continue
+			}
+		}
+		places[k] = v
+		if isStructType(types[k]) {
+			want = STRUCT
+		}
+		if have := KindOf(v); want != NIL && have != NIL && have != want {
+			return nil, nil, fmt.Errorf("cannot use %s (type %s) as type %s in return argument",
+				RealName(v), GetType(have), types[k])
+		}
+		if want == STRUCT {
+			symEntry, _ := Lookup(v)
+			if typeName := symEntry.symbols[1]; typeName != types[k] {
+				return nil, nil, fmt.Errorf("cannot use %s (type %s) as type %s in return argument",
+					RealName(v), typeName, types[k])
+			}
+		}
+	}
+	values, c := heapArrays(flatValues(places))
+	return values, append(code, c...), nil
+}
+
+// heapArrays returns the values returned for the given values, where an array
+// (which is a member of a struct) is returned by the address of a copy on the
+// heap, since the array resides in the frame of the returning function.
+func heapArrays(values []string) ([]string, []string) {
+	code := []string{}
+	for k, v := range values {
+		typ, ok := arrayType(v)
+		if !ok {
+			continue
+		}
+		t, size := NewTmp(), strconv.Itoa(sizeOf(typ))
+		insertTyped(t, typ, t)
+		code = append(code, runtimeCall(t, "malloc", strconv.Itoa(sizeOf(typ)*tac.WordSize))...)
+		code = append(code, copyArray(t, v, size)...)
+		values[k] = t
+	}
+	return values, code
+}
+
+// retCode returns the return statement for the given values.
+func retCode(values []string) string {
+	return strings.TrimSuffix(fmt.Sprintf("%s, %s", tac.RET, strings.Join(values, ", ")), " ")
+}
@@ -0,0 +1,128 @@
+// This file implements the operations on strings which are carried out by the
+// runtime, namely the concatenation and the comparison of strings along with
+// the conversion of integers to strings. The strings created at runtime are
+// allocated on the heap and are never freed.
+
+package ast
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/shivansh/gogo/src/tac"
+)
+
+// isStringExpr determines whether any of the places refers to a string.
+func isStringExpr(places ...string) bool {
+	for _, v := range places {
+		if KindOf(v) == STRING {
+			return true
+		}
+	}
+	return false
+}
+
+// strKind verifies that both the operands of a binary operation are strings.
+func strKind(op, left, right string) error {
+	leftKind, rightKind := KindOf(left), KindOf(right)
+	if leftKind != rightKind {
+		return fmt.Errorf("invalid operation: %s %s %s (mismatched types %s and %s)",
+			exprName(left), op, exprName(right),
+			typeOf(left), typeOf(right))
+	}
+	return nil
+}
+
+// concat returns the code for evaluating the concatenation of two strings into
+// dst. The string literals are declared before being passed to the runtime.
+func concat(dst, left, right string) []string {
+	left, code := strValue(left)
+	right, rightCode := strValue(right)
+	return append(append(code, rightCode...),
+		fmt.Sprintf("%s, %s", tac.ARG, left),
+		fmt.Sprintf("%s, %s", tac.ARG, right),
+		fmt.Sprintf("%s, %s, 2", tac.CALL, RuntimeFunc("concat")),
+		fmt.Sprintf("%s, %s", tac.STORE, dst),
+	)
+}
+
+// newStrArith returns the concatenation of two strings, which is the only
+// arithmetic operation on strings. The concatenation of string literals is
+// evaluated during compilation.
+func newStrArith(op string, leftexpr, rightexpr *Node) (*Node, error) {
+	if op != ADD {
+		return nil, fmt.Errorf("invalid operation: operator %s not defined on %s (type string)",
+			op, exprName(leftexpr.Place))
+	}
+	if err := strKind(op, leftexpr.Place, rightexpr.Place); err != nil {
+		return nil, err
+	}
+	n := &Node{"", append(leftexpr.Code, rightexpr.Code...)}
+	if GetPrefix(leftexpr.Place) == STR && GetPrefix(rightexpr.Place) == STR {
+		left, err := strconv.Unquote(StripPrefix(leftexpr.Place))
+		if err != nil {
+			return nil, err
+		}
+		right, err := strconv.Unquote(StripPrefix(rightexpr.Place))
+		if err != nil {
+			return nil, err
+		}
+		n.Place = fmt.Sprintf("%s:%s", STR, strconv.Quote(left+right))
+		return n, nil
+	}
+	n.Place = NewTmp()
+	InsertSymbol(n.Place, STRING, n.Place)
+	n.Code = append(n.Code, concat(n.Place, leftexpr.Place, rightexpr.Place)...)
+	return n, nil
+}
+
+// newStrRel returns the comparison of two strings, which compares the result
+// of runtime.strcmp against zero.
+func newStrRel(op, leftexpr, rightexpr *Node) (*Node, error) {
+	if err := strKind(op.Place, leftexpr.Place, rightexpr.Place); err != nil {
+		return nil, err
+	}
+	cmp := &Node{NewTmp(), append(leftexpr.Code, rightexpr.Code...)}
+	InsertSymbol(cmp.Place, INTEGER, cmp.Place)
+	left, code := strValue(leftexpr.Place)
+	right, rightCode := strValue(rightexpr.Place)
+	cmp.Code = append(append(cmp.Code, code...), rightCode...)
+	cmp.Code = append(cmp.Code,
+		fmt.Sprintf("%s, %s", tac.ARG, left),
+		fmt.Sprintf("%s, %s", tac.ARG, right),
+		fmt.Sprintf("%s, %s, 2", tac.CALL, RuntimeFunc("strcmp")),
+		fmt.Sprintf("%s, %s", tac.STORE, cmp.Place),
+	)
+	return NewRelExpr(op, cmp, &Node{"0", []string{}})
+}
+
+// strAssignOp returns the code for the assignment operation "dst op= src" on
+// strings.
+func strAssignOp(op, dst, src string) ([]string, error) {
+	if op != ADD {
+		return nil, fmt.Errorf("invalid operation: operator %s not defined on %s (type string)",
+			op, RealName(dst))
+	}
+	if err := strKind(op, dst, src); err != nil {
+		return nil, err
+	}
+	return concat(dst, dst, src), nil
+}
+
+// stringConv returns the conversion of an integer to a string, which yields
+// the UTF-8 encoding of the integer as a rune. A constant is converted during
+// compilation.
+func stringConv(n, expr *Node) (*Node, error) {
+	if val, err := strconv.Atoi(expr.Place); err == nil {
+		n.Place = fmt.Sprintf("%s:%s", STR, strconv.Quote(string(rune(val))))
+		return n, nil
+	}
+	n.Place = NewTmp()
+	InsertSymbol(n.Place, STRING, n.Place)
+	n.Code = append(n.Code,
+		fmt.Sprintf("%s, %s", tac.ARG, expr.Place),
+		fmt.Sprintf("%s, %s, 1", tac.CALL, RuntimeFunc("runestring")),
+		fmt.Sprintf("%s, %s", tac.STORE, n.Place),
+	)
+	return n, nil
+}
@@ -0,0 +1,415 @@
+// This file implements the struct values. The members of a struct are held in
+// separate variables, where the members of a nested struct are in turn those
+// of the struct it is nested in. A struct literal is held by a temporary
+// struct, which is copied member by member where it is assigned. An array
+// member is copied element by element, and it is passed to a function by the
+// address of a copy made by the caller so that structs are passed by value.
+
+package ast
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/shivansh/gogo/src/tac"
+	"github.com/shivansh/gogo/src/utils"
+)
+
+// lookupPlace returns the symbol table entry of the variable at a place. The
+// members of a struct are renamed from keys of the form "<struct>.<member>",
+// hence they are looked up by these keys.
+func lookupPlace(place string) (*SymTabEntry, bool) {
+	if isStruct(place) {
+		return Lookup(place)
+	}
+	symEntry, found := Lookup(RealName(place))
+	if found && symEntry.kind == STRUCT && place != symEntry.symbols[0] {
+		if key, ok := renamedKey(place); ok {
+			return Lookup(key)
+		}
+	}
+	return symEntry, found
+}
+
+// structType returns the type of the struct at a place, which is empty if the
+// place does not refer to a struct.
+func structType(place string) string {
+	if !isStruct(place) {
+		return ""
+	}
+	symEntry, _ := Lookup(place)
+	return symEntry.symbols[1]
+}
+
+// checkStruct verifies that the value at src can be assigned to dst when
+// either of them is a struct.
+func checkStruct(dst, src string) error {
+	dstType, srcType := structType(dst), structType(src)
+	if dstType == srcType {
+		return nil
+	}
+	if dstType == "" {
+		dstType = typeOf(dst)
+	}
+	if srcType == "" {
+		srcType = typeOf(src)
+	}
+	return fmt.Errorf("cannot use %s (type %s) as type %s in assignment",
+		exprName(src), srcType, dstType)
+}
+
+// structSpec returns a variable specification which declares structs of the
+// given type. The structs are initialized with the values at the places in
+// expr, or with their zero values if expr is empty.
+func structSpec(n *Node, idents []string, typeName string, expr []string) (*Node, error) {
+	if len(expr) != 0 && len(expr) != len(idents) {
+		return nil, ErrCountMismatch(len(idents), len(expr))
+	}
+	if len(expr) != 0 && currScope.parent == nil {
+		return nil, ErrGlobalStrct
+	}
+	for k, v := range idents {
+		declareStruct(v, typeName)
+		if len(expr) == 0 {
+			n.Code = append(n.Code, zeroStruct(v)...)
+			continue
+		}
+		if err := checkStruct(v, expr[k]); err != nil {
+			return nil, err
+		}
+		n.Code = append(n.Code, copyMembers(members(v), members(expr[k]))...)
+	}
+	return n, nil
+}
+
+// NewFieldKey returns a keyed element of a composite literal whose key is an
+// identifier, which is either the name of a field of a struct or a key of a
+// map. The key is placed as "field:<identifier>".
+func NewFieldKey(ident string, elem *Node) (*Node, error) {
+	return &Node{fmt.Sprintf("%s:%s, %s", FLD, ident, elem.Place), elem.Code}, nil
+}
+
+// fieldValues returns the values of the fields of a struct literal, where the
+// value of a field which is not present in the literal is empty.
+func fieldValues(typeName string, litVals []string) ([]string, error) {
+	fields := globalSymTab[typeName].symbols
+	values := make([]string, len(fields)/2)
+	if len(litVals) == 0 {
+		return values, nil
+	}
+	if GetPrefix(litVals[0]) != FLD {
+		for _, v := range litVals {
+			if GetPrefix(v) == FLD {
+				return nil, fmt.Errorf("mixture of field:value and value initializers")
+			}
+		}
+		switch {
+		case len(litVals) < len(values):
+			return nil, fmt.Errorf("too few values in struct initializer")
+		case len(litVals) > len(values):
+			return nil, fmt.Errorf("too many values in struct initializer")
+		}
+		return litVals, nil
+	}
+	for k := 0; k < len(litVals); k += 2 {
+		if GetPrefix(litVals[k]) != FLD || k+1 == len(litVals) {
+			return nil, fmt.Errorf("mixture of field:value and value initializers")
+		}
+		name := StripPrefix(litVals[k])
+		i := 0
+		for ; i < len(values) && fields[2*i] != name; i++ {
+		}
+		switch {
+		case i == len(values):
+			return nil, fmt.Errorf("unknown field '%s' in struct literal of type %s", name, typeName)
+		case values[i] != "":
+			return nil, fmt.Errorf("duplicate field name %s in struct literal", name)
+		}
+		values[i] = litVals[k+1]
+	}
+	return values, nil
+}
+
+// newStructLit returns a struct literal, whose fields which are not given a
+// value are initialized to their zero values.
+func newStructLit(typeName string, val *Node) (*Node, error) {
+	n := &Node{NewTmp(), val.Code}
+	litVals := utils.SplitAndSanitize(val.Place, ",")
+	if err := checkElided(litVals); err != nil {
+		return nil, err
+	}
+	values, err := fieldValues(typeName, litVals)
+	if err != nil {
+		return nil, err
+	}
+	declareStruct(n.Place, typeName)
+	structLits[n.Place] = true
+	fields := globalSymTab[typeName].symbols
+	for k, v := range values {
+		code, err := initMember(n.Place+"."+fields[2*k], v)
+		if err != nil {
+			return nil, err
+		}
+		n.Code = append(n.Code, code...)
+	}
+	return n, nil
+}
+
+// initMember returns the code for initializing a member of a struct with the
+// value at src, which is the zero value if src is empty. The key of the member
+// is of the form "<struct>.<member>".
+func initMember(key, src string) ([]string, error) {
+	if isStruct(key) {
+		if src == "" {
+			return zeroStruct(key), nil
+		}
+		if err := checkStruct(key, src); err != nil {
+			return nil, err
+		}
+		return copyMembers(members(key), members(src)), nil
+	}
+	symEntry, _ := Lookup(key)
+	dst := symEntry.symbols[0]
+	if length, ok := arrayLen(dst); ok {
+		if src == "" || isArrayType(src) {
+			typ, _ := arrayType(dst)
+			return declareArray(dst, typ), nil
+		}
+		code := []string{fmt.Sprintf("%s, %s, %s", tac.DECL, dst, length)}
+		return append(code, copyArray(dst, src, length)...), nil
+	}
+	if src == "" {
+		return zeroValue(KindOf(dst), dst), nil
+	}
+	if err := checkNamed(dst, src); err != nil {
+		return nil, err
+	}
+	if err := checkStruct(dst, src); err != nil {
+		return nil, err
+	}
+	if kind := KindOf(dst); isFloat(kind) {
+		code, err := assignFloat(dst, kind, src)
+		if err != nil {
+			return nil, err
+		}
+		return []string{code}, nil
+	}
+	src, code := strValue(src)
+	return append(code, fmt.Sprintf("=, %s, %s", dst, src)), nil
+}
+
+// zeroStruct returns the code for initializing the members of a struct to
+// their zero values.
+func zeroStruct(name string) []string {
+	code := []string{}
+	for _, v := range members(name) {
+		if typ, ok := arrayType(v); ok {
+			code = append(code, declareArray(v, typ)...)
+		} else {
+			code = append(code, zeroValue(KindOf(v), v)...)
+		}
+	}
+	return code
+}
+
+// copyMembers returns the code for declaring the variables dst as copies of
+// the variables src, which hold the members of structs.
+func copyMembers(dst, src []string) []string {
+	code := []string{}
+	for k, v := range dst {
+		if length, ok := arrayLen(v); ok {
+			code = append(code, fmt.Sprintf("%s, %s, %s", tac.DECL, v, length))
+		}
+		code = append(code, moveMember(v, src[k])...)
+	}
+	return code
+}
+
+// moveMember returns the code for copying the member src of a struct into the
+// member dst of another struct.
+func moveMember(dst, src string) []string {
+	if length, ok := arrayLen(dst); ok {
+		return copyArray(dst, src, length)
+	}
+	return []string{fmt.Sprintf("%s, %s, %s", moveOp(KindOf(dst)), dst, src)}
+}
+
+// copyArray returns the code for copying the elements of the array src into
+// the array dst of the given length.
+func copyArray(dst, src, length string) []string {
+	i, t := NewTmp(), NewTmp()
+	InsertSymbol(i, INTEGER, i)
+	if kind := KindOf(dst); kind == STRING {
+		InsertSymbol(t, STRING, t)
+	} else {
+		// The elements of the other arrays are copied a word at a time.
+		InsertSymbol(t, INTEGER, t)
+	}
+	loop, end := NewLabel(), NewLabel()
+	return []string{
+		fmt.Sprintf("%s, %s, 0", tac.EQ, i),
+		fmt.Sprintf("%s, %s", tac.LABEL, loop),
+		fmt.Sprintf("%s, %s, %s, %s", tac.BGE, end, i, length),
+		fmt.Sprintf("%s, %s, %s, %s", tac.FROM, t, src, i),
+		fmt.Sprintf("%s, %s, %s, %s, %s", tac.INTO, dst, dst, i, t),
+		fmt.Sprintf("%s, %s, %s, 1", tac.ADD, i, i),
+		fmt.Sprintf("%s, %s", tac.JMP, loop),
+		fmt.Sprintf("%s, %s", tac.LABEL, end),
+	}
+}
+
+// argValues returns the values passed for the given places, where a struct is
+// passed as its members. An array (or an array member) is passed by the
+// address of a copy.
+func argValues(places []string) ([]string, []string) {
+	values, code := []string{}, []string{}
+	for _, v := range places {
+		if typ, ok := arrayType(v); ok {
+			t, size := NewTmp(), strconv.Itoa(sizeOf(typ))
+			insertTyped(t, typ, t)
+			exprTexts[t] = exprName(v)
+			code = append(code, fmt.Sprintf("%s, %s, %s", tac.DECL, t, size))
+			code = append(code, copyArray(t, v, size)...)
+			values = append(values, t)
+			continue
+		}
+		if !isStruct(v) {
+			values = append(values, v)
+			continue
+		}
+		for _, member := range members(v) {
+			if length, ok := arrayLen(member); ok {
+				t := NewTmp()
+				InsertSymbol(t, KindOf(member), t, length)
+				code = append(code, fmt.Sprintf("%s, %s, %s", tac.DECL, t, length))
+				code = append(code, copyArray(t, member, length)...)
+				member = t
+			}
+			values = append(values, member)
+		}
+	}
+	return values, code
+}
+
+// arrayResults returns the code for copying the array members of the results
+// of a call, which are returned by their addresses. The addresses are stored
+// in the temporaries which replace the array members in dst.
+func arrayResults(dst []string) []string {
+	code := []string{}
+	for k, v := range dst {
+		if length, ok := arrayLen(v); ok {
+			t := NewTmp()
+			InsertSymbol(t, INTEGER, t)
+			dst[k] = t
+			code = append(code, fmt.Sprintf("%s, %s, %s", tac.DECL, v, length))
+			code = append(code, copyArray(v, t, length)...)
+		}
+	}
+	return code
+}
+
+// isCompositeExpr determines whether any of the places holds a struct or an
+// array.
+func isCompositeExpr(places ...string) bool {
+	for _, v := range places {
+		if _, ok := arrayType(v); ok || isStruct(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// compositeRel returns the comparison of structs or arrays, which can only be
+// compared for equality with values of the same type. The values are equal
+// when their corresponding members (or elements) are equal.
+func compositeRel(op, leftexpr, rightexpr *Node) (*Node, error) {
+	left, right := leftexpr.Place, rightexpr.Place
+	if op.Place != EQ && op.Place != NEQ {
+		if !isCompositeExpr(left) {
+			left = right
+		}
+		return nil, ErrOperator(op.Place, exprName(left), typeOf(left))
+	}
+	leftType, _ := arrayType(left)
+	rightType, _ := arrayType(right)
+	if leftType != rightType || structType(left) != structType(right) ||
+		!identicalNamed(namedTypes[left], namedTypes[right]) {
+		return nil, fmt.Errorf("invalid operation: %s %s %s (mismatched types %s and %s)",
+			exprName(left), op.Place, exprName(right), typeOf(left), typeOf(right))
+	}
+	unequal, end := NewLabel(), NewLabel()
+	code, err := equalCode(unequal, left, right)
+	if err != nil {
+		return nil, err
+	}
+	n := &Node{NewTmp(), append(leftexpr.Code, rightexpr.Code...)}
+	InsertSymbol(n.Place, BOOLEAN, n.Place)
+	eq, neq := 1, 0
+	if op.Place == NEQ {
+		eq, neq = neq, eq
+	}
+	n.Code = utils.AppendCode(
+		n.Code,
+		code,
+		fmt.Sprintf("=, %s, %d", n.Place, eq),
+		fmt.Sprintf("%s, %s", tac.JMP, end),
+		fmt.Sprintf("%s, %s", tac.LABEL, unequal),
+		fmt.Sprintf("=, %s, %d", n.Place, neq),
+		fmt.Sprintf("%s, %s", tac.LABEL, end),
+	)
+	return n, nil
+}
+
+// equalCode returns the code which branches to the label unequal when the
+// values of the same type at left and right are not equal. The members of
+// structs and the elements of arrays are compared one after another.
+func equalCode(unequal, left, right string) ([]string, error) {
+	if typ, ok := arrayType(left); ok {
+		length, elem := arrayParts(typ)
+		i := NewTmp()
+		InsertSymbol(i, INTEGER, i)
+		l, err := loadElem(left, i, elem)
+		if err != nil {
+			return nil, err
+		}
+		r, err := loadElem(right, i, elem)
+		if err != nil {
+			return nil, err
+		}
+		code, err := equalCode(unequal, l.Place, r.Place)
+		if err != nil {
+			return nil, err
+		}
+		loop, end := NewLabel(), NewLabel()
+		return utils.AppendCode(
+			[]string{
+				fmt.Sprintf("%s, %s, 0", tac.EQ, i),
+				fmt.Sprintf("%s, %s", tac.LABEL, loop),
+				fmt.Sprintf("%s, %s, %s, %s", tac.BGE, end, i, length),
+			},
+			l.Code,
+			r.Code,
+			code,
+			fmt.Sprintf("%s, %s, %s, 1", tac.ADD, i, i),
+			fmt.Sprintf("%s, %s", tac.JMP, loop),
+			fmt.Sprintf("%s, %s", tac.LABEL, end),
+		), nil
+	}
+	if isStruct(left) {
+		code := []string{}
+		leftMembers, rightMembers := members(left), members(right)
+		for k, v := range leftMembers {
+			c, err := equalCode(unequal, v, rightMembers[k])
+			if err != nil {
+				return nil, err
+			}
+			code = append(code, c...)
+		}
+		return code, nil
+	}
+	n, err := relExpr(&Node{EQ, []string{}}, &Node{left, []string{}}, &Node{right, []string{}})
+	if err != nil {
+		return nil, err
+	}
+	return append(n.Code, fmt.Sprintf("blt, %s, %s, 1", unequal, n.Place)), nil
+}
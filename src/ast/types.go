@@ -15,14 +15,30 @@ import (
 // presence when required.
 const (
 	FNC    = "func"
-	DRF    = "deref"
 	PTR    = "pointer"
 	ARR    = "arr"
 	ARRINT = "arrint"
 	ARRSTR = "arrstr"
 	INT    = "int"
 	STR    = "string"
+	BOOL   = "bool"
+	BYT    = "byte"
+	RUN    = "rune"
+	FLT    = "float" // prefix of floating-point constants
+	FLT32  = "float32"
+	FLT64  = "float64"
+	SLC    = "slice"
+	VRD    = "variadic" // prefix of the type of a variadic parameter
+	MP     = "map"
+	CHN    = "chan"
+	IFC    = "interface {}"
+	MTH    = "method"
 	STRCT  = "struct"
+	CNST   = "const"
+	TYP    = "type"
+	IOTA   = "iota"  // prefix of the expressions which depend on iota
+	FLD    = "field" // prefix of the field names in struct literals
+	LIT    = "lit"   // prefix of the composite literals whose types are elided
 )
 
 // symkind determines the kind of symbol table entry.
@@ -36,9 +52,27 @@ const (
 	POINTER
 	ARRAYINT
 	ARRAYSTR
+	ARRAY
 	INTEGER
 	STRING
 	STRUCT
+	BOOLEAN
+	SLICE
+	MAP
+	MAPELEM
+	METHOD
+	FUNCVAL
+	FLOAT32
+	FLOAT64
+	BYTE
+	RUNE
+	CONSTANT
+	NAMED
+	ALIAS
+	CHANNEL
+	// INTERFACE is the kind of the values returned by recover, which are
+	// either nil or the message of a panic.
+	INTERFACE
 )
 
 // GetType returns the type information from a symkind variable.
@@ -48,18 +82,129 @@ func GetType(kind symkind) string {
 		return ARRINT // reusing prefix values
 	case ARRAYSTR:
 		return ARRSTR
+	case ARRAY:
+		return ARR
 	case INTEGER:
 		return INT
 	case STRING:
 		return STR
+	case BOOLEAN:
+		return BOOL
+	case FLOAT32:
+		return FLT32
+	case FLOAT64:
+		return FLT64
+	case BYTE:
+		return BYT
+	case RUNE:
+		return RUN
+	case FUNCTION, FUNCVAL:
+		return FNC
+	case STRUCT:
+		return STRCT
+	case SLICE:
+		return SLC
+	case MAP:
+		return MP
+	case METHOD:
+		return MTH
+	case CONSTANT:
+		return CNST
+	case NAMED, ALIAS:
+		return TYP
 	case POINTER:
-		// TODO: Better type info.
 		return PTR
+	case CHANNEL:
+		return CHN
+	case INTERFACE:
+		return IFC
 	default:
 		panic("GetType: invalid type")
 	}
 }
 
+// GetKind returns the symkind corresponding to a type name. NIL is returned for
+// the types which do not have a corresponding symkind.
+func GetKind(typ string) symkind {
+	switch GetPrefix(typ) {
+	case SLC:
+		return SLICE
+	case MP:
+		return MAP
+	case FNC:
+		return FUNCVAL
+	case PTR:
+		return POINTER
+	case CHN:
+		return CHANNEL
+	}
+	switch typ {
+	case INT:
+		return INTEGER
+	case STR:
+		return STRING
+	case BOOL:
+		return BOOLEAN
+	case FLT32:
+		return FLOAT32
+	case FLT64:
+		return FLOAT64
+	case BYT:
+		return BYTE
+	case RUN:
+		return RUNE
+	}
+	if isNamedType(typ) {
+		return GetKind(underlying(typ))
+	}
+	return NIL
+}
+
+// KindOf evaluates the kind of the value represented by a place attribute. NIL
+// is returned when the kind cannot be determined.
+func KindOf(place string) symkind {
+	switch {
+	case re.MatchString(place):
+		return INTEGER
+	case strings.HasPrefix(place, STR+":"):
+		return STRING
+	case strings.HasPrefix(place, FLT+":"):
+		// An untyped floating-point constant defaults to float64.
+		return FLOAT64
+	case strings.HasPrefix(place, ARRINT+":"):
+		return INTEGER
+	case strings.HasPrefix(place, ARRSTR+":"):
+		return STRING
+	}
+	if symEntry, found := Lookup(RealName(place)); found {
+		switch symEntry.kind {
+		case ARRAYINT:
+			return INTEGER
+		case ARRAYSTR:
+			return STRING
+		case MAPELEM:
+			if kind := GetKind(symEntry.symbols[2]); kind != NIL {
+				return kind
+			}
+			return INTEGER
+		case DEREF:
+			return GetKind(symEntry.symbols[2])
+		case STRUCT:
+			// The members of a struct are renamed from keys of the
+			// form "<struct>.<member>".
+			if key, ok := renamedKey(place); ok && place != symEntry.symbols[0] {
+				if member, found := Lookup(key); found {
+					return member.kind
+				}
+			}
+			return STRUCT
+		default:
+			return symEntry.kind
+		}
+	}
+	return NIL
+}
+
 // GetPrefix returns the prefix from a place value.
 func GetPrefix(place string) string {
 	if i := strings.Index(place, ":"); i != -1 {
@@ -0,0 +1,152 @@
+// This file implements variadic functions. The values passed for the final
+// parameter of a variadic function are placed in a new slice, unless a slice
+// is spread as the final argument of the call, in which case it is passed as
+// is. A variadic function can only be called directly, as the parameters of a
+// function value are not known at the site of a call through it.
+
+package ast
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shivansh/gogo/src/utils"
+)
+
+// spread follows the final argument of a call in the place attribute of the
+// arguments when the argument is spread.
+const spread = "..."
+
+// NewVariadicParam returns the declaration of a variadic parameter, whose type
+// is of the form "variadic:<element type>".
+func NewVariadicParam(ident string, typ *Node) (*Node, error) {
+	// The values passed for a struct are its members, which are not packed.
+	if elem := typ.Place; isStructType(elem) || isArrayType(elem) {
+		return nil, fmt.Errorf("variadic parameters of type %s are not supported", displayType(elem))
+	}
+	return NewParamDecl(ident, &Node{VRD + ":" + typ.Place, []string{}})
+}
+
+// NewSpreadArgs returns the arguments of a call whose final argument is spread.
+func NewSpreadArgs(exprList *Node) (*Node, error) {
+	return &Node{fmt.Sprintf("%s, %s", exprList.Place, spread), exprList.Code}, nil
+}
+
+// spreadArgs removes the mark of a spread argument from the values passed to a
+// call, and determines whether the final argument is spread.
+func spreadArgs(args []string) ([]string, bool) {
+	if len(args) > 0 && args[len(args)-1] == spread {
+		return args[:len(args)-1], true
+	}
+	return args, false
+}
+
+// signatureTypes returns the types of the parameters of the function being
+// declared, which are recorded in its symbol table entry.
+func signatureTypes(params []string) []string {
+	types := paramTypes(params)
+	if elem := currFunc().variadic; elem != "" {
+		types[len(types)-1] = VRD + ":" + elem
+	}
+	return types
+}
+
+// variadicArgs verifies the number of values passed to a call against the
+// types of the parameters of the callee, and returns the values passed for the
+// parameters along with their types, where a variadic parameter is a slice.
+// The code for packing the values passed for a variadic parameter is appended
+// to the node of the call. The parameters of a call through a function value
+// are not known, hence only the spreading of an argument is verified.
+func variadicArgs(n *Node, callee string, args, params []string, known bool) ([]string, []string, error) {
+	args, spreads := spreadArgs(args)
+	isVariadic := len(params) > 0 && GetPrefix(params[len(params)-1]) == VRD
+	switch {
+	case spreads && !isVariadic:
+		name := RealName(callee)
+		if known {
+			name = strings.TrimPrefix(callee, qualifier)
+		}
+		return nil, nil, fmt.Errorf("cannot use ... in call to non-variadic %s", name)
+	case !known:
+		return args, params, nil
+	case !isVariadic:
+		if len(args) != len(params) {
+			return nil, nil, errArgs(callee, args, params)
+		}
+		return args, params, nil
+	}
+	fixed := len(params) - 1
+	elem := StripPrefix(params[fixed])
+	types := append(append([]string{}, params[:fixed]...), SLC+":"+elem)
+	if spreads {
+		if len(args) != len(params) {
+			return nil, nil, errArgs(callee, args, params)
+		}
+		if symEntry, ok := sliceEntry(args[fixed]); !ok || symEntry.symbols[1] != elem {
+			return nil, nil, fmt.Errorf("cannot use %s (type %s) as type %s in argument to %s",
+				exprName(args[fixed]), typeOf(args[fixed]), displayType(types[fixed]), RealName(callee))
+		}
+		return args, types, nil
+	}
+	if len(args) < fixed {
+		return nil, nil, errArgs(callee, args, params)
+	}
+	for _, v := range args[fixed:] {
+		if err := checkArg(v, elem, callee); err != nil {
+			return nil, nil, err
+		}
+	}
+	s, code, err := packArgs(args[fixed:], elem)
+	if err != nil {
+		return nil, nil, err
+	}
+	n.Code = append(n.Code, code...)
+	return append(args[:fixed:fixed], s), types, nil
+}
+
+// packArgs returns the code for placing the values passed for a variadic
+// parameter in a new slice, along with the temporary holding the slice. No
+// slice is allocated when there are no values.
+func packArgs(values []string, elem string) (string, []string, error) {
+	length := fmt.Sprintf("%d", len(values))
+	if len(values) == 0 {
+		s, code := newSliceHeader("0", "0", "0")
+		InsertSymbol(s, SLICE, s, elem)
+		return s, code, nil
+	}
+	ptr := NewTmp()
+	code := newBlock(ptr, len(values)*sizeOf(elem))
+	store, err := storeElems(ptr, elem, values, "argument")
+	if err != nil {
+		return "", nil, err
+	}
+	s, header := newSliceHeader(ptr, length, length)
+	InsertSymbol(s, SLICE, s, elem)
+	return s, utils.AppendCode(code, store, header), nil
+}
+
+// errArgs returns an error for a call passing fewer or more values than the
+// parameters of the callee.
+func errArgs(callee string, args, params []string) error {
+	// The receiver of a method is not listed.
+	if symEntry, found := globalSymTab[callee]; found && symEntry.kind == METHOD {
+		k := 1
+		if recv := symEntry.symbols[0]; GetPrefix(recv) != PTR {
+			k = len(flatTypes([]string{recv}))
+		}
+		args, params = args[k:], params[k:]
+	}
+	msg := "not enough arguments"
+	if len(args) > len(params) {
+		msg = "too many arguments"
+	}
+	have, want := []string{}, []string{}
+	for _, v := range args {
+		have = append(have, typeOf(v))
+	}
+	for _, v := range params {
+		want = append(want, displayType(v))
+	}
+	return fmt.Errorf("%s in call to %s\n\thave (%s)\n\twant (%s)",
+		msg, strings.TrimPrefix(callee, qualifier), strings.Join(have, ", "), strings.Join(want, ", "))
+}
@@ -10,6 +10,7 @@ import (
 	"log"
 	"os"
 
+	"github.com/shivansh/gogo/src/ast"
 	"github.com/shivansh/gogo/src/codegen"
 	"github.com/shivansh/gogo/src/parser"
 	"github.com/shivansh/gogo/src/scanner"
@@ -21,13 +22,27 @@ func GenToken(file string) {
 	scanner.PrintTokens(file)
 }
 
-// GenIR generates the IR instructions from the input program.
-func GenIR(file string) {
-	// A journey of a thousand miles begins with a single step. This is that step.
-	if err := parser.GenProductions(file); err != nil {
-		fmt.Fprintf(os.Stderr, "%v", err)
+// GenIR generates the IR instructions from the input program, which is either
+// a source file or a directory of source files. The IR of the imported packages
+// precedes that of the program.
+func GenIR(src string) {
+	pkgs, err := loadPackages(src)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
+	for _, p := range pkgs {
+		ast.BeginPackage(p.path)
+		for _, file := range p.files {
+			// A journey of a thousand miles begins with a single step.
+			// This is that step.
+			if err := parser.GenProductions(file); err != nil {
+				fmt.Fprintf(os.Stderr, "%v", err)
+				os.Exit(1)
+			}
+		}
+		ast.EndPackage(p.path)
+	}
 }
 
 // GenAsmFromIR generates the assembly code using IR generated from the input
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/shivansh/gogo/src/ast"
+	"github.com/shivansh/gogo/src/scanner"
+)
+
+// pkg is a package of the program being compiled.
+type pkg struct {
+	path  string   // import path, which is empty for the program
+	files []string // source files
+}
+
+// loadPackages returns the packages of a program in the order in which they
+// are compiled, i.e. a package precedes the packages importing it. The program
+// is either a source file or a directory of source files, and the import path
+// of a package is the directory holding its source files relative to that of
+// the program.
+func loadPackages(src string) ([]*pkg, error) {
+	info, err := os.Stat(src)
+	if err != nil {
+		return nil, err
+	}
+	root, files := filepath.Dir(src), []string{src}
+	if info.IsDir() {
+		root = src
+		if files, err = sourceFiles(src); err != nil {
+			return nil, err
+		}
+	}
+	order := []*pkg{}
+	// state maps the import paths of the packages being loaded to false,
+	// and those of the loaded packages to true.
+	state := make(map[string]bool)
+	var load func(path string, files []string) error
+	load = func(path string, files []string) error {
+		state[path] = false
+		names := make(map[string]bool)
+		for _, file := range files {
+			name, imports, err := scanner.Imports(file)
+			if err != nil {
+				return err
+			}
+			names[name] = true
+			if len(names) > 1 {
+				return fmt.Errorf("found multiple packages in %s", filepath.Dir(file))
+			}
+			for _, v := range imports {
+				if loaded, ok := state[v]; ok && !loaded {
+					return fmt.Errorf("import cycle not allowed: %s imports %s", file, v)
+				}
+				if ast.BuiltinPkg(v) || state[v] {
+					continue
+				}
+				files, err := sourceFiles(filepath.Join(root, v))
+				if err != nil {
+					return fmt.Errorf("cannot find package %q", v)
+				}
+				if err := load(v, files); err != nil {
+					return err
+				}
+			}
+			if path != "" && names["main"] {
+				return fmt.Errorf("import %q is a program, not an importable package", path)
+			}
+		}
+		state[path] = true
+		order = append(order, &pkg{path, files})
+		return nil
+	}
+	if err := load("", files); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// sourceFiles returns the source files in a directory in lexical order.
+func sourceFiles(dir string) ([]string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{}
+	for _, v := range entries {
+		if name := v.Name(); !v.IsDir() && strings.HasSuffix(name, ".go") {
+			files = append(files, filepath.Join(dir, name))
+		}
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no source files in %s", dir)
+	}
+	sort.Strings(files)
+	return files, nil
+}
@@ -3,11 +3,14 @@
 package codegen
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/shivansh/gogo/src/ast"
 	"github.com/shivansh/gogo/src/tac"
@@ -38,17 +41,48 @@ func CodeGen(t tac.Tac, runtime bool) {
 	// information is useful during register allocation, as for example a
 	// register storing an integer will have different load/store operations
 	// than a register storing an array type.
-	typeInfo := make(map[string]types.RegType)
+	// The types of floating-point variables are determined beforehand, as
+	// these are required for laying out the frames.
+	typeInfo := t.EvalTypes()
+	// frames stores the layout of the activation records of all the
+	// functions, and frame is the activation record of the function whose
+	// code is currently being generated.
+	frames := t.EvalFrames(typeInfo)
+	var frame *tac.Frame
 	funcName := ""
-	callerSaved := []string{}
 	// entryPoint determines whether the source program contains a main
 	// function (entry point).
 	entryPoint := false
 	// globals stores the code for the global declarations. This code is
 	// inserted at the beginning of the entry point, i.e. the main routine.
 	globals := new(tac.TextSec)
-	// globalLineNo stores the line number of global declarations.
-	globalLineNo := make(map[int]bool)
+	// globalStmts stores the (block index, line number) pairs of global
+	// declarations.
+	globalStmts := make(map[[2]int]bool)
+	// funcs stores the labels of the functions in the order of their
+	// addresses.
+	funcs := []string{}
+	// checked determines whether the dereferences of the pointers and the
+	// integer divisions are checked at runtime, which is the case unless
+	// the checks are disabled or the runtime itself is being compiled.
+	// checks counts the checks generated, which label their resumptions.
+	checked := !runtime && !ast.NoChecks
+	checks := 0
+	// check calls the runtime function fn when the register reg holds 0.
+	check := func(reg int, fn string) {
+		if checked {
+			checks++
+			label := fmt.Sprintf("%s.check%d", funcName, checks)
+			fmt.Fprint(&ts.Stmts, runtimeCheck(reg, ast.RuntimeFunc(fn), label))
+		}
+	}
+	// nilCheck checks the base address of a load or a store, unless it is
+	// that of an array allocated statically or in the frame.
+	nilCheck := func(base string, reg int) {
+		if typeInfo[base] != types.ARR {
+			check(reg, "panicNil")
+		}
+	}
 
 	if !runtime {
 		// Define the assembler directives for data and text.
@@ -67,7 +101,7 @@ func CodeGen(t tac.Tac, runtime bool) {
 	// code for the global declarations. This code will be inserted in the
 	// main routine, i.e. the entry point.
 	funcScope := false
-	for _, blk := range t {
+	for i, blk := range t {
 		if len(blk.Stmts) == 0 {
 			continue
 		}
@@ -85,6 +119,13 @@ func CodeGen(t tac.Tac, runtime bool) {
 			case tac.RET:
 				funcScope = false
 
+			case tac.DECL:
+				// The value of a global array is its address, which
+				// is loaded when it initializes another global.
+				if !funcScope {
+					typeInfo[stmt.Dst] = types.ARR
+				}
+
 			case tac.EQ, tac.DECLInt:
 				if funcScope {
 					break
@@ -98,21 +139,36 @@ func CodeGen(t tac.Tac, runtime bool) {
 				switch v := stmt.Src[0].(type) {
 				case tac.I32:
 					fmt.Fprintf(&globals.Stmts, "\tli\t$%d, %d\t\t%s\n", blk.Adesc[stmt.Dst].Reg, v, comment)
-					comment = "# global decl -> memory"
-					fmt.Fprintf(&globals.Stmts, "\tsw\tt%d, %s\t\t%s\n", blk.Adesc[stmt.Dst].Reg, stmt.Dst, comment)
-					globalLineNo[stmt.Line] = true
 				case tac.Str:
-					// It is not required to handle strings separately here
-					// as the only side-effect of string declaration are
-					// limited to data section and not text section.
+					// The value of another global is loaded by GetReg.
+					fmt.Fprintf(&globals.Stmts, "\tmove\t$%d, $%d\t\t%s\n", blk.Adesc[stmt.Dst].Reg,
+						blk.Adesc[v.StrVal()].Reg, comment)
 				default:
-					log.Fatal("CodeGen: unknown type %T\n", v)
+					log.Fatalf("CodeGen: unknown type %T\n", v)
+				}
+				comment = "# global decl -> memory"
+				fmt.Fprintf(&globals.Stmts, "\tsw\t$%d, %s\t\t%s\n", blk.Adesc[stmt.Dst].Reg, stmt.Dst, comment)
+				globalStmts[[2]int{i, stmt.Line}] = true
+
+			default:
+				// A floating-point global is initialized by "=.s"
+				// or "=.d".
+				if op, prec := tac.SplitOp(stmt.Op); op != tac.EQ || prec == "" || funcScope {
+					break
 				}
+				blk.InitRegDS()
+				blk.InitHeap()
+				blk.EvalNextUseInfo()
+				floatCode(&blk, globals, typeInfo, stmt)
+				comment := "# global decl -> memory"
+				fmt.Fprintf(&globals.Stmts, "\t%s\t%s, %s\t\t%s\n", tac.StoreOp(typeInfo[stmt.Dst]),
+					tac.RegName(blk.Adesc[stmt.Dst].Reg), stmt.Dst, comment)
+				globalStmts[[2]int{i, stmt.Line}] = true
 			}
 		}
 	}
 
-	for _, blk := range t {
+	for i, blk := range t {
 		blk.InitRegDS()
 		// jumpStmt stores the intructions for jump statements which are
 		// responsible for terminating a basic block. These statements
@@ -121,13 +177,19 @@ func CodeGen(t tac.Tac, runtime bool) {
 		jumpStmt := []string{}
 		// exitStmt stores the instructions which terminate a function.
 		exitStmt := ""
+		// resultStmt stores the instructions which place the results of a
+		// function. They follow the stores of the block variables, as the
+		// words returned on the stack overwrite the parameters.
+		var resultStmt tac.TextSec
 		// dirtyRegCount determines the number of registers which have been
 		// modified after loading their values from memory.
 		dirtyRegCount := 0
 
 		if len(blk.Stmts) > 0 && blk.Stmts[0].Op == tac.FUNC {
 			funcName = blk.Stmts[0].Dst
+			frame = frames[funcName]
 		}
+		blk.Frame = frame
 
 		blk.InitHeap()
 		// Update the next-use info for the given block.
@@ -149,6 +211,11 @@ func CodeGen(t tac.Tac, runtime bool) {
 				tac.FUNC,
 				tac.RET,
 				tac.CALL,
+				tac.ARG,
+				tac.PARAM,
+				tac.EXIT,
+				tac.PRINTINT,
+				tac.PRINTCHAR,
 				tac.CMT,
 				tac.BGT,
 				tac.BGE,
@@ -156,17 +223,42 @@ func CodeGen(t tac.Tac, runtime bool) {
 				tac.BLE,
 				tac.BEQ,
 				tac.BNE,
-				tac.JMP:
+				tac.JMP,
+				tac.FORK,
+				tac.SWAP:
 				break
 			case tac.DECL:
 				typeInfo[stmt.Dst] = types.ARR
-				fmt.Fprintf(&ds.Stmts, "%s:%s.space\t%d\n", stmt.Dst, tab, 4*stmt.Src[0].IntVal())
+				// Local arrays are allocated in the frame.
+				if !blk.Frame.IsLocal(stmt.Dst) {
+					globalArray(&ds.Stmts, stmt, tab)
+				}
 			case tac.DECLSTR:
-				typeInfo[stmt.Dst] = types.STR
-				fmt.Fprintf(&ds.Stmts, "%s:%s.asciiz %s\n", stmt.Dst, tab, stmt.Src[0].StrVal())
-			default:
+				// A string variable holds the address of its contents,
+				// which reside in the data section. The address is
+				// placed in a global statically, and is loaded into a
+				// local when it is declared.
 				typeInfo[stmt.Dst] = types.INT
-				fmt.Fprintf(&ds.Stmts, "%s:%s.word\t0\n", stmt.Dst, tab)
+				fmt.Fprintf(&ds.Stmts, "%s:%s.asciiz %s\n", strLabel(stmt.Dst), tab, stmt.Src[0].StrVal())
+				if !blk.Frame.IsLocal(stmt.Dst) {
+					fmt.Fprintf(&ds.Stmts, "%s:%s.word\t%s\n", stmt.Dst, tab, strLabel(stmt.Dst))
+				}
+			default:
+				if _, ok := typeInfo[stmt.Dst]; !ok {
+					typeInfo[stmt.Dst] = types.INT
+				}
+				// Locals and temporaries reside in the frame.
+				if blk.Frame.IsLocal(stmt.Dst) {
+					continue
+				}
+				switch typeInfo[stmt.Dst] {
+				case types.FLT:
+					fmt.Fprintf(&ds.Stmts, "%s:%s.float\t0.0\n", stmt.Dst, tab)
+				case types.DBL:
+					fmt.Fprintf(&ds.Stmts, "%s:%s.double\t0.0\n", stmt.Dst, tab)
+				default:
+					fmt.Fprintf(&ds.Stmts, "%s:%s.word\t0\n", stmt.Dst, tab)
+				}
 			}
 			ds.Lookup[stmt.Dst] = true
 		}
@@ -175,7 +267,7 @@ func CodeGen(t tac.Tac, runtime bool) {
 			switch stmt.Op {
 			case tac.EQ, tac.DECLInt:
 				// Avoid re-generating code for global declarations.
-				if globalLineNo[stmt.Line] {
+				if globalStmts[[2]int{i, stmt.Line}] {
 					continue
 				}
 				blk.GetReg(&stmt, ts, typeInfo)
@@ -191,64 +283,94 @@ func CodeGen(t tac.Tac, runtime bool) {
 					fmt.Fprintf(&ts.Stmts, "\tmove\t$%d, $%d%s%s\n", blk.Adesc[stmt.Dst].Reg,
 						blk.Adesc[v.StrVal()].Reg, tab, comment)
 				default:
-					log.Fatal("Codegen: unknown type %T\n", v)
+					log.Fatalf("Codegen: unknown type %T\n", v)
 				}
 				blk.MarkDirty(blk.Adesc[stmt.Dst].Reg)
 				dirtyRegCount++
 
 			case tac.FROM:
 				blk.GetReg(&stmt, ts, typeInfo)
+				// The register allocated to the array stores its base
+				// address.
+				base := blk.Adesc[stmt.Src[0].StrVal()].Reg
+				nilCheck(stmt.Src[0].StrVal(), base)
 				switch v := stmt.Src[1].(type) {
 				case tac.I32:
 					comment := fmt.Sprintf("# variable <- array")
 					fmt.Fprintf(&ts.Stmts, "\tlw\t$%d, %d($%d)\t%s\n",
-						blk.Adesc[stmt.Dst].Reg, 4*stmt.Src[1].IntVal(), blk.Adesc[stmt.Src[0].StrVal()].Reg, comment)
+						blk.Adesc[stmt.Dst].Reg, 4*stmt.Src[1].IntVal(), base, comment)
 				case tac.Str:
 					comment := fmt.Sprintf("# iterator *= 4")
-					fmt.Fprintf(&ts.Stmts, "\tsll\t$s2, $%d, 2\t%s\n", blk.Adesc[v.StrVal()].Reg, comment)
+					fmt.Fprintf(&ts.Stmts, "\tsll\t$24, $%d, 2\t%s\n", blk.Adesc[v.StrVal()].Reg, comment)
+					fmt.Fprintf(&ts.Stmts, "\tadd\t$24, $24, $%d\n", base)
 					comment = fmt.Sprintf("# variable <- array")
-					fmt.Fprintf(&ts.Stmts, "\tlw\t$%d, %s($s2)\t%s\n",
-						blk.Adesc[stmt.Dst].Reg, stmt.Src[0].StrVal(), comment)
+					fmt.Fprintf(&ts.Stmts, "\tlw\t$%d, 0($24)\t%s\n", blk.Adesc[stmt.Dst].Reg, comment)
 				}
 				blk.MarkDirty(blk.Adesc[stmt.Dst].Reg)
 				dirtyRegCount++
 
+			case tac.FROMB:
+				blk.GetReg(&stmt, ts, typeInfo)
+				base := blk.Adesc[stmt.Src[0].StrVal()].Reg
+				nilCheck(stmt.Src[0].StrVal(), base)
+				comment := "# variable <- byte"
+				switch v := stmt.Src[1].(type) {
+				case tac.I32:
+					fmt.Fprintf(&ts.Stmts, "\tlbu\t$%d, %d($%d)\t%s\n",
+						blk.Adesc[stmt.Dst].Reg, stmt.Src[1].IntVal(), base, comment)
+				case tac.Str:
+					fmt.Fprintf(&ts.Stmts, "\tadd\t$24, $%d, $%d\n", blk.Adesc[v.StrVal()].Reg, base)
+					fmt.Fprintf(&ts.Stmts, "\tlbu\t$%d, 0($24)\t%s\n", blk.Adesc[stmt.Dst].Reg, comment)
+				}
+				blk.MarkDirty(blk.Adesc[stmt.Dst].Reg)
+				dirtyRegCount++
+
+			case tac.INTOB:
+				blk.GetReg(&stmt, ts, typeInfo)
+				base := blk.Adesc[stmt.Src[0].StrVal()].Reg
+				nilCheck(stmt.Src[0].StrVal(), base)
+				val := 25
+				switch v := stmt.Src[2].(type) {
+				case tac.I32:
+					fmt.Fprintf(&ts.Stmts, "\tli\t$25, %d\n", v.IntVal())
+				case tac.Str:
+					val = blk.Adesc[v.StrVal()].Reg
+				}
+				comment := "# variable -> byte"
+				switch u := stmt.Src[1].(type) {
+				case tac.I32:
+					fmt.Fprintf(&ts.Stmts, "\tsb\t$%d, %d($%d)\t%s\n", val, u.IntVal(), base, comment)
+				case tac.Str:
+					fmt.Fprintf(&ts.Stmts, "\tadd\t$24, $%d, $%d\n", blk.Adesc[u.StrVal()].Reg, base)
+					fmt.Fprintf(&ts.Stmts, "\tsb\t$%d, 0($24)\t%s\n", val, comment)
+				}
+
 			case tac.INTO:
 				blk.GetReg(&stmt, ts, typeInfo)
+				base := blk.Adesc[stmt.Src[0].StrVal()].Reg
+				nilCheck(stmt.Src[0].StrVal(), base)
+				// val is the register storing the value to be stored.
+				val := 0
+				switch v := stmt.Src[2].(type) {
+				case tac.I32:
+					comment := "# const value -> $25"
+					fmt.Fprintf(&ts.Stmts, "\tli\t$25, %d \t%s\n", v.IntVal(), comment)
+					val = 25
+				case tac.Str:
+					val = blk.Adesc[v.StrVal()].Reg
+				default:
+					log.Fatalf("Codegen: unknown type %T\n", v)
+				}
 				switch u := stmt.Src[1].(type) {
 				case tac.I32:
-					switch v := stmt.Src[2].(type) {
-					case tac.I32:
-						comment := "# const index -> $s1"
-						fmt.Fprintf(&ts.Stmts, "\tli\t$s1, %d \t%s\n", v.IntVal(), comment)
-						comment = "# variable -> array"
-						fmt.Fprintf(&ts.Stmts, "\tsw\t$s1, %d($%d)\t%s\n", 4*stmt.Src[1].IntVal(),
-							blk.Adesc[stmt.Src[0].StrVal()].Reg, comment)
-					case tac.Str:
-						comment := "# variable -> array"
-						fmt.Fprintf(&ts.Stmts, "\tsw\t$%d, %d($%d)\t%s\n", blk.Adesc[v.StrVal()].Reg,
-							4*u.IntVal(), blk.Adesc[stmt.Src[0].StrVal()].Reg, comment)
-					default:
-						log.Fatal("Codegen: unknown type %T\n", v)
-					}
+					comment := "# variable -> array"
+					fmt.Fprintf(&ts.Stmts, "\tsw\t$%d, %d($%d)\t%s\n", val, 4*u.IntVal(), base, comment)
 				case tac.Str:
-					switch v := stmt.Src[2].(type) {
-					case tac.I32:
-						comment := "# const index -> $s1"
-						fmt.Fprintf(&ts.Stmts, "\tli\t$s1, %d \t%s\n", v.IntVal(), comment)
-						comment = "# iterator *= 4"
-						fmt.Fprintf(&ts.Stmts, "\tsll $s2, $%d, 2\t%s\n", blk.Adesc[u.StrVal()].Reg, comment)
-						comment = "# variable -> array"
-						fmt.Fprintf(&ts.Stmts, "\tsw\t$s1, %s($s2)\t%s\n", stmt.Src[0].StrVal(), comment)
-					case tac.Str:
-						comment := "# iterator *= 4"
-						fmt.Fprintf(&ts.Stmts, "\tsll $s2, $%d, 2\t%s\n", blk.Adesc[u.StrVal()].Reg, comment)
-						comment = "# variable -> array"
-						fmt.Fprintf(&ts.Stmts, "\tsw\t$%d, %s($s2)\t%s\n", blk.Adesc[v.StrVal()].Reg,
-							stmt.Src[0].StrVal(), comment)
-					default:
-						log.Fatal("Codegen: unknown type %T\n", v)
-					}
+					comment := "# iterator *= 4"
+					fmt.Fprintf(&ts.Stmts, "\tsll\t$24, $%d, 2\t%s\n", blk.Adesc[u.StrVal()].Reg, comment)
+					fmt.Fprintf(&ts.Stmts, "\tadd\t$24, $24, $%d\n", base)
+					comment = "# variable -> array"
+					fmt.Fprintf(&ts.Stmts, "\tsw\t$%d, 0($24)\t%s\n", val, comment)
 				}
 
 			case tac.ADD:
@@ -261,7 +383,7 @@ func CodeGen(t tac.Tac, runtime bool) {
 					fmt.Fprintf(&ts.Stmts, "\tadd\t$%d, $%d, $%d\n", blk.Adesc[stmt.Dst].Reg,
 						blk.Adesc[stmt.Src[0].StrVal()].Reg, blk.Adesc[v.StrVal()].Reg)
 				default:
-					log.Fatal("Codegen: unknown type %T\n", v)
+					log.Fatalf("Codegen: unknown type %T\n", v)
 				}
 				blk.MarkDirty(blk.Adesc[stmt.Dst].Reg)
 				dirtyRegCount++
@@ -283,10 +405,16 @@ func CodeGen(t tac.Tac, runtime bool) {
 					fmt.Fprintf(&ts.Stmts, "\t%s\t$%d, $%d, %s\n", op, blk.Adesc[stmt.Dst].Reg,
 						blk.Adesc[stmt.Src[0].StrVal()].Reg, v.StrVal())
 				case tac.Str:
+					if stmt.Op == tac.LST || stmt.Op == tac.RST {
+						// Shifts by a variable amount.
+						op += "v"
+					} else if stmt.Op == tac.DIV || stmt.Op == tac.REM {
+						check(blk.Adesc[v.StrVal()].Reg, "panicDivide")
+					}
 					fmt.Fprintf(&ts.Stmts, "\t%s\t$%d, $%d, $%d\n", op,
 						blk.Adesc[stmt.Dst].Reg, blk.Adesc[stmt.Src[0].StrVal()].Reg, blk.Adesc[v.StrVal()].Reg)
 				default:
-					log.Fatal("Codegen: unknown type %T\n", v)
+					log.Fatalf("Codegen: unknown type %T\n", v)
 				}
 				blk.MarkDirty(blk.Adesc[stmt.Dst].Reg)
 				dirtyRegCount++
@@ -315,7 +443,7 @@ func CodeGen(t tac.Tac, runtime bool) {
 					branchStmt = fmt.Sprintf("\t%s\t$%d, $%d, %s", branchOp,
 						blk.Adesc[stmt.Src[0].StrVal()].Reg, blk.Adesc[v.StrVal()].Reg, stmt.Dst)
 				default:
-					log.Fatal("Codegen: unknown type %T\n", v)
+					log.Fatalf("Codegen: unknown type %T\n", v)
 				}
 				// A jump/branch statement marks the end of a basic block. As a result
 				// these statements are collected in the variable `jumpStmt` and added
@@ -339,49 +467,92 @@ func CodeGen(t tac.Tac, runtime bool) {
 					fmt.Fprintf(&ts.Stmts, "\n\t.globl %s\n\t.ent %s\n", funcName, funcName)
 				}
 				fmt.Fprintf(&ts.Stmts, "%s:\n", stmt.Dst)
-				if funcName != "main" {
-					fmt.Fprintln(&ts.Stmts, "\taddi\t$sp, $sp, -4\n\tsw\t$ra, 0($sp)")
-				} else {
+				fmt.Fprint(&ts.Stmts, prologue(frame))
+				funcs = append(funcs, stmt.Dst)
+				if funcName == "main" {
 					// Add code for global declarations.
 					fmt.Fprintf(&ts.Stmts, "%s", globals.Stmts.String())
 					globals.Stmts.Reset()
 				}
 
+			case tac.PARAM:
+				// Parameters are pushed by the caller and already have
+				// a slot in the frame.
+
 			case tac.JMP:
 				// Defer adding the jump statement (basic block terminator)
 				// until the modified variables have been stored in memory.
 				jumpStmt = append(jumpStmt, fmt.Sprintf("\tj\t%s", stmt.Dst))
 
-			case tac.CALL:
-				// Since range loop over maps are not deterministic, maintain
-				// a slice of sorted keys to preserve ordering across runs.
-				keys := []int{}
-				for k := range blk.Rdesc {
-					keys = append(keys, k)
-				}
-				sort.Ints(keys)
-				for _, reg := range keys {
-					varName := blk.Rdesc[reg].Name
-					fmt.Fprintf(&ts.Stmts, "\tsw\t$%d, %s\n", reg, varName)
-					blk.UnmarkDirty(reg)
-					dirtyRegCount--
-					// It is the responsibility of the caller to save
-					// all the registers before the callee starts.
-					if !blk.IsLoaded(reg, varName) {
-						callerSaved = append(callerSaved, fmt.Sprintf("\tlw\t$%d, %s\n", reg, varName))
-						blk.MarkLoaded(reg)
-					}
+			case tac.ARG:
+				// Push the argument on the stack. The arguments are
+				// popped by the caller once the callee returns.
+				// A double precision argument occupies two words.
+				reg := 25
+				if v, err := strconv.Atoi(stmt.Dst); err == nil {
+					fmt.Fprintf(&ts.Stmts, "\tli\t$25, %d\n", v)
+				} else {
+					blk.GetReg(&stmt, ts, typeInfo)
+					reg = blk.Adesc[stmt.Dst].Reg
 				}
+				argType := typeInfo[stmt.Dst]
+				fmt.Fprintf(&ts.Stmts, "\taddi\t$sp, $sp, -%d\n\t%s\t%s, 0($sp)\n",
+					tac.SizeOf(argType), tac.StoreOp(argType), tac.RegName(reg))
+
+			case tac.CALL:
+				// It is the responsibility of the caller to save all the
+				// registers before the callee starts. The dirty registers
+				// are stored back into memory, and the values are loaded
+				// again when they are next used after the call returns.
+				saveRegs(&blk, ts, typeInfo)
+				dirtyRegCount = 0
 				fmt.Fprintf(&ts.Stmts, "\tjal\t%s\n", stmt.Dst)
-				// Load the caller-saved registers after returning from
-				// the function call.
-				for _, v := range callerSaved {
-					fmt.Fprint(&ts.Stmts, v)
+				// Pop the arguments pushed before the call.
+				if len(stmt.Src) > 0 && stmt.Src[0].IntVal() > 0 {
+					fmt.Fprintf(&ts.Stmts, "\taddi\t$sp, $sp, %d\n", tac.WordSize*stmt.Src[0].IntVal())
+				}
+
+			case tac.CALLR:
+				// The closure held in the destination is passed in $3
+				// ($v1), and its first word is the address of the
+				// callee, which is loaded before the registers are
+				// saved.
+				blk.GetReg(&stmt, ts, typeInfo)
+				fmt.Fprintf(&ts.Stmts, "\tmove\t$3, $%d\n\tlw\t$25, 0($3)\n", blk.Adesc[stmt.Dst].Reg)
+				saveRegs(&blk, ts, typeInfo)
+				dirtyRegCount = 0
+				fmt.Fprintf(&ts.Stmts, "\tjalr\t$25\n")
+				if len(stmt.Src) > 0 && stmt.Src[0].IntVal() > 0 {
+					fmt.Fprintf(&ts.Stmts, "\taddi\t$sp, $sp, %d\n", tac.WordSize*stmt.Src[0].IntVal())
 				}
 
+			case tac.ADDR:
+				// The source is the label of a function or a global and
+				// not a variable, hence it is not allocated a register.
+				label := stmt.Src[0].StrVal()
+				stmt.Src = nil
+				blk.GetReg(&stmt, ts, typeInfo)
+				fmt.Fprintf(&ts.Stmts, "\tla\t$%d, %s\n", blk.Adesc[stmt.Dst].Reg, label)
+				blk.MarkDirty(blk.Adesc[stmt.Dst].Reg)
+				dirtyRegCount++
+
 			case tac.STORE:
+				// The first two results of a call are returned in $2
+				// ($v0) and $3 ($v1), and the remaining ones in the
+				// words pushed by the caller, which lie below the stack
+				// pointer once they are popped.
+				k := 0
+				if len(stmt.Src) > 0 {
+					k = stmt.Src[0].IntVal()
+				}
+				stmt.Src = nil
 				blk.GetReg(&stmt, ts, typeInfo)
-				fmt.Fprintf(&ts.Stmts, "\tmove\t$%d, $2\n", blk.Adesc[stmt.Dst].Reg)
+				switch k {
+				case 0, 1:
+					fmt.Fprintf(&ts.Stmts, "\tmove\t$%d, $%d\n", blk.Adesc[stmt.Dst].Reg, k+2)
+				default:
+					fmt.Fprintf(&ts.Stmts, "\tlw\t$%d, -%d($sp)\n", blk.Adesc[stmt.Dst].Reg, tac.WordSize*(k-1))
+				}
 				blk.MarkDirty(blk.Adesc[stmt.Dst].Reg)
 				dirtyRegCount++
 
@@ -389,18 +560,124 @@ func CodeGen(t tac.Tac, runtime bool) {
 				if funcName == "main" {
 					exitStmt = "\tli\t$2, 10\n\tsyscall\n\t.end main"
 				} else {
-					exitStmt = fmt.Sprintf("\n\tlw\t$ra, 0($sp)\n\taddi\t$sp, $sp, 4\n\tjr\t$ra\n\t.end %s", funcName)
+					exitStmt = epilogue(funcName)
 				}
-				// Check if the variable which is to hold the return value has a register -
-				// 	* if it does then move register's content to $2 ($v0)
-				//	* else load value of that variable to $2 ($v0) from memory
+				// The words of the results are placed in $2 ($v0),
+				// $3 ($v1) and the words pushed by the caller, starting
+				// from the one farthest from the frame pointer. The
+				// caller pushes at least as many words as the ones
+				// which are returned on the stack. A double precision
+				// result occupies two words. The words returned on the
+				// stack are first placed below the stack pointer, since
+				// a result may be a parameter whose word is overwritten.
+				results := []string{}
 				if len(stmt.Dst) > 0 {
-					if _, ok := blk.Adesc[stmt.Dst]; ok {
-						fmt.Fprintf(&ts.Stmts, "\tmove\t$2, $%d\n", blk.Adesc[stmt.Dst].Reg)
-					} else {
-						fmt.Fprintf(&ts.Stmts, "\tlw\t$2, %s\n", stmt.Dst)
+					results = append(results, stmt.Dst)
+				}
+				for _, v := range stmt.Src {
+					results = append(results, v.StrVal())
+				}
+				resultWords := 0
+				for _, v := range results {
+					resultWords += tac.SizeOf(typeInfo[v]) / tac.WordSize
+				}
+				paramWords := 0
+				if blk.Frame != nil {
+					for _, v := range blk.Frame.Params {
+						paramWords += tac.SizeOf(typeInfo[v]) / tac.WordSize
+					}
+				}
+				words := resultWords - 2
+				if paramWords > words {
+					words = paramWords
+				}
+				k := 0 // index of the current word
+				for _, v := range results {
+					for w := 0; w < tac.SizeOf(typeInfo[v])/tac.WordSize; w++ {
+						reg := k + 2
+						if k >= 2 {
+							reg = 24
+						}
+						if tac.IsFloat(typeInfo[v]) {
+							floatWord(&blk, &resultStmt, typeInfo, v, w, reg)
+						} else {
+							loadValue(&blk, &resultStmt, typeInfo, v, reg)
+						}
+						if reg == 24 {
+							fmt.Fprintf(&resultStmt.Stmts, "\tsw\t$24, -%d($sp)\n", tac.WordSize*(k-1))
+						}
+						k++
 					}
 				}
+				for k := 2; k < resultWords; k++ {
+					offset := 2*tac.WordSize + tac.WordSize*(words-k+1)
+					fmt.Fprintf(&resultStmt.Stmts, "\tlw\t$24, -%d($sp)\n\tsw\t$24, %d($fp)\n",
+						tac.WordSize*(k-1), offset)
+				}
+
+			case tac.SBRK:
+				// The sbrk syscall extends the data segment by the
+				// requested number of bytes and returns the address of
+				// the allocated memory.
+				blk.GetReg(&stmt, ts, typeInfo)
+				switch v := stmt.Src[0].(type) {
+				case tac.I32:
+					fmt.Fprintf(&ts.Stmts, "\tli\t$4, %d\n", v.IntVal())
+				case tac.Str:
+					fmt.Fprintf(&ts.Stmts, "\tmove\t$4, $%d\n", blk.Adesc[v.StrVal()].Reg)
+				}
+				fmt.Fprintf(&ts.Stmts, "\tli\t$2, 9\n\tsyscall\n\tmove\t$%d, $2\n", blk.Adesc[stmt.Dst].Reg)
+				blk.MarkDirty(blk.Adesc[stmt.Dst].Reg)
+				dirtyRegCount++
+
+			case tac.EXIT:
+				if v, err := strconv.Atoi(stmt.Dst); err == nil {
+					fmt.Fprintf(&ts.Stmts, "\tli\t$4, %d\n", v)
+				} else {
+					blk.GetReg(&stmt, ts, typeInfo)
+					fmt.Fprintf(&ts.Stmts, "\tmove\t$4, $%d\n", blk.Adesc[stmt.Dst].Reg)
+				}
+				fmt.Fprintln(&ts.Stmts, "\tli\t$2, 17\n\tsyscall")
+
+			case tac.FORK:
+				// The frame of the current function, including its
+				// parameters, is copied onto the stack of the goroutine
+				// held in the source, which resumes at the destination
+				// label. The words are copied from the top of the frame
+				// downwards, and the stack pointer, the frame pointer
+				// and the resume address of the goroutine are then
+				// saved in its descriptor, where the frame pointer is
+				// also saved as the frame of the go statement.
+				saveRegs(&blk, ts, typeInfo)
+				dirtyRegCount = 0
+				paramWords := 0
+				for _, v := range blk.Frame.Params {
+					paramWords += tac.SizeOf(typeInfo[v]) / tac.WordSize
+				}
+				loadValue(&blk, ts, typeInfo, stmt.Src[0].StrVal(), 24)
+				fmt.Fprintf(&ts.Stmts, "\tlw\t$25, 0($24)\n\taddi\t$2, $fp, %d\n", 2*tac.WordSize+tac.WordSize*paramWords)
+				fmt.Fprintf(&ts.Stmts, "%s.fork:\n\taddi\t$2, $2, -4\n\taddi\t$25, $25, -4\n"+
+					"\tlw\t$4, 0($2)\n\tsw\t$4, 0($25)\n\tbne\t$2, $sp, %s.fork\n", stmt.Dst, stmt.Dst)
+				fmt.Fprintf(&ts.Stmts, "\tsw\t$25, 0($24)\n\tsub\t$4, $fp, $sp\n\tadd\t$4, $25, $4\n"+
+					"\tsw\t$4, 4($24)\n\tsw\t$4, 20($24)\n\tla\t$4, %s\n\tsw\t$4, 8($24)\n", stmt.Dst)
+
+			case tac.SWAP:
+				// The state of the goroutine held in the first source
+				// is saved in its descriptor, where it resumes at the
+				// destination label, and that of the goroutine held in
+				// the second source is restored.
+				saveRegs(&blk, ts, typeInfo)
+				dirtyRegCount = 0
+				loadValue(&blk, ts, typeInfo, stmt.Src[0].StrVal(), 24)
+				loadValue(&blk, ts, typeInfo, stmt.Src[1].StrVal(), 25)
+				fmt.Fprintf(&ts.Stmts, "\tsw\t$sp, 0($24)\n\tsw\t$fp, 4($24)\n\tla\t$2, %s\n\tsw\t$2, 8($24)\n", stmt.Dst)
+				fmt.Fprintln(&ts.Stmts, "\tlw\t$sp, 0($25)\n\tlw\t$fp, 4($25)\n\tlw\t$2, 8($25)\n\tjr\t$2")
+
+			case tac.FP:
+				blk.GetReg(&stmt, ts, typeInfo)
+				fmt.Fprintf(&ts.Stmts, "\tmove\t$%d, $fp\n", blk.Adesc[stmt.Dst].Reg)
+				blk.MarkDirty(blk.Adesc[stmt.Dst].Reg)
+				dirtyRegCount++
 
 			case tac.SCANINT:
 				fmt.Fprintln(&ts.Stmts, "\tli\t$2, 5\n\tsyscall")
@@ -421,7 +698,26 @@ func CodeGen(t tac.Tac, runtime bool) {
 				fmt.Fprintln(&ts.Stmts, "\tsyscall")
 
 			case tac.PRINTSTR:
-				fmt.Fprintf(&ts.Stmts, "\tli\t$2, 4\n\tla\t$4, %s\n\tsyscall\n", stmt.Dst)
+				// A string which is not declared statically is the
+				// address of its contents, e.g. one returned by a call.
+				fmt.Fprintln(&ts.Stmts, "\tli\t$2, 4")
+				loadValue(&blk, ts, typeInfo, stmt.Dst, 4)
+				fmt.Fprintln(&ts.Stmts, "\tsyscall")
+
+			case tac.PRINTCHAR:
+				fmt.Fprintln(&ts.Stmts, "\tli\t$2, 11")
+				loadValue(&blk, ts, typeInfo, stmt.Dst, 4)
+				fmt.Fprintln(&ts.Stmts, "\tsyscall")
+
+			case tac.PRINTFLOAT, tac.PRINTDOUBLE:
+				// The value is printed from $f12.
+				blk.GetReg(&stmt, ts, typeInfo)
+				syscall, prec := 2, "s"
+				if stmt.Op == tac.PRINTDOUBLE {
+					syscall, prec = 3, "d"
+				}
+				fmt.Fprintf(&ts.Stmts, "\tli\t$2, %d\n\tmov.%s\t$f12, %s\n\tsyscall\n",
+					syscall, prec, tac.RegName(blk.Adesc[stmt.Dst].Reg))
 
 			case tac.CMT:
 				if stmt.Line == 0 {
@@ -434,19 +730,39 @@ func CodeGen(t tac.Tac, runtime bool) {
 					fmt.Fprintf(&ts.Stmts, "\t# %s\n", stmt.Dst)
 				}
 
-			case tac.DECL, tac.DECLSTR:
+			case tac.DECL:
 				// Handled above in the first pass while updating data segment as
 				// data segment is required to be updated in case of declarations.
 
+			case tac.DECLSTR:
+				if !blk.Frame.IsLocal(stmt.Dst) {
+					break
+				}
+				stmt.Src = nil
+				blk.GetReg(&stmt, ts, typeInfo)
+				fmt.Fprintf(&ts.Stmts, "\tla\t$%d, %s\n", blk.Adesc[stmt.Dst].Reg, strLabel(stmt.Dst))
+				blk.MarkDirty(blk.Adesc[stmt.Dst].Reg)
+				dirtyRegCount++
+
 			default:
-				log.Fatalf("Codegen: invalid operator %s\n", stmt.Op)
+				if _, prec := tac.SplitOp(stmt.Op); prec == "" {
+					log.Fatalf("Codegen: invalid operator %s\n", stmt.Op)
+				}
+				// Avoid re-generating code for global declarations.
+				if globalStmts[[2]int{i, stmt.Line}] {
+					continue
+				}
+				if floatCode(&blk, ts, typeInfo, stmt) {
+					blk.MarkDirty(blk.Adesc[stmt.Dst].Reg)
+					dirtyRegCount++
+				}
 			}
 
 			// In case on of the src variable's register was allocated to dst in GetReg(),
 			// the src variable's lookup entry was temporarily marked. Find that variable
 			// if it exists and delete its entry. It should be noted that the chosen
 			// variable shouldn't have the same name as that of dst.
-			if _, ok := blk.Adesc[stmt.Dst]; ok && stmt.Op == tac.PRINTINT {
+			if _, ok := blk.Adesc[stmt.Dst]; ok {
 				for _, v := range stmt.Src {
 					switch v := v.(type) {
 					case tac.Str:
@@ -470,15 +786,23 @@ func CodeGen(t tac.Tac, runtime bool) {
 			if dirtyRegCount > 0 {
 				fmt.Fprintln(&ts.Stmts, "\t# Store dirty variables back into memory")
 				for _, k := range keys {
-					if typeInfo[blk.Rdesc[k].Name] != types.ARR && blk.Rdesc[k].Dirty {
-						fmt.Fprintf(&ts.Stmts, "\tsw\t$%d, %s\n", k, blk.Rdesc[k].Name)
+					if name := blk.Rdesc[k].Name; typeInfo[name] != types.ARR && blk.Rdesc[k].Dirty {
+						fmt.Fprintf(&ts.Stmts, "\t%s\t%s, %s\n", tac.StoreOp(typeInfo[name]), tac.RegName(k),
+							blk.Frame.Addr(name))
 						blk.UnmarkDirty(k)
 					}
 				}
 			}
 		}
-		for _, v := range jumpStmt {
-			fmt.Fprintln(&ts.Stmts, v)
+		if _, err := resultStmt.Stmts.WriteTo(&ts.Stmts); err != nil {
+			log.Fatal(err)
+		}
+		// The jump statements following a return statement are never
+		// executed.
+		if exitStmt == "" {
+			for _, v := range jumpStmt {
+				fmt.Fprintln(&ts.Stmts, v)
+			}
 		}
 		fmt.Fprintln(&ts.Stmts, exitStmt)
 	}
@@ -488,8 +812,18 @@ func CodeGen(t tac.Tac, runtime bool) {
 		log.Fatal("function main not defined\n")
 	}
 
+	if !runtime {
+		fmt.Fprint(&ds.Stmts, funcTable(funcs))
+		fmt.Fprintf(&ts.Stmts, "%s:\n", ast.RuntimeFunc("etext"))
+	}
 	fmt.Fprintln(&ds.Stmts, "")
 
+	if runtime && ds.Stmts.Len() > 1 {
+		// The runtime is placed in the text segment of the program being
+		// compiled, hence its data segment is explicitly delimited.
+		fmt.Fprintln(os.Stdout, "\t.data")
+		fmt.Fprintln(&ds.Stmts, "\t.text")
+	}
 	if _, err := ds.Stmts.WriteTo(os.Stdout); err != nil {
 		log.Fatal(err)
 	}
@@ -497,3 +831,60 @@ func CodeGen(t tac.Tac, runtime bool) {
 		log.Fatal(err)
 	}
 }
+
+// saveRegs stores the dirty registers back into memory before a call and
+// resets the register descriptors.
+func saveRegs(blk *tac.Blk, ts *tac.TextSec, typeInfo map[string]types.RegType) {
+	// Since range loop over maps are not deterministic, maintain a slice of
+	// sorted keys to preserve ordering across runs.
+	keys := []int{}
+	for k := range blk.Rdesc {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	for _, reg := range keys {
+		if name := blk.Rdesc[reg].Name; typeInfo[name] != types.ARR && blk.IsDirty(reg) {
+			fmt.Fprintf(&ts.Stmts, "\t%s\t%s, %s\n", tac.StoreOp(typeInfo[name]), tac.RegName(reg), blk.Frame.Addr(name))
+		}
+	}
+	blk.ResetRegs()
+}
+
+// loadValue loads the value of a variable (or an integer) into a register.
+func loadValue(blk *tac.Blk, ts *tac.TextSec, typeInfo map[string]types.RegType, v string, reg int) {
+	if i, err := strconv.Atoi(v); err == nil {
+		fmt.Fprintf(&ts.Stmts, "\tli\t$%d, %d\n", reg, i)
+	} else if _, ok := blk.Adesc[v]; ok {
+		fmt.Fprintf(&ts.Stmts, "\tmove\t$%d, $%d\n", reg, blk.Adesc[v].Reg)
+	} else {
+		fmt.Fprintf(&ts.Stmts, "\tlw\t$%d, %s\n", reg, blk.Frame.Addr(v))
+	}
+}
+
+// strLabel returns the label of the contents of a string declared by declStr.
+// globalArray declares a global array in the data section, which is aligned
+// to a word as it may follow the contents of a string. The array is zeroed,
+// unless the zero values of the words of an element follow its size, in which
+// case they are repeated throughout the array. A zero value other than 0 is
+// the name of a string, whose contents are referred to by the word.
+func globalArray(stmts *bytes.Buffer, stmt tac.Stmt, tab string) {
+	fmt.Fprintln(stmts, "\t.align\t2")
+	size := stmt.Src[0].IntVal()
+	zeros := stmt.Src[1:]
+	if len(zeros) == 0 {
+		fmt.Fprintf(stmts, "%s:%s.space\t%d\n", stmt.Dst, tab, tac.WordSize*size)
+		return
+	}
+	words := make([]string, size)
+	for k := range words {
+		words[k] = zeros[k%len(zeros)].StrVal()
+		if words[k] != "0" {
+			words[k] = strLabel(words[k])
+		}
+	}
+	fmt.Fprintf(stmts, "%s:%s.word\t%s\n", stmt.Dst, tab, strings.Join(words, ", "))
+}
+
+func strLabel(name string) string {
+	return name + ".str"
+}
@@ -0,0 +1,154 @@
+// This file implements the code generation for the statements whose operators
+// are defined on floating-point operands, which are evaluated by the
+// floating-point coprocessor.
+
+package codegen
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/shivansh/gogo/src/tac"
+	"github.com/shivansh/gogo/src/types"
+)
+
+// floatOperand returns the register holding a floating-point operand. A
+// constant is loaded into the given scratch register.
+func floatOperand(blk *tac.Blk, ts *tac.TextSec, v tac.SrcVar, prec string, scratch int) string {
+	if v, ok := v.(tac.F64); ok {
+		fmt.Fprintf(&ts.Stmts, "\tli.%s\t$f%d, %s\n", prec, scratch, v.StrVal())
+		return fmt.Sprintf("$f%d", scratch)
+	}
+	return tac.RegName(blk.Adesc[v.StrVal()].Reg)
+}
+
+// floatCode generates the code for a statement whose operator is suffixed by
+// the precision of its floating-point operands. The returned value determines
+// whether the destination is defined by the statement.
+func floatCode(blk *tac.Blk, ts *tac.TextSec, typeInfo map[string]types.RegType, stmt tac.Stmt) bool {
+	op, prec := tac.SplitOp(stmt.Op)
+	switch op {
+	case tac.PARAM:
+		// Parameters are pushed by the caller and already have a slot
+		// in the frame.
+		return false
+
+	case tac.STORE:
+		// The result occupies one (single precision) or two (double
+		// precision) words starting at the given index, which are
+		// moved into the coprocessor.
+		k := 0
+		if len(stmt.Src) > 0 {
+			k = stmt.Src[0].IntVal()
+		}
+		stmt.Src = nil
+		blk.GetReg(&stmt, ts, typeInfo)
+		reg := blk.Adesc[stmt.Dst].Reg - tac.RegLimit
+		for w := 0; w < tac.SizeOf(typeInfo[stmt.Dst])/tac.WordSize; w++ {
+			src := k + w + 2
+			if k+w >= 2 {
+				fmt.Fprintf(&ts.Stmts, "\tlw\t$24, -%d($sp)\n", tac.WordSize*(k+w-1))
+				src = 24
+			}
+			fmt.Fprintf(&ts.Stmts, "\tmtc1\t$%d, $f%d\n", src, reg+w)
+		}
+		return true
+	}
+
+	blk.GetReg(&stmt, ts, typeInfo)
+	dst := tac.RegName(blk.Adesc[stmt.Dst].Reg)
+	switch op {
+	case tac.EQ:
+		switch v := stmt.Src[0].(type) {
+		case tac.F64:
+			fmt.Fprintf(&ts.Stmts, "\tli.%s\t%s, %s\n", prec, dst, v.StrVal())
+		case tac.Str:
+			fmt.Fprintf(&ts.Stmts, "\tmov.%s\t%s, %s\n", prec, dst, tac.RegName(blk.Adesc[v.StrVal()].Reg))
+		default:
+			log.Fatalf("Codegen: unknown type %T\n", v)
+		}
+
+	case tac.ADD, tac.SUB, tac.MUL, tac.DIV:
+		left := floatOperand(blk, ts, stmt.Src[0], prec, 0)
+		right := floatOperand(blk, ts, stmt.Src[1], prec, 2)
+		fmt.Fprintf(&ts.Stmts, "\t%s.%s\t%s, %s, %s\n", ConvertOp(op), prec, dst, left, right)
+
+	case tac.NEG:
+		fmt.Fprintf(&ts.Stmts, "\tneg.%s\t%s, %s\n", prec, dst, floatOperand(blk, ts, stmt.Src[0], prec, 0))
+
+	case tac.CLT, tac.CLE, tac.CEQ, tac.CNE, tac.CGT, tac.CGE:
+		// The comparison sets the condition flag of the coprocessor,
+		// which is moved into the (integer) destination.
+		left := floatOperand(blk, ts, stmt.Src[0], prec, 0)
+		right := floatOperand(blk, ts, stmt.Src[1], prec, 2)
+		cond, move := "", "movf"
+		switch op {
+		case tac.CLT:
+			cond = "lt"
+		case tac.CLE:
+			cond = "le"
+		case tac.CGT:
+			cond, left, right = "lt", right, left
+		case tac.CGE:
+			cond, left, right = "le", right, left
+		case tac.CEQ:
+			cond = "eq"
+		case tac.CNE:
+			cond, move = "eq", "movt"
+		}
+		fmt.Fprintf(&ts.Stmts, "\tli\t%s, 1\n", dst)
+		fmt.Fprintf(&ts.Stmts, "\tc.%s.%s\t%s, %s\n", cond, prec, left, right)
+		fmt.Fprintf(&ts.Stmts, "\t%s\t%s, $0\n", move, dst)
+
+	case tac.FROM:
+		// The value is loaded a word at a time, as the address of a
+		// double precision value need not be aligned to a doubleword.
+		base := blk.Adesc[stmt.Src[0].StrVal()].Reg
+		reg := blk.Adesc[stmt.Dst].Reg - tac.RegLimit
+		for w := 0; w < tac.SizeOf(typeInfo[stmt.Dst])/tac.WordSize; w++ {
+			fmt.Fprintf(&ts.Stmts, "\tlwc1\t$f%d, %d($%d)\n", reg+w, tac.WordSize*(stmt.Src[1].IntVal()+w), base)
+		}
+
+	case tac.INTO:
+		base := blk.Adesc[stmt.Src[0].StrVal()].Reg
+		v := stmt.Src[2].StrVal()
+		reg := blk.Adesc[v].Reg - tac.RegLimit
+		for w := 0; w < tac.SizeOf(typeInfo[v])/tac.WordSize; w++ {
+			fmt.Fprintf(&ts.Stmts, "\tswc1\t$f%d, %d($%d)\n", reg+w, tac.WordSize*(stmt.Src[1].IntVal()+w), base)
+		}
+		return false
+
+	case tac.CVT:
+		// An integer ("w") is moved between the general purpose
+		// registers and the coprocessor, where it is converted.
+		// The conversion to an integer truncates towards zero.
+		to, from := tac.SplitOp(prec)
+		src := tac.RegName(blk.Adesc[stmt.Src[0].StrVal()].Reg)
+		switch {
+		case from == "w":
+			fmt.Fprintf(&ts.Stmts, "\tmtc1\t%s, $f0\n", src)
+			fmt.Fprintf(&ts.Stmts, "\tcvt.%s.w\t%s, $f0\n", to, dst)
+		case to == "w":
+			fmt.Fprintf(&ts.Stmts, "\ttrunc.w.%s\t$f0, %s\n", from, src)
+			fmt.Fprintf(&ts.Stmts, "\tmfc1\t%s, $f0\n", dst)
+		default:
+			fmt.Fprintf(&ts.Stmts, "\tcvt.%s.%s\t%s, %s\n", to, from, dst, src)
+		}
+
+	default:
+		log.Fatalf("Codegen: invalid operator %s\n", stmt.Op)
+	}
+	return true
+}
+
+// floatWord moves a word of a floating-point variable into a general purpose
+// register, loading the variable into $f0 if it is not held by a register.
+func floatWord(blk *tac.Blk, ts *tac.TextSec, typeInfo map[string]types.RegType, v string, w, reg int) {
+	src := 0
+	if addr, ok := blk.Adesc[v]; ok {
+		src = addr.Reg - tac.RegLimit
+	} else {
+		fmt.Fprintf(&ts.Stmts, "\t%s\t$f0, %s\n", tac.LoadOp(typeInfo[v]), blk.Frame.Addr(v))
+	}
+	fmt.Fprintf(&ts.Stmts, "\tmfc1\t$%d, $f%d\n", reg, src+w)
+}
@@ -1,3 +1,50 @@
 // This files implements routines to format and generate assembly code.
 
 package codegen
+
+import (
+	"fmt"
+
+	"github.com/shivansh/gogo/src/ast"
+	"github.com/shivansh/gogo/src/tac"
+)
+
+// prologue returns the instructions executed on entry to a function. The
+// prologue saves the return address and the caller's frame pointer, sets up
+// the frame pointer of the callee and allocates space for its locals.
+func prologue(frame *tac.Frame) string {
+	code := "\taddi\t$sp, $sp, -8\n\tsw\t$ra, 4($sp)\n\tsw\t$fp, 0($sp)\n\tmove\t$fp, $sp\n"
+	if frame != nil && frame.Size > 0 {
+		code += fmt.Sprintf("\taddi\t$sp, $sp, -%d\n", frame.Size)
+	}
+	return code
+}
+
+// epilogue returns the instructions executed on returning from a function. The
+// epilogue discards the frame of the callee and restores the state of the
+// caller saved by the prologue.
+func epilogue(funcName string) string {
+	return fmt.Sprintf("\tmove\t$sp, $fp\n\tlw\t$fp, 0($sp)\n\tlw\t$ra, 4($sp)\n"+
+		"\taddi\t$sp, $sp, 8\n\tjr\t$ra\n\t.end %s", funcName)
+}
+
+// runtimeCheck returns the instructions which call the runtime function fn
+// when the given register holds 0, e.g. a nil pointer or a zero divisor. The
+// call never returns, and the code resumes past it at the given label.
+func runtimeCheck(reg int, fn, label string) string {
+	return fmt.Sprintf("\tbne\t$%d, $0, %s\n\tjal\t%s\n%s:\n", reg, label, fn, label)
+}
+
+// funcTable returns the table of the functions of the program, which is used by
+// the runtime for printing the calls in progress when the program panics. The
+// labels of the functions are given in the order of their addresses.
+func funcTable(funcs []string) string {
+	table := fmt.Sprintf("%s:\t.word\tmain\n", ast.RuntimeFunc("functab"))
+	names := ""
+	for _, f := range funcs {
+		name := ast.RuntimeFunc("name." + f)
+		table += fmt.Sprintf("\t.word\t%s, %s\n", f, name)
+		names += fmt.Sprintf("%s:\t.asciiz \"%s\"\n", name, ast.GoName(f))
+	}
+	return table + fmt.Sprintf("\t.word\t%s, 0\n", ast.RuntimeFunc("etext")) + names
+}
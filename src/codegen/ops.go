@@ -5,6 +5,8 @@ import "github.com/shivansh/gogo/src/tac"
 // ConvertOp returns an assembly operator from the corresponding IR operator.
 func ConvertOp(irOp string) (asmOp string) {
 	switch irOp {
+	case tac.ADD:
+		asmOp = "add"
 	case tac.OR:
 		asmOp = "or"
 	case tac.AND:
@@ -22,7 +24,8 @@ func ConvertOp(irOp string) (asmOp string) {
 	case tac.REM:
 		asmOp = "rem"
 	case tac.RST:
-		asmOp = "srl"
+		// Right shifts of signed integers are arithmetic.
+		asmOp = "sra"
 	case tac.LST:
 		asmOp = "sll"
 	case tac.BEQ:
@@ -7,6 +7,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/shivansh/gogo/src/ast"
 	"github.com/shivansh/gogo/src/cmd"
 	"github.com/shivansh/gogo/src/runtime"
 )
@@ -25,20 +26,29 @@ func main() {
 	ir := flag.Bool("r", false, "Generates IR instructions from go program")
 	ir2asm := flag.Bool("r2s", false, "Generates the MIPS assembly from IR")
 	prod := flag.Bool("p", false, "Generates rightmost derivations used in bottom-up parsing")
+	noChecks := flag.Bool("B", false, "Disables the runtime checks of indices, nil pointers and divisors")
 	// optimize := flag.Bool("O", false, "Turn on optimizations")
 	flag.Parse()
 
-	if len(args) != 3 {
-		fmt.Fprintf(os.Stderr, "Usage: gogo (-p | -r | -r2s | -s) <filename>\n")
+	if flag.NArg() != 1 {
+		fmt.Fprintf(os.Stderr, "Usage: gogo [-B] (-p | -r | -r2s | -s) (<filename> | <directory>)\n")
 		flag.PrintDefaults()
 		os.Exit(1)
 	}
+	ast.NoChecks = *noChecks
+	src := flag.Arg(0)
 
 	var err error
 	ErrInvalidFile := errors.New("invalid filename")
-	index := strings.LastIndex(args[2], ".")
-	if index == -1 {
-		err = ErrInvalidFile
+	index := strings.LastIndex(src, ".")
+	if info, statErr := os.Stat(src); statErr == nil && info.IsDir() {
+		// The program is a directory of source files, whose assembly
+		// is placed next to it.
+		src = strings.TrimSuffix(src, string(os.PathSeparator))
+		index = len(src)
+	} else if index == -1 {
+		fmt.Fprintf(os.Stderr, "%s\n", ErrInvalidFile)
+		os.Exit(1)
 	}
 
 	// If control flow has reached here, then we are not compiling runtime
@@ -46,18 +56,18 @@ func main() {
 	runtime := false
 
 	if *asm {
-		dst := fmt.Sprintf("%s.asm", args[2][:index])
-		cmd.GenAsm(args[2], dst, runtime)
+		dst := fmt.Sprintf("%s.asm", src[:index])
+		cmd.GenAsm(src, dst, runtime)
 	} else if *ir {
-		cmd.GenIR(args[2])
+		cmd.GenIR(src)
 	} else if *ir2asm {
 		// TODO: Verify if the input is indeed in a valid IR format.
-		dst := fmt.Sprintf("%s.asm", args[2][:index])
-		cmd.GenAsmFromIR(args[2], dst, runtime)
+		dst := fmt.Sprintf("%s.asm", src[:index])
+		cmd.GenAsmFromIR(src, dst, runtime)
 	} else if *prod {
-		if err = cmd.GenHTML(args[2]); err == nil {
-			index := strings.LastIndex(args[2], ".")
-			genFileName := args[2][:index]
+		if err = cmd.GenHTML(src); err == nil {
+			index := strings.LastIndex(src, ".")
+			genFileName := src[:index]
 			fmt.Printf("Successfully created %s.html\n", genFileName)
 		}
 	}
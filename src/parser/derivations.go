@@ -17,6 +17,7 @@ import (
 	parseError "github.com/shivansh/gogo/goccgen/errors"
 	"github.com/shivansh/gogo/goccgen/lexer"
 	"github.com/shivansh/gogo/goccgen/parser"
+	"github.com/shivansh/gogo/src/scanner"
 	"github.com/shivansh/gogo/src/utils"
 )
 
@@ -28,12 +29,20 @@ func GenProductions(file string) error {
 	if err != nil {
 		log.Fatal(err)
 	}
+	// The top-level declarations are ordered such that the names they refer
+	// to are declared before them, and the errors are reported at the lines
+	// of the original source.
+	content, lines := scanner.SortDecls(content)
 	s := lexer.NewLexer(content)
 	p := parser.NewParser()
 	// When parsing is finished, a final call to the routine PrintIR() (in
 	// package ast) is made. This prints the generated IR instructins.
 	if _, err = p.Parse(s); err != nil {
-		if e := err.(*parseError.Error); e.Err == nil {
+		e := err.(*parseError.Error)
+		if line := e.ErrorToken.Pos.Line; line > 0 && line <= len(lines) {
+			e.ErrorToken.Pos.Line = lines[line-1]
+		}
+		if e.Err == nil {
 			// The error is encountered while parsing.
 			return fmt.Errorf("%s:%d: expected one of %s, found %s\n", file,
 				e.ErrorToken.Pos.Line, e.ExpectedTokens, e.ErrorToken.Lit)
@@ -1,12 +1,1004 @@
 // --- [ DO NOT EDIT ] ---------------------------------------------------------
 //
-// This is a dummy file for demonstrating runtime support. This is compiled by
-// gogo, hence is not in pure Go.
+// This file implements the runtime support for the compiled programs. This is
+// compiled by gogo, hence is not in pure Go. The builtins sbrk, exit, loadWord,
+// storeWord, loadByte, storeByte, goswitch, getfp and functab are only available
+// when compiling the runtime.
 //
 // +build Ignore
 
 package runtime
 
-func runtime() {
+// The heap is a contiguous arena obtained from the operating system, and the
+// memory is allocated by bumping heapPtr. The allocated memory is never freed.
+var heapPtr int
+var heapEnd int
+
+// malloc allocates size bytes of word-aligned zeroed memory on the heap and
+// returns its address.
+func malloc(size int) int {
+	size = (size + 3) &^ 3
+	if heapEnd == 0 || heapPtr+size > heapEnd {
+		// The arena is exhausted (or is yet to be obtained, in which case
+		// even an empty block gets a non-nil address), request a new one.
+		n := 4096
+		if size > n {
+			n = size
+		}
+		heapPtr = sbrk(n)
+		heapEnd = heapPtr + n
+	}
+	p := heapPtr
+	heapPtr += size
+	return p
+}
+
+// growslice returns a slice of length n holding the elements of s, each of
+// which occupies the given number of words. The underlying array of s is reused
+// when its capacity is sufficient, otherwise a new array with at least twice
+// the capacity is allocated.
+func growslice(s []int, n, size int) []int {
+	if n <= cap(s) {
+		return s[:n]
+	}
+	c := 2 * cap(s)
+	if c < n {
+		c = n
+	}
+	t := make([]int, c*size)
+	src := loadWord(s, 0)
+	dst := loadWord(t, 0)
+	for i := 0; i < len(s)*size; i++ {
+		storeWord(dst, i, loadWord(src, i))
+	}
+	return t[:n:c]
+}
+
+// The hash table of a map is of the form -
+//	{ number of entries, number of buckets, address of buckets, string keys }
+// where each bucket is a chain of entries of the form -
+//	{ key, element, next entry }
+// The number of buckets is always a power of two. The string keys are compared
+// by their contents, and other keys by their values.
+
+// makemap returns an empty map.
+func makemap(strkeys int) int {
+	nb := 8
+	m := malloc(16)
+	buckets := malloc(4 * nb)
+	storeWord(m, 1, nb)
+	storeWord(m, 2, buckets)
+	storeWord(m, 3, strkeys)
+	return m
+}
+
+// strhash returns the hash of a string.
+func strhash(s int) int {
+	h := 0
+	i := 0
+	c := loadByte(s, i)
+	for c != 0 {
+		h = h*31 + c
+		i++
+		c = loadByte(s, i)
+	}
+	return h
+}
+
+// strequal reports whether two strings are equal.
+func strequal(a, b int) int {
+	i := 0
+	for {
+		c := loadByte(a, i)
+		if c != loadByte(b, i) {
+			return 0
+		}
+		if c == 0 {
+			return 1
+		}
+		i++
+	}
+}
+
+// The strings are represented by the addresses of their contents, which are
+// terminated by a null byte. The strings created at runtime are allocated on
+// the heap.
+
+// strlen returns the length of a string.
+func strlen(s int) int {
+	n := 0
+	c := loadByte(s, n)
+	for c != 0 {
+		n++
+		c = loadByte(s, n)
+	}
+	return n
+}
+
+// concat returns the concatenation of two strings.
+func concat(a, b int) int {
+	m := strlen(a)
+	n := strlen(b)
+	s := malloc(m + n + 1)
+	for i := 0; i < m; i++ {
+		storeByte(s, i, loadByte(a, i))
+	}
+	for i := 0; i < n; i++ {
+		storeByte(s, m+i, loadByte(b, i))
+	}
+	storeByte(s, m+n, 0)
+	return s
+}
+
+// strcmp compares two strings lexicographically, and returns -1, 0 or 1 when
+// the first string is less than, equal to or greater than the second one.
+func strcmp(a, b int) int {
+	i := 0
+	for {
+		c := loadByte(a, i)
+		d := loadByte(b, i)
+		if c != d {
+			if c < d {
+				return -1
+			}
+			return 1
+		}
+		if c == 0 {
+			return 0
+		}
+		i++
+	}
+}
+
+// itoa returns the decimal representation of an integer. The digits are
+// evaluated on the negated value, which cannot overflow.
+func itoa(n int) int {
+	s := malloc(12)
+	i := 11
+	neg := 0
+	if n < 0 {
+		neg = 1
+	} else {
+		n = -n
+	}
+	for {
+		i--
+		storeByte(s, i, '0'-n%10)
+		n = n / 10
+		if n == 0 {
+			break
+		}
+	}
+	if neg == 1 {
+		i--
+		storeByte(s, i, '-')
+	}
+	return s + i
+}
+
+// runestring returns the UTF-8 encoding of a rune. An invalid rune is encoded
+// as the replacement character U+FFFD.
+func runestring(r int) int {
+	if r < 0 || r > 1114111 || r >= 55296 && r <= 57343 {
+		r = 65533
+	}
+	s := malloc(5)
+	if r < 128 {
+		storeByte(s, 0, r)
+	} else if r < 2048 {
+		storeByte(s, 0, 192|r>>6)
+		storeByte(s, 1, 128|r&63)
+	} else if r < 65536 {
+		storeByte(s, 0, 224|r>>12)
+		storeByte(s, 1, 128|r>>6&63)
+		storeByte(s, 2, 128|r&63)
+	} else {
+		storeByte(s, 0, 240|r>>18)
+		storeByte(s, 1, 128|r>>12&63)
+		storeByte(s, 2, 128|r>>6&63)
+		storeByte(s, 3, 128|r&63)
+	}
+	return s
+}
+
+// printrune prints the UTF-8 encoding of a rune.
+func printrune(r int) {
+	if r >= 0 && r < 128 {
+		printChar r
+		return
+	}
+	s := runestring(r)
+	printStr s
+	return
+}
+
+// fmtint returns the representation of an integer in a base which is at most
+// 16, where the digits above 9 are lower case letters.
+func fmtint(n, base int) int {
+	s := malloc(36)
+	i := 35
+	neg := 0
+	if n < 0 {
+		neg = 1
+	} else {
+		n = -n
+	}
+	for {
+		i--
+		d := -(n % base)
+		if d < 10 {
+			storeByte(s, i, '0'+d)
+		} else {
+			storeByte(s, i, 'a'+d-10)
+		}
+		n = n / base
+		if n == 0 {
+			break
+		}
+	}
+	if neg == 1 {
+		i--
+		storeByte(s, i, '-')
+	}
+	return s + i
+}
+
+// fmtbool returns the representation of a boolean. The strings are declared
+// as variables, as the temporaries of the runtime are not qualified.
+func fmtbool(b int) string {
+	yes := "true"
+	no := "false"
+	if b != 0 {
+		return yes
+	}
+	return no
+}
+
+// fmtiface returns the representation of a value returned by recover, which is
+// the message of a panic or nil. The representation of nil is copied, as a
+// string cannot be returned as an integer.
+func fmtiface(v int) int {
+	if v != 0 {
+		return v
+	}
+	nilValue := "<nil>"
+	empty := ""
+	return concat(nilValue, empty)
+}
+
+// fmtpad pads a string to a width counted in runes. The flags are those of
+// the formatting verb, where 1 pads with spaces on the right, 2 pads with
+// leading zeros and 4 places the leading zeros after a minus sign.
+func fmtpad(s, width, flags int) int {
+	n := strlen(s)
+	runes := 0
+	for i := 0; i < n; i++ {
+		if loadByte(s, i)&192 != 128 {
+			runes++
+		}
+	}
+	if runes >= width {
+		return s
+	}
+	pad := width - runes
+	p := malloc(n + pad + 1)
+	i := 0
+	j := 0
+	if flags&1 == 0 {
+		c := ' '
+		if flags&2 != 0 {
+			c = '0'
+			if flags&4 != 0 && loadByte(s, 0) == '-' {
+				storeByte(p, 0, '-')
+				i, j = 1, 1
+			}
+		}
+		for k := 0; k < pad; k++ {
+			storeByte(p, j, c)
+			j++
+		}
+	}
+	for i < n {
+		storeByte(p, j, loadByte(s, i))
+		i++
+		j++
+	}
+	if flags&1 != 0 {
+		for k := 0; k < pad; k++ {
+			storeByte(p, j, ' ')
+			j++
+		}
+	}
+	return p
+}
+
+// A goroutine is scheduled cooperatively, i.e. it runs until it blocks on a
+// channel operation or exits. A goroutine is represented by a descriptor of
+// the form -
+//	{ stack pointer, frame pointer, resume address, next goroutine, id,
+//	  frame of the go statement, deferred calls, panic }
+// where the first three words are saved when the goroutine is suspended, and
+// the frame is the one copied by the go statement which started it. The
+// goroutines other than the main one run on stacks allocated on the heap. The
+// runnable goroutines are held in a queue.
+var curg int
+var runqhead int
+var runqtail int
+var goidgen int
+
+// getg returns the descriptor of the running goroutine. The descriptor of the
+// main goroutine is allocated when it is first suspended.
+func getg() int {
+	if curg == 0 {
+		curg = malloc(32)
+		storeWord(curg, 4, 1)
+	}
+	return curg
+}
+
+// newg returns the descriptor of a new goroutine, whose stack pointer is the
+// top of a stack of 64 KiB. The frame of its entry point is copied onto the
+// stack by the fork statement. The main goroutine is numbered 1, and the others
+// are numbered from 2 in the order of their creation.
+func newg() int {
+	g := malloc(32)
+	size := 65536
+	storeWord(g, 0, malloc(size)+size)
+	goidgen++
+	storeWord(g, 4, goidgen+1)
+	return g
+}
+
+// ready appends a goroutine to the run queue.
+func ready(g int) {
+	storeWord(g, 3, 0)
+	if runqtail == 0 {
+		runqhead = g
+	} else {
+		storeWord(runqtail, 3, g)
+	}
+	runqtail = g
+	return
+}
+
+// park suspends the running goroutine and resumes the goroutine at the head
+// of the run queue. When no goroutine is runnable, all of them are blocked
+// and the program is terminated.
+func park() {
+	next := runqhead
+	if next == 0 {
+		msg := "fatal error: all goroutines are asleep - deadlock!\n"
+		printStr msg
+		exit(2)
+	}
+	runqhead = loadWord(next, 3)
+	if runqhead == 0 {
+		runqtail = 0
+	}
+	prev := getg()
+	curg = next
+	goswitch(prev, next)
+	return
+}
+
+// goexit terminates the running goroutine, which is never resumed.
+func goexit() {
+	park()
+	return
+}
+
+// The functions of the program are described by a table placed in its data
+// section, which is of the form -
+//	{ address of main, (address of a function, name of the function)... }
+// where the functions are in the order of their addresses, and the last entry
+// is the end of the text of the program, whose name is 0. The runtime precedes
+// the functions of the program.
+
+// findfunc returns the index of the entry of the function containing the
+// address pc in the table of the functions, or 0 if the address is not one of
+// the program.
+func findfunc(pc int) int {
+	tab := functab()
+	if pc < loadWord(tab, 1) {
+		return 0
+	}
+	i := 1
+	for loadWord(tab, i+1) != 0 && loadWord(tab, i+2) <= pc {
+		i += 2
+	}
+	if loadWord(tab, i+1) == 0 {
+		return 0
+	}
+	return i
+}
+
+// traceback returns the functions of the calls in progress on the running
+// goroutine, starting from the innermost one. Each frame holds the frame
+// pointer and the return address of its caller, and the frames are walked
+// until the one of main, or that of the function which started the goroutine.
+func traceback() int {
+	id := 1
+	base := 0
+	if curg != 0 {
+		id = loadWord(curg, 4)
+		base = loadWord(curg, 5)
+	}
+	header := "\ngoroutine "
+	running := " [running]:\n"
+	call := "()\n"
+	createdBy := "created by "
+	newline := "\n"
+	s := concat(concat(header, itoa(id)), running)
+	tab := functab()
+	fp := getfp()
+	for fp != 0 {
+		pc := loadWord(fp, 1)
+		fp = loadWord(fp, 0)
+		i := findfunc(pc)
+		if i == 0 {
+			continue
+		}
+		name := loadWord(tab, i+1)
+		if base != 0 && fp == base {
+			s = concat(concat(concat(s, createdBy), name), newline)
+			break
+		}
+		s = concat(concat(s, name), call)
+		if loadWord(tab, i) == loadWord(tab, 0) {
+			break
+		}
+	}
+	return s
+}
+
+// A panic is described by a record of the form -
+//	{ message, recovered, calls in progress, frame being unwound }
+// which is held by the goroutine until the panic is recovered from. The calls
+// in progress are those when the panic started, and the frame being unwound is
+// that of the function running its deferred calls.
+
+// unwind runs the next deferred call of the running goroutine during a panic,
+// by resuming the function which deferred it at the code running its deferred
+// calls. The stack pointer is reset below the frame of unwind, the frames of
+// the calls in progress being discarded. When no deferred call remains, the
+// panic is printed and the program is terminated.
+func unwind(p int) {
+	g := getg()
+	d := loadWord(g, 6)
+	if d == 0 {
+		prefix := "panic: "
+		newline := "\n"
+		msg := loadWord(p, 0)
+		trace := loadWord(p, 2)
+		printStr prefix
+		printStr msg
+		printStr newline
+		printStr trace
+		exit(2)
+	}
+	storeWord(p, 3, loadWord(d, 1))
+	ctx := malloc(12)
+	storeWord(ctx, 0, getfp())
+	storeWord(ctx, 1, loadWord(d, 1))
+	storeWord(ctx, 2, loadWord(d, 2))
+	goswitch(malloc(12), ctx)
+	return
+}
+
+// gopanic starts a panic with the given message, which replaces that of the
+// panic in progress, if any.
+func gopanic(msg string) {
+	g := getg()
+	p := loadWord(g, 7)
+	if p == 0 {
+		p = malloc(16)
+		storeWord(g, 7, p)
+	}
+	storeWord(p, 0, msg)
+	storeWord(p, 1, 0)
+	storeWord(p, 2, traceback())
+	unwind(p)
+	return
+}
+
+// deferproc pushes the record of a call deferred by the function whose frame
+// is fp onto the deferred calls of the running goroutine, and returns it. The
+// code at pc runs the deferred calls of the function, and the arguments of the
+// call are stored by the caller in the n words following the site.
+func deferproc(fp, pc, site, n int) int {
+	g := getg()
+	d := malloc(16 + 4*n)
+	storeWord(d, 0, loadWord(g, 6))
+	storeWord(d, 1, fp)
+	storeWord(d, 2, pc)
+	storeWord(d, 3, site)
+	storeWord(g, 6, d)
+	return d
+}
+
+// deferpop removes the record of the next deferred call of the running
+// goroutine and returns it, if it was deferred by the function whose frame is
+// fp, and returns 0 otherwise.
+func deferpop(fp int) int {
+	g := getg()
+	d := loadWord(g, 6)
+	if d == 0 || loadWord(d, 1) != fp {
+		return 0
+	}
+	storeWord(g, 6, loadWord(d, 0))
+	return d
+}
+
+// deferreturn is called once the function whose frame is fp has run its
+// deferred calls. If the function was resumed by a panic, the function returns
+// normally when the panic was recovered from, and the panic continues with the
+// deferred calls of its callers otherwise.
+func deferreturn(fp int) {
+	g := getg()
+	p := loadWord(g, 7)
+	if p == 0 || loadWord(p, 3) != fp {
+		return
+	}
+	if loadWord(p, 1) == 0 {
+		unwind(p)
+	}
+	storeWord(g, 7, 0)
+	return
+}
+
+// gorecover stops the panic of the running goroutine and returns its message.
+// It returns 0 (nil) when the goroutine is not panicking, or when the panic
+// was already recovered from.
+func gorecover() int {
+	p := loadWord(getg(), 7)
+	if p == 0 || loadWord(p, 1) != 0 {
+		return 0
+	}
+	storeWord(p, 1, 1)
+	return loadWord(p, 0)
+}
+
+// panicNilMap is called when assigning to an element of a nil map.
+func panicNilMap() {
+	msg := "assignment to entry in nil map"
+	gopanic(msg)
+	return
+}
+
+// hashkey returns the index of the bucket holding a key.
+func hashkey(m, k int) int {
+	h := k
+	if loadWord(m, 3) != 0 {
+		h = strhash(k)
+	}
+	h = h ^ (h >> 16)
+	return h & (loadWord(m, 1) - 1)
+}
+
+// keyequal reports whether two keys of a map are equal.
+func keyequal(m, a, b int) int {
+	if loadWord(m, 3) != 0 {
+		return strequal(a, b)
+	}
+	if a == b {
+		return 1
+	}
+	return 0
+}
+
+// mapaccess returns the address of the element of a map with the given key,
+// or 0 if the key is not present.
+func mapaccess(m, k int) int {
+	if m == 0 {
+		return 0
+	}
+	e := loadWord(loadWord(m, 2), hashkey(m, k))
+	for e != 0 {
+		if keyequal(m, loadWord(e, 0), k) != 0 {
+			return e + 4
+		}
+		e = loadWord(e, 2)
+	}
+	return 0
+}
+
+// mapgrow doubles the number of buckets of a map and redistributes its
+// entries among them.
+func mapgrow(m int) {
+	nb := loadWord(m, 1)
+	old := loadWord(m, 2)
+	buckets := malloc(8 * nb)
+	storeWord(m, 1, 2*nb)
+	storeWord(m, 2, buckets)
+	for i := 0; i < nb; i++ {
+		e := loadWord(old, i)
+		for e != 0 {
+			next := loadWord(e, 2)
+			j := hashkey(m, loadWord(e, 0))
+			storeWord(e, 2, loadWord(buckets, j))
+			storeWord(buckets, j, e)
+			e = next
+		}
+	}
+	return
+}
+
+// mapassign returns the address of the element of a map with the given key.
+// The key is inserted with a zero element if it is not present.
+func mapassign(m, k int) int {
+	if m == 0 {
+		panicNilMap()
+	}
+	p := mapaccess(m, k)
+	if p != 0 {
+		return p
+	}
+	// The number of buckets is doubled once the chains become long.
+	if loadWord(m, 0) >= 2*loadWord(m, 1) {
+		mapgrow(m)
+	}
+	e := malloc(12)
+	storeWord(e, 0, k)
+	b := loadWord(m, 2)
+	i := hashkey(m, k)
+	storeWord(e, 2, loadWord(b, i))
+	storeWord(b, i, e)
+	storeWord(m, 0, loadWord(m, 0)+1)
+	return e + 4
+}
+
+// mapdelete removes the entry of a map with the given key, if present.
+func mapdelete(m, k int) {
+	if m == 0 {
+		return
+	}
+	b := loadWord(m, 2)
+	i := hashkey(m, k)
+	prev := 0
+	e := loadWord(b, i)
+	for e != 0 {
+		if keyequal(m, loadWord(e, 0), k) != 0 {
+			if prev == 0 {
+				storeWord(b, i, loadWord(e, 2))
+			} else {
+				storeWord(prev, 2, loadWord(e, 2))
+			}
+			storeWord(m, 0, loadWord(m, 0)-1)
+			return
+		}
+		prev = e
+		e = loadWord(e, 2)
+	}
+	return
+}
+
+// maplen returns the number of entries in a map.
+func maplen(m int) int {
+	if m == 0 {
+		return 0
+	}
+	return loadWord(m, 0)
+}
+
+// mapiterinit returns an iterator over the entries of a map, which is of the
+// form -
+//	{ map, index of the next bucket, next entry }
+func mapiterinit(m int) int {
+	it := malloc(12)
+	storeWord(it, 0, m)
+	return it
+}
+
+// mapiternext returns the address of the next entry of an iterator, or 0 if
+// the iteration is over. The entry following the returned one is saved in the
+// iterator so that the returned entry can be deleted during the iteration.
+func mapiternext(it int) int {
+	m := loadWord(it, 0)
+	if m == 0 {
+		return 0
+	}
+	e := loadWord(it, 2)
+	i := loadWord(it, 1)
+	for e == 0 {
+		if i >= loadWord(m, 1) {
+			return 0
+		}
+		e = loadWord(loadWord(m, 2), i)
+		i++
+	}
+	storeWord(it, 1, i)
+	storeWord(it, 2, loadWord(e, 2))
+	return e
+}
+
+// panicIndex is called when an index is out of range.
+func panicIndex(i, n int) {
+	msg := "runtime error: index out of range ["
+	withLen := "] with length "
+	gopanic(concat(concat(concat(msg, itoa(i)), withLen), itoa(n)))
+	return
+}
+
+// panicSlice is called when the indices of a slice expression are out of range.
+func panicSlice() {
+	msg := "runtime error: slice bounds out of range"
+	gopanic(msg)
+	return
+}
+
+// panicMakeSlice is called when the length or capacity passed to make is out
+// of range.
+func panicMakeSlice() {
+	msg := "runtime error: makeslice: len out of range"
+	gopanic(msg)
 	return
 }
+
+// panicDivide is called when an integer is divided by zero.
+func panicDivide() {
+	msg := "runtime error: integer divide by zero"
+	gopanic(msg)
+	return
+}
+
+// panicNil is called when a nil pointer is dereferenced.
+func panicNil() {
+	msg := "runtime error: invalid memory address or nil pointer dereference"
+	gopanic(msg)
+	return
+}
+
+// A channel is represented by the address of a header of the form -
+//	{ buffer, capacity, count, index of the first element, closed,
+//	  zero value, send queue, receive queue }
+// where the buffer is a circular queue of the elements. The goroutines blocked
+// on a channel are held in its queues by waiters of the form -
+//	{ goroutine, element, ok, next waiter, select }
+// where ok is set when the operation of the waiter completes. The waiters of a
+// select statement share a word (select) which holds the waiter whose
+// operation completed first, and the remaining ones are skipped when they are
+// dequeued. The zero value of a channel (nil) is represented by 0.
+
+// makechan returns a channel with a buffer of the given capacity, where the
+// zero value is received once the channel is closed.
+func makechan(size, zero int) int {
+	if size < 0 {
+		msg := "makechan: size out of range"
+		gopanic(msg)
+	}
+	c := malloc(32)
+	storeWord(c, 0, malloc(4*size))
+	storeWord(c, 1, size)
+	storeWord(c, 5, zero)
+	return c
+}
+
+// chanlen returns the number of elements in the buffer of a channel.
+func chanlen(c int) int {
+	if c == 0 {
+		return 0
+	}
+	return loadWord(c, 2)
+}
+
+// chancap returns the capacity of the buffer of a channel.
+func chancap(c int) int {
+	if c == 0 {
+		return 0
+	}
+	return loadWord(c, 1)
+}
+
+// enqueue appends a waiter to the queue of a channel at the given index.
+func enqueue(c, q, w int) {
+	storeWord(w, 3, 0)
+	p := loadWord(c, q)
+	if p == 0 {
+		storeWord(c, q, w)
+		return
+	}
+	for loadWord(p, 3) != 0 {
+		p = loadWord(p, 3)
+	}
+	storeWord(p, 3, w)
+	return
+}
+
+// dequeue removes the first waiter from the queue of a channel at the given
+// index and returns it, or 0 if the queue is empty. The waiters of the select
+// statements which have already completed are discarded.
+func dequeue(c, q int) int {
+	w := loadWord(c, q)
+	for w != 0 {
+		storeWord(c, q, loadWord(w, 3))
+		sel := loadWord(w, 4)
+		if sel == 0 {
+			return w
+		}
+		if loadWord(sel, 0) == 0 {
+			storeWord(sel, 0, w)
+			return w
+		}
+		w = loadWord(c, q)
+	}
+	return 0
+}
+
+// trysend sends a value on a channel if it does not block, and reports
+// whether the value was sent.
+func trysend(c, v int) int {
+	if c == 0 {
+		return 0
+	}
+	if loadWord(c, 4) != 0 {
+		msg := "send on closed channel"
+		gopanic(msg)
+	}
+	// A blocked receiver receives the value directly.
+	w := dequeue(c, 7)
+	if w != 0 {
+		storeWord(w, 1, v)
+		storeWord(w, 2, 1)
+		ready(loadWord(w, 0))
+		return 1
+	}
+	n := loadWord(c, 2)
+	size := loadWord(c, 1)
+	if n < size {
+		storeWord(loadWord(c, 0), (loadWord(c, 3)+n)%size, v)
+		storeWord(c, 2, n+1)
+		return 1
+	}
+	return 0
+}
+
+// tryrecv receives a value from a channel into a waiter if it does not block,
+// and reports whether a value was received. The ok word of the waiter is
+// cleared when the zero value is received from a closed channel.
+func tryrecv(c, w int) int {
+	if c == 0 {
+		return 0
+	}
+	n := loadWord(c, 2)
+	if n > 0 {
+		buf := loadWord(c, 0)
+		size := loadWord(c, 1)
+		i := loadWord(c, 3)
+		storeWord(w, 1, loadWord(buf, i))
+		storeWord(w, 2, 1)
+		storeWord(c, 3, (i+1)%size)
+		storeWord(c, 2, n-1)
+		// The value of a blocked sender takes the freed slot.
+		s := dequeue(c, 6)
+		if s != 0 {
+			storeWord(buf, (i+n)%size, loadWord(s, 1))
+			storeWord(c, 2, n)
+			storeWord(s, 2, 1)
+			ready(loadWord(s, 0))
+		}
+		return 1
+	}
+	s := dequeue(c, 6)
+	if s != 0 {
+		storeWord(w, 1, loadWord(s, 1))
+		storeWord(w, 2, 1)
+		storeWord(s, 2, 1)
+		ready(loadWord(s, 0))
+		return 1
+	}
+	if loadWord(c, 4) != 0 {
+		storeWord(w, 1, loadWord(c, 5))
+		storeWord(w, 2, 0)
+		return 1
+	}
+	return 0
+}
+
+// chansend sends a value on a channel, blocking until it is received or
+// buffered. A send on a nil channel blocks forever.
+func chansend(c, v int) {
+	if trysend(c, v) != 0 {
+		return
+	}
+	w := malloc(20)
+	storeWord(w, 0, getg())
+	storeWord(w, 1, v)
+	if c != 0 {
+		enqueue(c, 6, w)
+	}
+	park()
+	if loadWord(w, 2) == 0 {
+		// The channel was closed while the sender was blocked.
+		msg := "send on closed channel"
+		gopanic(msg)
+	}
+	return
+}
+
+// chanrecv receives a value from a channel, blocking until one is sent, and
+// reports whether the value was sent (rather than being the zero value of a
+// closed channel). A receive from a nil channel blocks forever.
+func chanrecv(c int) (int, int) {
+	w := malloc(20)
+	if tryrecv(c, w) == 0 {
+		storeWord(w, 0, getg())
+		if c != 0 {
+			enqueue(c, 7, w)
+		}
+		park()
+	}
+	return loadWord(w, 1), loadWord(w, 2)
+}
+
+// closechan closes a channel. The blocked receivers receive the zero value,
+// whereas the blocked senders panic.
+func closechan(c int) {
+	if c == 0 {
+		msg := "close of nil channel"
+		gopanic(msg)
+	}
+	if loadWord(c, 4) != 0 {
+		msg := "close of closed channel"
+		gopanic(msg)
+	}
+	storeWord(c, 4, 1)
+	w := dequeue(c, 7)
+	for w != 0 {
+		storeWord(w, 1, loadWord(c, 5))
+		storeWord(w, 2, 0)
+		ready(loadWord(w, 0))
+		w = dequeue(c, 7)
+	}
+	w = dequeue(c, 6)
+	for w != 0 {
+		ready(loadWord(w, 0))
+		w = dequeue(c, 6)
+	}
+	return
+}
+
+// selectgo executes a select statement whose n cases are held in an array of
+// records of the form -
+//	{ goroutine, element, ok, next waiter, select, channel, send }
+// whose first five words are used as the waiters of the cases, and returns the
+// index of the case which proceeds. The cases are polled in order, and when
+// none of them can proceed, -1 is returned if the statement has a default case
+// (block is 0), otherwise the goroutine blocks on all of the cases.
+func selectgo(cases, n, block int) int {
+	for i := 0; i < n; i++ {
+		w := cases + 28*i
+		c := loadWord(w, 5)
+		if loadWord(w, 6) != 0 {
+			if trysend(c, loadWord(w, 1)) != 0 {
+				return i
+			}
+		} else if tryrecv(c, w) != 0 {
+			return i
+		}
+	}
+	if block == 0 {
+		return -1
+	}
+	sel := malloc(4)
+	g := getg()
+	for i := 0; i < n; i++ {
+		w := cases + 28*i
+		c := loadWord(w, 5)
+		if c != 0 {
+			storeWord(w, 0, g)
+			storeWord(w, 4, sel)
+			if loadWord(w, 6) != 0 {
+				enqueue(c, 6, w)
+			} else {
+				enqueue(c, 7, w)
+			}
+		}
+	}
+	park()
+	w := loadWord(sel, 0)
+	if loadWord(w, 6) != 0 && loadWord(w, 2) == 0 {
+		msg := "send on closed channel"
+		gopanic(msg)
+	}
+	return (w - cases) / 28
+}
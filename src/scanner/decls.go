@@ -0,0 +1,294 @@
+// This file implements the ordering of the top-level declarations of a source
+// file. The declarations are compiled in a single pass, where a name can only
+// be referred to after it has been declared, whereas the names declared at the
+// top level of a go program can be referred to anywhere in the package, e.g. a
+// package-level variable may be initialized by a call of a function declared
+// after it. The top-level declarations are thus moved after the declarations
+// of the names they refer to before the source file is parsed, which also
+// results in the package-level variables being initialized in the order of
+// their dependencies.
+
+package scanner
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/shivansh/gogo/goccgen/lexer"
+	"github.com/shivansh/gogo/goccgen/token"
+)
+
+// decl is a top-level declaration spanning the bytes src[start:end] of a source
+// file, which is preceded by its keyword when it is a specification separated
+// from a group. A method is declared (and a selector refers to a name) as
+// ".<name>".
+type decl struct {
+	keyword    string
+	start, end int
+	names      []string
+	refs       []string
+}
+
+// SortDecls returns the source of a file where each top-level declaration
+// follows those of the names it refers to, along with the line numbers in the
+// original source of the lines of the returned source. The declarations which
+// refer to one another, along with those whose order needn't change, retain
+// their relative order.
+func SortDecls(src []byte) ([]byte, []int) {
+	prefix, decls := topLevelDecls(src)
+	declaredBy := make(map[string][]int)
+	for k, d := range decls {
+		for _, name := range d.names {
+			declaredBy[name] = append(declaredBy[name], k)
+		}
+	}
+	deps := make([][]int, len(decls))
+	for k, d := range decls {
+		seen := make(map[int]bool)
+		for _, ref := range d.refs {
+			for _, v := range declaredBy[ref] {
+				if v != k && !seen[v] {
+					seen[v] = true
+					deps[k] = append(deps[k], v)
+				}
+			}
+		}
+		sort.Ints(deps[k])
+	}
+
+	out, lines := []byte{}, []int{}
+	emit := func(keyword string, start, end int) {
+		if start == end {
+			return
+		}
+		text := src[start:end]
+		out = append(append(out, keyword...), text...)
+		count := bytes.Count(text, []byte("\n"))
+		if text[len(text)-1] != '\n' {
+			out = append(out, '\n')
+			count++
+		}
+		line := bytes.Count(src[:start], []byte("\n")) + 1
+		for k := 0; k < count; k++ {
+			lines = append(lines, line+k)
+		}
+	}
+	emit("", 0, prefix)
+	for _, k := range sortComponents(components(deps), deps) {
+		emit(decls[k].keyword, decls[k].start, decls[k].end)
+	}
+	// The errors at the end of the file are reported at its last line.
+	lines = append(lines, bytes.Count(src, []byte("\n"))+1)
+	return out, lines
+}
+
+// topLevelDecls returns the top-level declarations of a source file, along with
+// the offset of the first of them. A declaration extends up to the next one, so
+// that the comments preceding a declaration belong to the previous one. The
+// specifications of a group of variables or types are separate declarations,
+// whereas those of constants depend on their order because of iota.
+func topLevelDecls(src []byte) (int, []*decl) {
+	type tok struct {
+		id, lit string
+		offset  int
+		depth   int // nesting of parentheses, braces and brackets
+	}
+	toks := []tok{}
+	s := lexer.NewLexer(src)
+	depth := 0
+	for t := s.Scan(); t.Type != token.EOF; t = s.Scan() {
+		id := token.TokMap.Id(t.Type)
+		switch id {
+		case ")", "}", "]":
+			depth--
+		}
+		toks = append(toks, tok{id, string(t.Lit), t.Pos.Offset, depth})
+		switch id {
+		case "(", "{", "[":
+			depth++
+		}
+	}
+
+	// A top-level declaration begins with a keyword at the beginning of a
+	// line, which distinguishes it from a function literal or type.
+	bounds := []int{}
+	for k, t := range toks {
+		if t.depth != 0 || k > 0 && toks[k-1].id != "terminator" {
+			continue
+		}
+		switch t.id {
+		case "kwdFunc", "kwdVar", "kwdConst", "kwdType":
+			bounds = append(bounds, k)
+		}
+	}
+	if len(bounds) == 0 {
+		return len(src), nil
+	}
+	offset := func(k int) int {
+		if k < len(toks) {
+			return toks[k].offset
+		}
+		return len(src)
+	}
+
+	// identList returns the identifiers in the list beginning at toks[k].
+	identList := func(k, end int) []string {
+		names := []string{}
+		for ; k < end && toks[k].id == "identifier"; k += 2 {
+			names = append(names, toks[k].lit)
+			if k+1 >= end || toks[k+1].id != "," {
+				break
+			}
+		}
+		return names
+	}
+	// refs returns the identifiers in toks[k:end].
+	refs := func(k, end int) []string {
+		names := []string{}
+		for ; k < end; k++ {
+			if toks[k].id != "identifier" {
+				continue
+			}
+			if k > 0 && toks[k-1].id == "." {
+				names = append(names, "."+toks[k].lit)
+			} else {
+				names = append(names, toks[k].lit)
+			}
+		}
+		return names
+	}
+
+	decls := []*decl{}
+	bounds = append(bounds, len(toks))
+	for i := 0; i+1 < len(bounds); i++ {
+		k, end := bounds[i]+1, bounds[i+1]
+		keyword := toks[bounds[i]].id
+		if (keyword == "kwdVar" || keyword == "kwdType") && k < end && toks[k].id == "(" {
+			// The specifications of a group are on separate lines.
+			specs := []int{}
+			for k++; k < end && toks[k].depth > 0; k++ {
+				if toks[k].depth == 1 && toks[k].id != "terminator" && (toks[k-1].depth == 0 || toks[k-1].id == "terminator" && toks[k-1].depth == 1) {
+					specs = append(specs, k)
+				}
+			}
+			specs = append(specs, k)
+			for j := 0; j+1 < len(specs); j++ {
+				decls = append(decls, &decl{
+					keyword: toks[bounds[i]].lit + " ",
+					start:   offset(specs[j]),
+					end:     offset(specs[j+1]),
+					names:   identList(specs[j], specs[j+1]),
+					refs:    refs(specs[j], specs[j+1]),
+				})
+			}
+			continue
+		}
+		d := &decl{
+			start: offset(bounds[i]),
+			end:   offset(end),
+			refs:  refs(k, end),
+		}
+		switch {
+		case k >= end:
+		case keyword == "kwdFunc" && toks[k].id == "(":
+			// The name of a method follows its receiver.
+			for k < end && !(toks[k].id == ")" && toks[k].depth == 0) {
+				k++
+			}
+			if k+1 < end {
+				d.names = []string{"." + toks[k+1].lit}
+			}
+		case keyword == "kwdFunc" || toks[k].id != "(":
+			d.names = identList(k, end)
+		default:
+			for k++; k < end; k++ {
+				if toks[k].depth == 1 && (toks[k-1].depth == 0 || toks[k-1].id == "terminator" && toks[k-1].depth == 1) {
+					d.names = append(d.names, identList(k, end)...)
+				}
+			}
+		}
+		decls = append(decls, d)
+	}
+	return offset(bounds[0]), decls
+}
+
+// components returns the strongly connected components of the graph in which
+// the declarations are connected to the declarations they refer to, i.e. the
+// sets of declarations which refer to one another, as the components of the
+// declarations.
+func components(deps [][]int) []int {
+	index, low := make([]int, len(deps)), make([]int, len(deps))
+	comp, onStack := make([]int, len(deps)), make([]bool, len(deps))
+	for k := range index {
+		index[k] = -1
+	}
+	stack, next, count := []int{}, 0, 0
+	var visit func(v int)
+	visit = func(v int) {
+		index[v], low[v] = next, next
+		next++
+		stack = append(stack, v)
+		onStack[v] = true
+		for _, w := range deps[v] {
+			if index[w] == -1 {
+				visit(w)
+				if low[w] < low[v] {
+					low[v] = low[w]
+				}
+			} else if onStack[w] && index[w] < low[v] {
+				low[v] = index[w]
+			}
+		}
+		if low[v] == index[v] {
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				comp[w] = count
+				if w == v {
+					break
+				}
+			}
+			count++
+		}
+	}
+	for k := range deps {
+		if index[k] == -1 {
+			visit(k)
+		}
+	}
+	return comp
+}
+
+// sortComponents returns the order of the declarations where the declarations
+// of a component follow those of the components they refer to. The components
+// are taken in the order of their first declarations, and the declarations of
+// a component retain their order.
+func sortComponents(comp []int, deps [][]int) []int {
+	members := make(map[int][]int)
+	first := []int{}
+	for k, c := range comp {
+		if _, ok := members[c]; !ok {
+			first = append(first, c)
+		}
+		members[c] = append(members[c], k)
+	}
+	order, done := []int{}, make(map[int]bool)
+	var visit func(c int)
+	visit = func(c int) {
+		if done[c] {
+			return
+		}
+		done[c] = true
+		for _, k := range members[c] {
+			for _, v := range deps[k] {
+				visit(comp[v])
+			}
+		}
+		order = append(order, members[c]...)
+	}
+	for _, c := range first {
+		visit(c)
+	}
+	return order
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"strings"
 
 	"github.com/shivansh/gogo/goccgen/lexer"
 	"github.com/shivansh/gogo/goccgen/token"
@@ -55,3 +56,51 @@ func PrintTokens(file string) {
 		fmt.Printf("\r")
 	}
 }
+
+// Imports returns the name of the package declared in a source file along with
+// the import paths of the packages it imports. Only the tokens preceding the
+// top level declarations are scanned.
+func Imports(file string) (string, []string, error) {
+	src, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", nil, err
+	}
+	s := lexer.NewLexer(src)
+	next := func() (string, string) {
+		tok := s.Scan()
+		for token.TokMap.Id(tok.Type) == "terminator" {
+			tok = s.Scan()
+		}
+		return token.TokMap.Id(tok.Type), string(tok.Lit)
+	}
+	id, name := next()
+	if id != "kwdPackage" {
+		return "", nil, fmt.Errorf("%s: expected package clause", file)
+	}
+	if _, name = next(); name == "" {
+		return "", nil, fmt.Errorf("%s: expected package name", file)
+	}
+	paths := []string{}
+	for id, _ = next(); id == "kwdImport"; id, _ = next() {
+		id, lit := next()
+		grouped := id == "("
+		if grouped {
+			id, lit = next()
+		}
+		for id != ")" {
+			if id == "identifier" {
+				// The name by which the package is referred to.
+				id, lit = next()
+			}
+			if id != "stringLit" {
+				return "", nil, fmt.Errorf("%s: malformed import declaration", file)
+			}
+			paths = append(paths, strings.Trim(lit, "\"`"))
+			if !grouped {
+				break
+			}
+			id, lit = next()
+		}
+	}
+	return name, paths, nil
+}
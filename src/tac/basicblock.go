@@ -5,6 +5,8 @@ package tac
 import (
 	"container/heap"
 	"strconv"
+
+	"github.com/shivansh/gogo/src/types"
 )
 
 // Blk represents the structure of a basic block.
@@ -16,7 +18,7 @@ type Blk struct {
 	//	* The location can be either one or a set of -
 	//		- register
 	//		- memory address
-	//		- stack (TODO)
+	//		- stack
 	Adesc map[string]Addr
 	// Register descriptor
 	//	* Keeps track of what is currently in each register.
@@ -24,9 +26,15 @@ type Blk struct {
 	Rdesc      map[int]RegDesc
 	NextUseTab [][]UseInfo
 	Pq         PriorityQueue
-	child      Child
-	pred       map[int]bool // immediate predecessors of the basic block
-	dataflow   DataFlowInfo
+	// Fpq is the priority queue of the floating-point registers, which
+	// are allocated separately from the general purpose registers.
+	Fpq PriorityQueue
+	// Frame is the activation record of the function the basic block
+	// belongs to. It is nil for the blocks containing global declarations.
+	Frame    *Frame
+	child    Child
+	pred     map[int]bool // immediate predecessors of the basic block
+	dataflow DataFlowInfo
 }
 
 // Child determines the index of the left and right child of a basic block in a
@@ -80,21 +88,29 @@ func (tac Tac) LabelInfo() labelinfotype {
 				}
 			}
 
-			// If the basic block contains only a jump statement, it
-			// **might** be dropped in case another block references
-			// it. Only if it is preceded by an unconditional jump,
-			// it will be **definite** that the block can be dropped.
+			// If the basic block contains only an unconditional jump
+			// statement, it **might** be dropped in case another
+			// block references it. Only if it is preceded by an
+			// unconditional jump, it will be **definite** that the
+			// block can be dropped. A block ending in a conditional
+			// branch cannot be dropped as it can also fall through.
 			canDrop := FALSE
-			if len(blk.Stmts) == 2 && IsBranchOp(blk.Stmts[1].Op) {
+			if len(blk.Stmts) == 2 && blk.Stmts[1].Op == JMP {
 				canDrop = MAYBE
 			}
 
 			// Avoid dropping fallthrough labels. Check if the last
-			// statement of the previous block is a jump/branch.
-			if blkIndex >= 1 {
-				prevBlk := tac[blkIndex-1]
+			// statement of the previous block is a jump, where the
+			// empty blocks left behind by the elimination of jumps
+			// over jumps are skipped.
+			prevIndex := blkIndex - 1
+			for prevIndex >= 0 && len(tac[prevIndex].Stmts) == 0 {
+				prevIndex--
+			}
+			if prevIndex >= 0 {
+				prevBlk := tac[prevIndex]
 				index := len(prevBlk.Stmts) - 1
-				if !IsBranchOp(prevBlk.Stmts[index].Op) {
+				if prevBlk.Stmts[index].Op != JMP {
 					// The block is not preceded by an
 					// unconditional jump, hence cannot be
 					// dropped in any case.
@@ -145,6 +161,7 @@ func (blk *Blk) InitRegDS() {
 	blk.Rdesc = make(map[int]RegDesc)
 	blk.Adesc = make(map[string]Addr)
 	blk.Pq = make(PriorityQueue, RegLimit)
+	blk.Fpq = make(PriorityQueue, RegLimit)
 	blk.NextUseTab = make([][]UseInfo, len(blk.Stmts), len(blk.Stmts))
 }
 
@@ -156,14 +173,21 @@ func (blk *Blk) InitHeap() {
 	// instructions and hence is not assigned to variables.
 	for i := 0; i < RegLimit; i++ {
 		switch i {
-		case 0, 1, 2, 4, 29, 31:
+		case 0, 1, 2, 3, 4, 24, 25, 26, 27, 28, 29, 30, 31:
 			// The following registers are not allocated -
 			//   * $0 is not a valid register.
 			//   * $1 is reserved by the assembler for
-			//   * $2 ($v0) stores function results.
 			//     pseudo instructions.
+			//   * $2 ($v0) and $3 ($v1) store function
+			//     results, and $3 also passes the closure
+			//     of a function value.
 			//   * $v0 and $a0 are special registers.
+			//   * $24 ($t8) and $25 ($t9) are scratch registers
+			//     used while generating code for a statement.
+			//   * $26 ($k0) and $27 ($k1) are reserved for the
+			//     kernel and $28 ($gp) is the global pointer.
 			//   * $29 ($sp) stores the stack pointer.
+			//   * $30 ($fp) stores the frame pointer.
 			//   * $31 ($ra) stores the return address.
 			// The nextuse of these registers is set to -∞.
 			blk.Pq[i] = &UseInfo{
@@ -184,6 +208,46 @@ func (blk *Blk) InitHeap() {
 		}
 	}
 	heap.Init(&blk.Pq)
+
+	for i := 0; i < RegLimit; i++ {
+		// A double precision value occupies a pair of registers
+		// ($fn, $fn+1), hence only the even numbered registers are
+		// allocated. $f0 and $f2 are scratch registers used while
+		// generating code for a statement and $f12 holds the value
+		// printed by a syscall.
+		nextuse := MaxInt - i
+		switch {
+		case i%2 == 1, i == 0, i == 2, i == 12:
+			nextuse = MinInt
+		}
+		blk.Fpq[i] = &UseInfo{
+			Name:    strconv.Itoa(RegLimit + i),
+			Nextuse: nextuse,
+		}
+	}
+	heap.Init(&blk.Fpq)
+}
+
+// queue returns the priority queue from which a register is allocated to a
+// variable of the given type.
+func (blk *Blk) queue(t types.RegType) *PriorityQueue {
+	if IsFloat(t) {
+		return &blk.Fpq
+	}
+	return &blk.Pq
+}
+
+// ResetRegs empties the register and address descriptors, effectively marking
+// all the registers free. This is required after a function call, since the
+// callee is free to overwrite any of the allocatable registers.
+func (blk *Blk) ResetRegs() {
+	for k := range blk.Rdesc {
+		delete(blk.Rdesc, k)
+	}
+	for k := range blk.Adesc {
+		delete(blk.Adesc, k)
+	}
+	blk.InitHeap()
 }
 
 // MarkDirty marks the given register as dirty. A register whose contents have
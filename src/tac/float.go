@@ -0,0 +1,134 @@
+// This file implements the support for floating-point values, which reside in
+// the registers of the floating-point coprocessor ($f0-$f31).
+
+package tac
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shivansh/gogo/src/types"
+)
+
+// The floating-point registers are numbered after the general purpose
+// registers in the register descriptors, i.e. $fn is numbered RegLimit+n.
+
+// SplitOp splits an operator into its base operator and the precision of its
+// floating-point operands, which is empty for the remaining operators. The
+// precision of a conversion is of the form "to.from", e.g. "d.w".
+func SplitOp(op string) (string, string) {
+	if i := strings.Index(op, "."); i > 0 {
+		return op[:i], op[i+1:]
+	}
+	return op, ""
+}
+
+// precType returns the type of the values of the given precision.
+func precType(prec string) types.RegType {
+	switch prec {
+	case "s":
+		return types.FLT
+	case "d":
+		return types.DBL
+	}
+	return types.INT
+}
+
+// IsFloat determines whether the given type is a floating-point type.
+func IsFloat(t types.RegType) bool {
+	return t == types.FLT || t == types.DBL
+}
+
+// EvalTypes determines the types of the floating-point variables from the
+// precision of the operators which define or use them.
+func (tac Tac) EvalTypes() map[string]types.RegType {
+	typeInfo := make(map[string]types.RegType)
+	mark := func(name string, t types.RegType) {
+		if t != types.INT {
+			typeInfo[name] = t
+		}
+	}
+	for _, blk := range tac {
+		for _, stmt := range blk.Stmts {
+			switch stmt.Op {
+			case PRINTFLOAT:
+				mark(stmt.Dst, types.FLT)
+				continue
+			case PRINTDOUBLE:
+				mark(stmt.Dst, types.DBL)
+				continue
+			}
+			op, prec := SplitOp(stmt.Op)
+			if prec == "" {
+				continue
+			}
+			dstType, srcType := precType(prec), precType(prec)
+			switch op {
+			case CVT:
+				to, from := SplitOp(prec)
+				dstType, srcType = precType(to), precType(from)
+			case CLT, CLE, CEQ, CNE, CGT, CGE:
+				// A comparison evaluates to a boolean.
+				dstType = types.INT
+			case FROM:
+				// The value is loaded from the given word offset
+				// from an address.
+				srcType = types.INT
+			case INTO:
+				// The destination holds the address, whereas
+				// the value being stored is the last source.
+				dstType = types.INT
+				mark(stmt.Src[len(stmt.Src)-1].StrVal(), srcType)
+				continue
+			}
+			mark(stmt.Dst, dstType)
+			for _, v := range stmt.Src {
+				if v, ok := v.(Str); ok {
+					mark(v.StrVal(), srcType)
+				}
+			}
+		}
+	}
+	return typeInfo
+}
+
+// RegName returns the assembly name of a register.
+func RegName(reg int) string {
+	if reg >= RegLimit {
+		return fmt.Sprintf("$f%d", reg-RegLimit)
+	}
+	return fmt.Sprintf("$%d", reg)
+}
+
+// LoadOp returns the instruction which loads a value of the given type from
+// memory into a register.
+func LoadOp(t types.RegType) string {
+	switch t {
+	case types.FLT:
+		return "lwc1"
+	case types.DBL:
+		return "l.d"
+	}
+	return "lw"
+}
+
+// StoreOp returns the instruction which stores a register holding a value of
+// the given type into memory.
+func StoreOp(t types.RegType) string {
+	switch t {
+	case types.FLT:
+		return "swc1"
+	case types.DBL:
+		return "s.d"
+	}
+	return "sw"
+}
+
+// SizeOf returns the number of bytes occupied by a value of the given type in
+// memory.
+func SizeOf(t types.RegType) int {
+	if t == types.DBL {
+		return 2 * WordSize
+	}
+	return WordSize
+}
@@ -0,0 +1,165 @@
+// This file implements the activation records (stack frames) of functions.
+
+package tac
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/shivansh/gogo/src/types"
+)
+
+// WordSize is the size (in bytes) of a single frame slot.
+const WordSize = 4
+
+// Frame represents the activation record of a function. The layout of a frame
+// for a function with n parameters is as follows -
+//
+//	+-----------------------+
+//	|     parameter 0       |  8+4(n-1)($fp)
+//	|         ...           |
+//	|     parameter n-1     |  8($fp)
+//	+-----------------------+
+//	|    return address     |  4($fp)
+//	|   caller's $fp        |  0($fp)
+//	+-----------------------+
+//	| locals and temporaries|  -4($fp), -8($fp), ...
+//	+-----------------------+  <- $sp
+//
+// The parameters are pushed by the caller in order, hence the last parameter
+// is the nearest to the frame pointer. A double precision value occupies two
+// slots.
+type Frame struct {
+	Name string
+	// Offset maps the name of a local variable, temporary or parameter to
+	// its $fp-relative offset.
+	Offset map[string]int
+	// Size determines the number of bytes occupied by the locals and
+	// temporaries in the frame.
+	Size   int
+	Params []string
+}
+
+// Addr returns the memory operand corresponding to a variable. Variables which
+// have a slot in the frame are addressed relative to $fp, whereas the rest of
+// them (globals) are addressed using their labels in the data section.
+func (f *Frame) Addr(name string) string {
+	if f != nil {
+		if off, ok := f.Offset[name]; ok {
+			return fmt.Sprintf("%d($fp)", off)
+		}
+	}
+	return name
+}
+
+// IsLocal determines whether the variable has a slot in the frame.
+func (f *Frame) IsLocal(name string) bool {
+	if f == nil {
+		return false
+	}
+	_, ok := f.Offset[name]
+	return ok
+}
+
+// EvalFrames computes the frame layout of all the functions in the three-address
+// code. A variable which is referenced only within a single function gets a
+// slot in that function's frame. The variables which are referenced at the top
+// level or from more than one function are treated as globals and are placed
+// in the data section. The types of the variables determine the sizes of their
+// slots.
+func (tac Tac) EvalFrames(typeInfo map[string]types.RegType) map[string]*Frame {
+	frames := make(map[string]*Frame)
+	re := regexp.MustCompile("(^-?[0-9]+$)") // regex for integers
+	// users keeps track of the functions referencing a variable. Top level
+	// references are denoted by an empty function name.
+	users := make(map[string]map[string]bool)
+	// order keeps track of the order in which the variables are referenced
+	// in a function so that the frame layout is deterministic.
+	order := make(map[string][]string)
+	// size keeps track of the number of bytes required by arrays.
+	size := make(map[string]int)
+
+	use := func(fn, name string) {
+		if name == "" || re.MatchString(name) {
+			return
+		}
+		if users[name] == nil {
+			users[name] = make(map[string]bool)
+		}
+		if !users[name][fn] {
+			users[name][fn] = true
+			order[fn] = append(order[fn], name)
+		}
+	}
+
+	funcName := ""
+	funcScope := false
+	for _, blk := range tac {
+		for _, stmt := range blk.Stmts {
+			switch stmt.Op {
+			case FUNC:
+				funcName = stmt.Dst
+				funcScope = true
+				frames[funcName] = &Frame{funcName, make(map[string]int), 0, []string{}}
+				continue
+			case LABEL:
+				funcScope = true
+				continue
+			case CMT, JMP, PRINTSTR:
+				continue
+			}
+			fn := funcName
+			if !funcScope {
+				fn = ""
+			}
+			switch op, _ := SplitOp(stmt.Op); op {
+			case PARAM:
+				frames[funcName].Params = append(frames[funcName].Params, stmt.Dst)
+				use(fn, stmt.Dst)
+			case CALL:
+				// The destination is the name of the callee.
+			case ADDR:
+				// The source is the name of a function.
+				use(fn, stmt.Dst)
+				continue
+			case DECL:
+				size[stmt.Dst] = WordSize * stmt.Src[0].IntVal()
+				use(fn, stmt.Dst)
+			case BGT, BGE, BLT, BLE, BEQ, BNE, FORK, SWAP:
+				// The destination is a label.
+			default:
+				use(fn, stmt.Dst)
+			}
+			if stmt.Op != DECL {
+				for _, v := range stmt.Src {
+					if v, ok := v.(Str); ok {
+						use(fn, v.StrVal())
+					}
+				}
+			}
+			if stmt.Op == RET {
+				funcScope = false
+			}
+		}
+	}
+
+	for fn, frame := range frames {
+		offset := 2 * WordSize
+		for k := len(frame.Params) - 1; k >= 0; k-- {
+			frame.Offset[frame.Params[k]] = offset
+			offset += SizeOf(typeInfo[frame.Params[k]])
+		}
+		for _, v := range order[fn] {
+			if _, ok := frame.Offset[v]; ok || len(users[v]) != 1 {
+				continue
+			}
+			if s, ok := size[v]; ok {
+				frame.Size += s
+			} else {
+				frame.Size += SizeOf(typeInfo[v])
+			}
+			frame.Offset[v] = -frame.Size
+		}
+	}
+	return frames
+}
@@ -56,6 +56,12 @@ func (blk Blk) EvalNextUseInfo() {
 		for _, v := range blk.Stmts[i].Src {
 			nuSymTab[v.StrVal()] = i
 		}
+		switch blk.Stmts[i].Op {
+		case ARG, RET, EXIT, CALLR, PRINTSTR, PRINTCHAR, PRINTFLOAT, PRINTDOUBLE:
+			// The destination variable is used and not defined by
+			// these statements.
+			nuSymTab[s[0]] = i
+		}
 	}
 }
 
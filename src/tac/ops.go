@@ -21,8 +21,10 @@ const (
 	JMP = "jmp"
 
 	// array operators
-	FROM = "from"
-	INTO = "into"
+	FROM  = "from"
+	FROMB = "fromb" // loads a byte, the index being a byte offset
+	INTO  = "into"
+	INTOB = "intob" // stores a byte, the index being a byte offset
 
 	// binary operators
 	OR  = "or"
@@ -40,17 +42,43 @@ const (
 	LABEL = "label"
 	RET   = "ret"
 	CALL  = "call"
+	CALLR = "callr" // calls the function whose closure is held in a variable
 	STORE = "store"
+	ARG   = "arg"   // pushes an argument before a call
+	PARAM = "param" // declares a parameter of the enclosing function
+	ADDR  = "addr"  // loads the address of a function, a global or a label
 
 	CMT = "#" // comments
 
+	// runtime operators
+	SBRK = "sbrk" // allocates memory from the operating system
+	EXIT = "exit" // terminates the program with an exit status
+	FORK = "fork" // copies the current frame onto the stack of a goroutine
+	SWAP = "swap" // suspends a goroutine and resumes another one
+	FP   = "fp"   // loads the frame pointer of the enclosing function
+
 	// declaration operators
 	DECL    = "decl"
 	DECLInt = "declInt"
 	DECLSTR = "declStr"
 
+	// floating-point operators
+	// The operators defined on floating-point operands are suffixed by
+	// their precision, i.e. ".s" (single) or ".d" (double), as in "+.d".
+	NEG = "neg"
+	CVT = "cvt" // converts a value, as in "cvt.d.w" (int -> double)
+	CLT = "<"
+	CLE = "<="
+	CEQ = "=="
+	CNE = "!="
+	CGT = ">"
+	CGE = ">="
+
 	// I/O operators
-	SCANINT  = "scanInt"
-	PRINTINT = "printInt"
-	PRINTSTR = "printStr"
+	SCANINT     = "scanInt"
+	PRINTINT    = "printInt"
+	PRINTSTR    = "printStr"
+	PRINTCHAR   = "printChar"
+	PRINTFLOAT  = "printFloat"
+	PRINTDOUBLE = "printDouble"
 )
@@ -48,10 +48,18 @@ func (tac Tac) JumpsOverJumps() Tac {
 				foundBEQ = true
 			case JMP:
 				if foundBEQ {
+					index := len(tac[k-1].Stmts) - 1
+					target := tac[k-1].Stmts[index].Dst
+					// The sequence is only valid when the
+					// branch jumps over the jump statement.
+					if k+1 == len(tac) || len(tac[k+1].Stmts) == 0 ||
+						tac[k+1].Stmts[0].Op != LABEL || tac[k+1].Stmts[0].Dst != target {
+						foundBEQ = false
+						continue
+					}
 					// Valid sequence encountered. Update the
 					// instruction and jump target of the
 					// last statement in the previous block.
-					index := len(tac[k-1].Stmts) - 1
 					branchOp := tac[k-1].Stmts[index].Op
 					switch branchOp {
 					case BNE:
@@ -66,10 +74,11 @@ func (tac Tac) JumpsOverJumps() Tac {
 					// Replace the jump instruction in the
 					// current basic block by all the
 					// instructions from the next block. The
-					// first and last statements, namely label
-					// and jump respectively are not copied.
-					if len(tac[k+1].Stmts) > 0 {
-						tac[k].Stmts = tac[k+1].Stmts[1:]
+					// label is not copied, unless it is
+					// referenced by other branches.
+					tac[k].Stmts = tac[k+1].Stmts[1:]
+					if tac.IsReferenced(target) {
+						tac[k].Stmts = tac[k+1].Stmts
 					}
 
 					// Drop the next basic block.
@@ -147,18 +156,9 @@ func (tac Tac) ControlFlow() Tac {
 		}
 	}
 
-	// The blocks are supposed be dropped in asceding order of their
-	// occurrence to enable proper index calculations while updating the TAC
-	// data structure.
-	keys := []int{}
-	for k := range dropIndices {
-		keys = append(keys, k)
-	}
-	sort.Ints(keys)
-
-	// The blocks which reference the to-be-dropped blocks should be updated
-	// to point to the corresponding next valid block (which won't be
-	// dropped).
+	// The branches to the to-be-dropped blocks should be updated to point
+	// to the corresponding next valid block (which won't be dropped). Every
+	// such block consists of a label followed by a jump.
 	// Consider the code -
 	//
 	//	L1: a = 0
@@ -171,40 +171,42 @@ func (tac Tac) ControlFlow() Tac {
 	//	L1: a = 0
 	//	    goto L3
 	//	L3: a = 1
-	for i := 0; i < len(keys); i++ {
-		blk := tac[keys[i]]
-		index := len(blk.Stmts) - 1
-		nextBlk := 0
-		toBeDropped := TRUE
-		// Keep following the references to the next blocks until we find
-		// one which will not be dropped.
-		for toBeDropped != FALSE {
-			if IsBranchOp(blk.Stmts[index].Op) {
-				// Follow the destination of jump/branch
-				// statement.
-				nextBlk = labelMap[blk.Stmts[index].Dst].index
-				toBeDropped = labelMap[tac[nextBlk].Stmts[0].Dst].canDrop
-			} else {
-				// Fallthough block is the next valid block.
-				// TODO: Check if nextBlk can reach past the
-				// boundary of the tac data structure.
-				nextBlk = keys[i] + 1
-				toBeDropped = labelMap[blk.Stmts[nextBlk].Dst].canDrop
-			}
+	targets := make(map[string]string)
+	for i := range dropIndices {
+		label := tac[i].Stmts[0].Dst
+		dst := tac[i].Stmts[1].Dst
+		// Keep following the jumps until we find a block which will
+		// not be dropped. The blocks jumping to each other in a cycle
+		// are retained.
+		seen := map[string]bool{label: true}
+		for labelMap[dst] != nil && labelMap[dst].canDrop == TRUE && !seen[dst] {
+			seen[dst] = true
+			dst = tac[labelMap[dst].index].Stmts[1].Dst
 		}
-		// Update the next valid blocks for all the ones referencing the
-		// current block.
-		for _, prevBlk := range labelMap[blk.Stmts[0].Dst].inRef {
-			index = len(tac[prevBlk].Stmts) - 1
-			if IsBranchOp(tac[prevBlk].Stmts[index].Op) {
-				if _, ok := labelMap[tac[nextBlk].Stmts[0].Dst]; ok {
-					tac[prevBlk].Stmts[index].Dst = tac[nextBlk].Stmts[0].Dst
-				}
+		if seen[dst] {
+			continue
+		}
+		targets[label] = dst
+	}
+	for _, blk := range tac {
+		for k, stmt := range blk.Stmts {
+			if dst, ok := targets[stmt.Dst]; ok && IsBranchOp(stmt.Op) {
+				blk.Stmts[k].Dst = dst
 			}
 		}
-		// Else the next valid block will be reached via a fallthrough.
 	}
 
+	// The blocks are supposed be dropped in asceding order of their
+	// occurrence to enable proper index calculations while updating the TAC
+	// data structure.
+	keys := []int{}
+	for k := range dropIndices {
+		if _, ok := targets[tac[k].Stmts[0].Dst]; ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Ints(keys)
+
 	// Drop the blocks, and update the tac data structure accordingly.
 	dropCount := 0
 	for _, i := range keys {
@@ -215,6 +217,18 @@ func (tac Tac) ControlFlow() Tac {
 	return tac
 }
 
+// IsReferenced determines whether a label is the target of any jump/branch.
+func (tac Tac) IsReferenced(label string) bool {
+	for _, blk := range tac {
+		for _, stmt := range blk.Stmts {
+			if IsBranchOp(stmt.Op) && stmt.Dst == label {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // IsBranchOp verifies whether the given operator is jump/branch.
 func IsBranchOp(op string) bool {
 	switch op {
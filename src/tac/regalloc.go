@@ -57,6 +57,12 @@ func (blk Blk) GetReg(stmt *Stmt, ts *TextSec, typeInfo map[string]types.RegType
 	switch stmt.Op {
 	case BGT, BGE, BLT, BLE, BEQ, BNE, JMP:
 		lenSource = len(srcVars) + 1
+	case ARG, EXIT, CALLR, PRINTFLOAT, PRINTDOUBLE:
+		// The destination of an argument (exit status, indirect call,
+		// print statement) is the value being pushed (returned, called,
+		// printed), hence it is treated as a source variable.
+		srcVars = append(srcVars, stmt.Dst)
+		lenSource = len(srcVars) + 1
 	default:
 		srcVars = append(srcVars, stmt.Dst)
 		lenSource = len(srcVars)
@@ -65,18 +71,21 @@ func (blk Blk) GetReg(stmt *Stmt, ts *TextSec, typeInfo map[string]types.RegType
 	for k, v := range srcVars {
 		if _, hasReg := blk.Adesc[v]; !hasReg {
 			// Element with next-use farthest in future is popped.
-			item := heap.Pop(&blk.Pq).(*UseInfo)
+			// The floating-point variables are allocated from the
+			// pool of floating-point registers.
+			item := heap.Pop(blk.queue(typeInfo[v])).(*UseInfo)
 			reg, err := strconv.Atoi(item.Name)
 			if err != nil {
 				log.Fatal(err)
 			}
 			if entry, ok := blk.Rdesc[reg]; ok && typeInfo[entry.Name] != types.ARR && entry.Dirty {
-				comment := fmt.Sprintf("# spilled %s, freed $%s", blk.Rdesc[reg].Name, item.Name)
+				comment := fmt.Sprintf("# spilled %s, freed %s", entry.Name, RegName(reg))
 				tab := "\t\t" // indentation for in-line comments
-				if len(blk.Rdesc[reg].Name) > 3 {
+				if len(entry.Name) > 3 {
 					tab = "\t"
 				}
-				fmt.Fprintf(&ts.Stmts, "\tsw\t$%s, %s\n", item.Name, blk.Rdesc[reg].Name+tab+comment)
+				fmt.Fprintf(&ts.Stmts, "\t%s\t%s, %s\n", StoreOp(typeInfo[entry.Name]), RegName(reg),
+					blk.Frame.Addr(entry.Name)+tab+comment)
 			}
 			allocReg = append(allocReg, &UseInfo{strconv.Itoa(reg), blk.FindNextUse(stmt.Line, v)})
 			delete(blk.Adesc, blk.Rdesc[reg].Name)
@@ -86,15 +95,17 @@ func (blk Blk) GetReg(stmt *Stmt, ts *TextSec, typeInfo map[string]types.RegType
 			blk.Adesc[v] = Addr{reg, blk.Adesc[v].Mem}
 			// Load the variable from memory.
 			if k < lenSource-1 {
+				// The value of an array is its address.
 				if typeInfo[v] == types.ARR {
-					fmt.Fprintf(&ts.Stmts, "\tla\t$%d, %s\n", reg, v)
+					fmt.Fprintf(&ts.Stmts, "\tla\t$%d, %s\n", reg, blk.Frame.Addr(v))
 				} else {
 					tab := "\t\t" // indentation for in-line comments
 					if len(v) > 3 {
 						tab = "\t"
 					}
-					comment := fmt.Sprintf("# %s -> $%d", v, reg)
-					fmt.Fprintf(&ts.Stmts, "\tlw\t$%d, %s%s\n", reg, v, tab+comment)
+					comment := fmt.Sprintf("# %s -> %s", v, RegName(reg))
+					fmt.Fprintf(&ts.Stmts, "\t%s\t%s, %s%s\n", LoadOp(typeInfo[v]), RegName(reg),
+						blk.Frame.Addr(v), tab+comment)
 					blk.MarkLoaded(reg)
 				}
 			}
@@ -103,7 +114,11 @@ func (blk Blk) GetReg(stmt *Stmt, ts *TextSec, typeInfo map[string]types.RegType
 
 	// Push the popped items with updated priorities back into heap.
 	for _, v := range allocReg {
-		heap.Push(&blk.Pq, v)
+		if reg, _ := strconv.Atoi(v.Name); reg >= RegLimit {
+			heap.Push(&blk.Fpq, v)
+		} else {
+			heap.Push(&blk.Pq, v)
+		}
 	}
 
 	// Check if any src variable is without a register. If there is, then
@@ -12,6 +12,8 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/shivansh/gogo/src/utils"
 )
 
 type Addr struct {
@@ -72,7 +74,8 @@ type Tac []Blk
 //		* at jump instruction
 func GenTAC(file string) (tac Tac) {
 	blk, line := InitBlock(), 0
-	re := regexp.MustCompile("(^-?[0-9]+$)") // regex for integers
+	re := regexp.MustCompile("(^-?[0-9]+$)")             // regex for integers
+	floatRe := regexp.MustCompile("(^-?[0-9]+\\.[0-9]+$)") // regex for floating-point constants
 	startNewBlock := false
 
 	f, err := os.Open(file)
@@ -81,7 +84,7 @@ func GenTAC(file string) (tac Tac) {
 	}
 	scanner := bufio.NewScanner(f)
 	for scanner.Scan() {
-		record := strings.Split(scanner.Text(), ",")
+		record := utils.Split(scanner.Text(), ",")
 		// Sanitize the records.
 		for i := 0; i < len(record); i++ {
 			record[i] = strings.TrimSpace(record[i])
@@ -110,6 +113,12 @@ func GenTAC(file string) (tac Tac) {
 						log.Fatal(err)
 					}
 					sv = append(sv, I32(v))
+				} else if floatRe.MatchString(record[i]) {
+					v, err := strconv.ParseFloat(record[i], 64)
+					if err != nil {
+						log.Fatal(err)
+					}
+					sv = append(sv, F64(v))
 				} else {
 					sv = append(sv, Str(record[i]))
 				}
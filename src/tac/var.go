@@ -6,10 +6,12 @@ package tac
 import (
 	"log"
 	"strconv"
+	"strings"
 )
 
 type (
 	I32 int
+	F64 float64
 	Str string
 )
 
@@ -27,6 +29,20 @@ func (U I32) StrVal() string {
 	return strconv.Itoa(U.IntVal())
 }
 
+func (U F64) IntVal() int {
+	return int(U)
+}
+
+// StrVal returns the decimal representation of a floating-point constant,
+// which always contains a decimal point.
+func (U F64) StrVal() string {
+	s := strconv.FormatFloat(float64(U), 'f', -1, 64)
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+	return s
+}
+
 func (U Str) IntVal() (i int) {
 	i, err := strconv.Atoi(U.StrVal())
 	if err != nil {
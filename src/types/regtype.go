@@ -10,6 +10,7 @@ type RegType int
 const (
 	NIL RegType = iota
 	INT
-	STR
 	ARR
+	FLT // single precision floating-point
+	DBL // double precision floating-point
 )
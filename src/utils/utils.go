@@ -7,11 +7,32 @@ import (
 	"strings"
 )
 
+// Split slices a string into the substrings separated by a separator. Unlike
+// strings.Split, the separators occurring within a double-quoted string literal
+// are not considered, as string literals can appear in the IR.
+func Split(str string, sep string) []string {
+	retVal := []string{}
+	start, quoted := 0, false
+	for i := 0; i < len(str); i++ {
+		switch {
+		case str[i] == '\\' && quoted:
+			i++ // skip the escaped character
+		case str[i] == '"':
+			quoted = !quoted
+		case !quoted && strings.HasPrefix(str[i:], sep):
+			retVal = append(retVal, str[start:i])
+			start = i + len(sep)
+			i += len(sep) - 1
+		}
+	}
+	return append(retVal, str[start:])
+}
+
 // SplitAndSanitize creates a slice after splitting a string at a separator. The
 // entries in resulting slice are trimmed of any whitespace and the resulting
 // entries which are empty are removed (originally containing only whitspaces).
 func SplitAndSanitize(str string, sep string) (retVal []string) {
-	for _, v := range strings.Split(str, sep) {
+	for _, v := range Split(str, sep) {
 		entry := strings.TrimSpace(v)
 		if entry != "" {
 			retVal = append(retVal, entry)
@@ -45,3 +66,13 @@ func Tac(s string) []string {
 	}
 	return record
 }
+
+// Contains determines whether a slice of strings contains the given string.
+func Contains(slice []string, s string) bool {
+	for _, v := range slice {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -1,62 +1,5873 @@
 	.data
-a.0:		.space	8
-t0:		.word	0
-t1:		.word	0
-t2:		.word	0
-t3:		.word	0
+runtime.functab:	.word	main
+	.word	main, runtime.name.main
+	.word	runtime.etext, 0
+runtime.name.main:	.asciiz "main.main"
 
 	.text
+	.data
+heapPtr.runtime.0:	.word	0
+heapEnd.runtime.1:	.word	0
+yes.runtime.55.str:	.asciiz "true"
+no.runtime.56.str:	.asciiz "false"
+nilValue.runtime.58.str:	.asciiz "<nil>"
+empty.runtime.59.str:	.asciiz ""
+curg.runtime.73:	.word	0
+runqhead.runtime.74:	.word	0
+runqtail.runtime.75:	.word	0
+goidgen.runtime.76:	.word	0
+msg.runtime.81.str:	.asciiz "fatal error: all goroutines are asleep - deadlock!\n"
+header.runtime.88.str:	.asciiz "\ngoroutine "
+running.runtime.89.str:	.asciiz " [running]:\n"
+call.runtime.90.str:	.asciiz "()\n"
+createdBy.runtime.91.str:	.asciiz "created by "
+newline.runtime.92.str:	.asciiz "\n"
+prefix.runtime.102.str:	.asciiz "panic: "
+newline.runtime.103.str:	.asciiz "\n"
+msg.runtime.123.str:	.asciiz "assignment to entry in nil map"
+msg.runtime.162.str:	.asciiz "runtime error: index out of range ["
+withLen.runtime.163.str:	.asciiz "] with length "
+msg.runtime.164.str:	.asciiz "runtime error: slice bounds out of range"
+msg.runtime.165.str:	.asciiz "runtime error: makeslice: len out of range"
+msg.runtime.166.str:	.asciiz "runtime error: integer divide by zero"
+msg.runtime.167.str:	.asciiz "runtime error: invalid memory address or nil pointer dereference"
+msg.runtime.170.str:	.asciiz "makechan: size out of range"
+msg.runtime.184.str:	.asciiz "send on closed channel"
+msg.runtime.199.str:	.asciiz "send on closed channel"
+msg.runtime.203.str:	.asciiz "close of nil channel"
+msg.runtime.204.str:	.asciiz "close of closed channel"
+msg.runtime.218.str:	.asciiz "send on closed channel"
+
+	.text
+
+runtime.malloc:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -44
+	lw	$5, 8($fp)	# size.runtime.2 -> $5
+	addi	$6, $5, 3
+	and	$5, $6, -4
+	move	$7, $5		# size.runtime.2 -> $7
+	sw	$7, 8($fp)	# spilled size.runtime.2, freed $7
+	lw	$7, heapEnd.runtime.1	# heapEnd.runtime.1 -> $7
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+	sw	$6, -4($fp)
+	bne	$7, 0, runtime.l0
+
+	li	$5, 1		# t2 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+	j	runtime.l1
+
+runtime.l0:
+	li	$5, 0		# t2 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+
+runtime.l1:
+	lw	$5, -12($fp)		# t2 -> $5
+	beq	$5, 1, runtime.l5
+
+	lw	$5, heapPtr.runtime.0	# heapPtr.runtime.0 -> $5
+	lw	$6, 8($fp)	# size.runtime.2 -> $6
+	add	$7, $5, $6
+	lw	$5, heapEnd.runtime.1	# heapEnd.runtime.1 -> $5
+	# Store dirty variables back into memory
+	sw	$7, -16($fp)
+	ble	$7, $5, runtime.l2
+
+	li	$5, 1		# t4 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	j	runtime.l3
+
+runtime.l2:
+	li	$5, 0		# t4 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+
+runtime.l3:
+	lw	$5, -20($fp)		# t4 -> $5
+	beq	$5, 1, runtime.l5
+
+	li	$5, 0		# t5 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+	j	runtime.l4
+
+runtime.l5:
+	li	$5, 1		# t5 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+
+runtime.l4:
+	lw	$5, -24($fp)		# t5 -> $5
+	blt	$5, 1, runtime.l10
+
+	li	$5, 4096		# n.runtime.3 -> $5
+	lw	$6, 8($fp)	# size.runtime.2 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -28($fp)
+	ble	$6, $5, runtime.l6
+
+	li	$5, 1		# t6 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+	j	runtime.l7
+
+runtime.l6:
+	li	$5, 0		# t6 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+
+runtime.l7:
+	lw	$5, -32($fp)		# t6 -> $5
+	blt	$5, 1, runtime.l8
+
+	lw	$5, 8($fp)	# size.runtime.2 -> $5
+	move	$6, $5		# n.runtime.3 -> $6
+	# Store dirty variables back into memory
+	sw	$6, -28($fp)
+
+runtime.l8:
+	lw	$5, -28($fp)	# n.runtime.3 -> $5
+	move	$4, $5
+	li	$2, 9
+	syscall
+	move	$6, $2
+	move	$7, $6		# heapPtr.runtime.0 -> $7
+	add	$8, $7, $5
+	move	$9, $8		# heapEnd.runtime.1 -> $9
+	# Store dirty variables back into memory
+	sw	$6, -36($fp)
+	sw	$7, heapPtr.runtime.0
+	sw	$8, -40($fp)
+	sw	$9, heapEnd.runtime.1
+
+runtime.l10:
+	lw	$5, heapPtr.runtime.0	# heapPtr.runtime.0 -> $5
+	move	$6, $5		# p.runtime.4 -> $6
+	lw	$7, 8($fp)	# size.runtime.2 -> $7
+	add	$5, $5, $7
+	# Store dirty variables back into memory
+	sw	$5, heapPtr.runtime.0
+	sw	$6, -44($fp)
+	move	$2, $6
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.malloc
+runtime.growslice:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -140
+	lw	$5, 16($fp)	# s.runtime.5 -> $5
+	lw	$6, 8($5)	# variable <- array
+	lw	$5, 12($fp)	# n.runtime.6 -> $5
+	# Store dirty variables back into memory
+	sw	$6, -4($fp)
+	bgt	$5, $6, runtime.l12
+
+	li	$5, 1		# t10 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+	j	runtime.l13
+
+runtime.l12:
+	li	$5, 0		# t10 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+
+runtime.l13:
+	lw	$5, -8($fp)		# t10 -> $5
+	blt	$5, 1, runtime.l16
+
+	lw	$5, 16($fp)	# s.runtime.5 -> $5
+	lw	$6, 0($5)	# variable <- array
+	sw	$6, -12($fp)		# spilled t11, freed $6
+	lw	$6, 4($5)	# variable <- array
+	sw	$6, -16($fp)		# spilled t12, freed $6
+	lw	$6, 8($5)	# variable <- array
+	sw	$6, -20($fp)		# spilled t13, freed $6
+	lw	$6, 12($fp)	# n.runtime.6 -> $6
+	# Store dirty variables back into memory
+	blt	$6, 0, runtime.l14
+
+	lw	$5, 12($fp)	# n.runtime.6 -> $5
+	lw	$6, -20($fp)		# t13 -> $6
+	bgt	$5, $6, runtime.l14
+
+	lw	$5, -20($fp)		# t13 -> $5
+	bgt	$5, $5, runtime.l14
+
+	j	runtime.l15
+
+runtime.l14:
+	jal	runtime.panicSlice
+
+runtime.l15:
+	lw	$5, -12($fp)		# t11 -> $5
+	addi	$6, $5, 0
+	lw	$5, 12($fp)	# n.runtime.6 -> $5
+	sub	$7, $5, 0
+	lw	$5, -20($fp)		# t13 -> $5
+	sub	$8, $5, 0
+	li	$25, 12
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	sw	$6, -24($fp)
+	sw	$7, -28($fp)
+	sw	$8, -32($fp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	lw	$6, -24($fp)		# t14 -> $6
+	sw	$6, 0($5)	# variable -> array
+	lw	$6, -28($fp)		# t15 -> $6
+	sw	$6, 4($5)	# variable -> array
+	lw	$6, -32($fp)		# t16 -> $6
+	sw	$6, 8($5)	# variable -> array
+	# Store dirty variables back into memory
+	sw	$5, -36($fp)
+	move	$2, $5
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.growslice
+runtime.l16:
+	lw	$5, 16($fp)	# s.runtime.5 -> $5
+	lw	$6, 8($5)	# variable <- array
+	mul	$5, $6, 2
+	move	$7, $5		# c.runtime.8 -> $7
+	lw	$8, 12($fp)	# n.runtime.6 -> $8
+	# Store dirty variables back into memory
+	sw	$5, -44($fp)
+	sw	$6, -40($fp)
+	sw	$7, -48($fp)
+	bge	$7, $8, runtime.l18
+
+	li	$5, 1		# t20 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -52($fp)
+	j	runtime.l19
+
+runtime.l18:
+	li	$5, 0		# t20 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -52($fp)
+
+runtime.l19:
+	lw	$5, -52($fp)		# t20 -> $5
+	blt	$5, 1, runtime.l20
+
+	lw	$5, 12($fp)	# n.runtime.6 -> $5
+	move	$6, $5		# c.runtime.8 -> $6
+	# Store dirty variables back into memory
+	sw	$6, -48($fp)
+
+runtime.l20:
+	lw	$5, -48($fp)	# c.runtime.8 -> $5
+	lw	$6, 8($fp)	# size.runtime.7 -> $6
+	mul	$7, $5, $6
+	# Store dirty variables back into memory
+	sw	$7, -56($fp)
+	blt	$7, 0, runtime.l22
+
+	lw	$5, -56($fp)		# t21 -> $5
+	ble	$5, $5, runtime.l23
+
+runtime.l22:
+	jal	runtime.panicMakeSlice
+
+runtime.l23:
+	lw	$5, -56($fp)		# t21 -> $5
+	mul	$6, $5, 1
+	sll	$7, $6, 2
+	addi	$sp, $sp, -4
+	sw	$7, 0($sp)
+	sw	$6, -60($fp)
+	sw	$7, -64($fp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	li	$25, 12
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	sw	$5, -68($fp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	lw	$6, -68($fp)		# t24 -> $6
+	sw	$6, 0($5)	# variable -> array
+	lw	$6, -56($fp)		# t21 -> $6
+	sw	$6, 4($5)	# variable -> array
+	sw	$6, 8($5)	# variable -> array
+	move	$7, $5		# t.runtime.9 -> $7
+	lw	$8, 16($fp)	# s.runtime.5 -> $8
+	lw	$9, 0($8)	# variable <- array
+	move	$8, $9		# src.runtime.10 -> $8
+	sw	$8, -84($fp)	# spilled src.runtime.10, freed $8
+	lw	$8, 0($7)	# variable <- array
+	move	$10, $8		# dst.runtime.11 -> $10
+	sw	$10, -92($fp)	# spilled dst.runtime.11, freed $10
+	li	$10, 0		# i.runtime.12 -> $10
+	# Store dirty variables back into memory
+	sw	$5, -72($fp)
+	sw	$7, -76($fp)
+	sw	$8, -88($fp)
+	sw	$9, -80($fp)
+	sw	$10, -96($fp)
+
+runtime.l26:
+	lw	$5, 16($fp)	# s.runtime.5 -> $5
+	lw	$6, 4($5)	# variable <- array
+	lw	$5, 8($fp)	# size.runtime.7 -> $5
+	mul	$7, $6, $5
+	lw	$5, -96($fp)	# i.runtime.12 -> $5
+	# Store dirty variables back into memory
+	sw	$6, -100($fp)
+	sw	$7, -104($fp)
+	bge	$5, $7, runtime.l24
+
+	li	$5, 1		# t30 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -108($fp)
+	j	runtime.l25
+
+runtime.l24:
+	li	$5, 0		# t30 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -108($fp)
+
+runtime.l25:
+	lw	$5, -108($fp)		# t30 -> $5
+	blt	$5, 1, runtime.l27
+
+	lw	$5, -84($fp)	# src.runtime.10 -> $5
+	lw	$6, -96($fp)	# i.runtime.12 -> $6
+	sll	$24, $6, 2	# iterator *= 4
+	add	$24, $24, $5
+	lw	$7, 0($24)	# variable <- array
+	lw	$5, -92($fp)	# dst.runtime.11 -> $5
+	sll	$24, $6, 2	# iterator *= 4
+	add	$24, $24, $5
+	sw	$7, 0($24)	# variable -> array
+	addi	$6, $6, 1
+	# Store dirty variables back into memory
+	sw	$6, -96($fp)
+	sw	$7, -112($fp)
+	j	runtime.l26
+
+runtime.l27:
+	lw	$5, -76($fp)	# t.runtime.9 -> $5
+	lw	$6, 0($5)	# variable <- array
+	sw	$6, -116($fp)		# spilled t32, freed $6
+	lw	$6, 4($5)	# variable <- array
+	sw	$6, -120($fp)		# spilled t33, freed $6
+	lw	$6, 8($5)	# variable <- array
+	sw	$6, -124($fp)		# spilled t34, freed $6
+	lw	$6, 12($fp)	# n.runtime.6 -> $6
+	# Store dirty variables back into memory
+	blt	$6, 0, runtime.l28
+
+	lw	$5, 12($fp)	# n.runtime.6 -> $5
+	lw	$6, -48($fp)	# c.runtime.8 -> $6
+	bgt	$5, $6, runtime.l28
+
+	lw	$5, -48($fp)	# c.runtime.8 -> $5
+	lw	$6, -124($fp)		# t34 -> $6
+	bgt	$5, $6, runtime.l28
+
+	j	runtime.l29
+
+runtime.l28:
+	jal	runtime.panicSlice
+
+runtime.l29:
+	lw	$5, -116($fp)		# t32 -> $5
+	addi	$6, $5, 0
+	lw	$5, 12($fp)	# n.runtime.6 -> $5
+	sub	$7, $5, 0
+	lw	$5, -48($fp)	# c.runtime.8 -> $5
+	sub	$8, $5, 0
+	li	$25, 12
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	sw	$6, -128($fp)
+	sw	$7, -132($fp)
+	sw	$8, -136($fp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	lw	$6, -128($fp)		# t35 -> $6
+	sw	$6, 0($5)	# variable -> array
+	lw	$6, -132($fp)		# t36 -> $6
+	sw	$6, 4($5)	# variable -> array
+	lw	$6, -136($fp)		# t37 -> $6
+	sw	$6, 8($5)	# variable -> array
+	# Store dirty variables back into memory
+	sw	$5, -140($fp)
+	move	$2, $5
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.growslice
+runtime.makemap:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -24
+	li	$5, 8		# nb.runtime.14 -> $5
+	li	$25, 16
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	sw	$5, -4($fp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# m.runtime.15 -> $6
+	lw	$7, -4($fp)	# nb.runtime.14 -> $7
+	mul	$8, $7, 4
+	addi	$sp, $sp, -4
+	sw	$8, 0($sp)
+	sw	$5, -8($fp)
+	sw	$6, -12($fp)
+	sw	$8, -16($fp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# buckets.runtime.16 -> $6
+	lw	$7, -12($fp)	# m.runtime.15 -> $7
+	lw	$8, -4($fp)	# nb.runtime.14 -> $8
+	sw	$8, 4($7)	# variable -> array
+	sw	$6, 8($7)	# variable -> array
+	lw	$8, 8($fp)	# strkeys.runtime.13 -> $8
+	sw	$8, 12($7)	# variable -> array
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	sw	$6, -24($fp)
+	move	$2, $7
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.makemap
+runtime.strhash:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -32
+	li	$5, 0		# h.runtime.18 -> $5
+	sw	$5, -4($fp)	# spilled h.runtime.18, freed $5
+	li	$5, 0		# i.runtime.19 -> $5
+	lw	$6, 8($fp)	# s.runtime.17 -> $6
+	add	$24, $5, $6
+	lbu	$7, 0($24)	# variable <- byte
+	move	$6, $7		# c.runtime.20 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+	sw	$6, -16($fp)
+	sw	$7, -12($fp)
+
+runtime.l32:
+	lw	$5, -16($fp)	# c.runtime.20 -> $5
+	beq	$5, 0, runtime.l30
+
+	li	$5, 1		# t43 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	j	runtime.l31
+
+runtime.l30:
+	li	$5, 0		# t43 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+
+runtime.l31:
+	lw	$5, -20($fp)		# t43 -> $5
+	blt	$5, 1, runtime.l33
+
+	lw	$5, -4($fp)	# h.runtime.18 -> $5
+	mul	$6, $5, 31
+	lw	$5, -16($fp)	# c.runtime.20 -> $5
+	add	$7, $6, $5
+	move	$5, $7		# h.runtime.18 -> $5
+	sw	$5, -4($fp)	# spilled h.runtime.18, freed $5
+	lw	$5, -8($fp)	# i.runtime.19 -> $5
+	addi	$5, $5, 1
+	lw	$8, 8($fp)	# s.runtime.17 -> $8
+	add	$24, $5, $8
+	lbu	$9, 0($24)	# variable <- byte
+	move	$8, $9		# c.runtime.20 -> $8
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+	sw	$6, -24($fp)
+	sw	$7, -28($fp)
+	sw	$8, -16($fp)
+	sw	$9, -32($fp)
+	j	runtime.l32
+
+runtime.l33:
+	lw	$2, -4($fp)
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.strhash
+runtime.strequal:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -24
+	li	$5, 0		# i.runtime.23 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+
+runtime.l42:
+	lw	$5, 12($fp)	# a.runtime.21 -> $5
+	lw	$6, -4($fp)	# i.runtime.23 -> $6
+	add	$24, $6, $5
+	lbu	$7, 0($24)	# variable <- byte
+	move	$5, $7		# c.runtime.24 -> $5
+	lw	$8, 8($fp)	# b.runtime.22 -> $8
+	add	$24, $6, $8
+	lbu	$9, 0($24)	# variable <- byte
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+	sw	$7, -8($fp)
+	sw	$9, -16($fp)
+	beq	$5, $9, runtime.l34
+
+	li	$5, 1		# t49 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	j	runtime.l35
+
+runtime.l34:
+	li	$5, 0		# t49 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+
+runtime.l35:
+	lw	$5, -20($fp)		# t49 -> $5
+	blt	$5, 1, runtime.l36
+
+	li	$2, 0
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.strequal
+runtime.l36:
+	lw	$5, -12($fp)	# c.runtime.24 -> $5
+	bne	$5, 0, runtime.l38
+
+	li	$5, 1		# t50 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+	j	runtime.l39
+
+runtime.l38:
+	li	$5, 0		# t50 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+
+runtime.l39:
+	lw	$5, -24($fp)		# t50 -> $5
+	blt	$5, 1, runtime.l40
+
+	li	$2, 1
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.strequal
+runtime.l40:
+	lw	$5, -4($fp)	# i.runtime.23 -> $5
+	addi	$5, $5, 1
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	j	runtime.l42
+
+runtime.l43:
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.strequal
+runtime.strlen:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -20
+	li	$5, 0		# n.runtime.26 -> $5
+	lw	$6, 8($fp)	# s.runtime.25 -> $6
+	add	$24, $5, $6
+	lbu	$7, 0($24)	# variable <- byte
+	move	$6, $7		# c.runtime.27 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	sw	$6, -12($fp)
+	sw	$7, -8($fp)
+
+runtime.l46:
+	lw	$5, -12($fp)	# c.runtime.27 -> $5
+	beq	$5, 0, runtime.l44
+
+	li	$5, 1		# t52 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+	j	runtime.l45
+
+runtime.l44:
+	li	$5, 0		# t52 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+
+runtime.l45:
+	lw	$5, -16($fp)		# t52 -> $5
+	blt	$5, 1, runtime.l47
+
+	lw	$5, -4($fp)	# n.runtime.26 -> $5
+	addi	$5, $5, 1
+	lw	$6, 8($fp)	# s.runtime.25 -> $6
+	add	$24, $5, $6
+	lbu	$7, 0($24)	# variable <- byte
+	move	$6, $7		# c.runtime.27 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	sw	$6, -12($fp)
+	sw	$7, -20($fp)
+	j	runtime.l46
+
+runtime.l47:
+	lw	$2, -4($fp)
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.strlen
+runtime.concat:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -64
+	lw	$5, 12($fp)	# a.runtime.28 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	jal	runtime.strlen
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# m.runtime.30 -> $6
+	lw	$7, 8($fp)	# b.runtime.29 -> $7
+	addi	$sp, $sp, -4
+	sw	$7, 0($sp)
+	sw	$5, -4($fp)
+	sw	$6, -8($fp)
+	jal	runtime.strlen
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# n.runtime.31 -> $6
+	lw	$7, -8($fp)	# m.runtime.30 -> $7
+	add	$8, $7, $6
+	addi	$7, $8, 1
+	addi	$sp, $sp, -4
+	sw	$7, 0($sp)
+	sw	$5, -12($fp)
+	sw	$6, -16($fp)
+	sw	$7, -24($fp)
+	sw	$8, -20($fp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# s.runtime.32 -> $6
+	sw	$6, -32($fp)	# spilled s.runtime.32, freed $6
+	li	$6, 0		# i.runtime.33 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -28($fp)
+	sw	$6, -36($fp)
+
+runtime.l50:
+	lw	$5, -36($fp)	# i.runtime.33 -> $5
+	lw	$6, -8($fp)	# m.runtime.30 -> $6
+	bge	$5, $6, runtime.l48
+
+	li	$5, 1		# t59 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -40($fp)
+	j	runtime.l49
+
+runtime.l48:
+	li	$5, 0		# t59 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -40($fp)
+
+runtime.l49:
+	lw	$5, -40($fp)		# t59 -> $5
+	blt	$5, 1, runtime.l51
+
+	lw	$5, 12($fp)	# a.runtime.28 -> $5
+	lw	$6, -36($fp)	# i.runtime.33 -> $6
+	add	$24, $6, $5
+	lbu	$7, 0($24)	# variable <- byte
+	lw	$5, -32($fp)	# s.runtime.32 -> $5
+	add	$24, $6, $5
+	sb	$7, 0($24)	# variable -> byte
+	addi	$6, $6, 1
+	# Store dirty variables back into memory
+	sw	$6, -36($fp)
+	sw	$7, -44($fp)
+	j	runtime.l50
+
+runtime.l51:
+	li	$5, 0		# i.runtime.34 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -48($fp)
+
+runtime.l54:
+	lw	$5, -48($fp)	# i.runtime.34 -> $5
+	lw	$6, -16($fp)	# n.runtime.31 -> $6
+	bge	$5, $6, runtime.l52
+
+	li	$5, 1		# t61 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -52($fp)
+	j	runtime.l53
+
+runtime.l52:
+	li	$5, 0		# t61 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -52($fp)
+
+runtime.l53:
+	lw	$5, -52($fp)		# t61 -> $5
+	blt	$5, 1, runtime.l55
+
+	lw	$5, -8($fp)	# m.runtime.30 -> $5
+	lw	$6, -48($fp)	# i.runtime.34 -> $6
+	add	$7, $5, $6
+	lw	$5, 8($fp)	# b.runtime.29 -> $5
+	add	$24, $6, $5
+	lbu	$8, 0($24)	# variable <- byte
+	lw	$5, -32($fp)	# s.runtime.32 -> $5
+	add	$24, $7, $5
+	sb	$8, 0($24)	# variable -> byte
+	addi	$6, $6, 1
+	# Store dirty variables back into memory
+	sw	$6, -48($fp)
+	sw	$7, -56($fp)
+	sw	$8, -60($fp)
+	j	runtime.l54
+
+runtime.l55:
+	lw	$5, -8($fp)	# m.runtime.30 -> $5
+	lw	$6, -16($fp)	# n.runtime.31 -> $6
+	add	$7, $5, $6
+	lw	$5, -32($fp)	# s.runtime.32 -> $5
+	li	$25, 0
+	add	$24, $7, $5
+	sb	$25, 0($24)	# variable -> byte
+	# Store dirty variables back into memory
+	sw	$7, -64($fp)
+	move	$2, $5
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.concat
+runtime.strcmp:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -32
+	li	$5, 0		# i.runtime.37 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+
+runtime.l68:
+	lw	$5, 12($fp)	# a.runtime.35 -> $5
+	lw	$6, -4($fp)	# i.runtime.37 -> $6
+	add	$24, $6, $5
+	lbu	$7, 0($24)	# variable <- byte
+	move	$5, $7		# c.runtime.38 -> $5
+	lw	$8, 8($fp)	# b.runtime.36 -> $8
+	add	$24, $6, $8
+	lbu	$9, 0($24)	# variable <- byte
+	move	$8, $9		# d.runtime.39 -> $8
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+	sw	$7, -8($fp)
+	sw	$8, -20($fp)
+	sw	$9, -16($fp)
+	beq	$5, $8, runtime.l56
+
+	li	$5, 1		# t67 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+	j	runtime.l57
+
+runtime.l56:
+	li	$5, 0		# t67 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+
+runtime.l57:
+	lw	$5, -24($fp)		# t67 -> $5
+	blt	$5, 1, runtime.l62
+
+	lw	$5, -12($fp)	# c.runtime.38 -> $5
+	lw	$6, -20($fp)	# d.runtime.39 -> $6
+	bge	$5, $6, runtime.l58
+
+	li	$5, 1		# t68 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -28($fp)
+	j	runtime.l59
+
+runtime.l58:
+	li	$5, 0		# t68 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -28($fp)
+
+runtime.l59:
+	lw	$5, -28($fp)		# t68 -> $5
+	blt	$5, 1, runtime.l60
+
+	li	$2, -1
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.strcmp
+runtime.l60:
+	li	$2, 1
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.strcmp
+runtime.l62:
+	lw	$5, -12($fp)	# c.runtime.38 -> $5
+	bne	$5, 0, runtime.l64
+
+	li	$5, 1		# t69 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+	j	runtime.l65
+
+runtime.l64:
+	li	$5, 0		# t69 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+
+runtime.l65:
+	lw	$5, -32($fp)		# t69 -> $5
+	blt	$5, 1, runtime.l66
+
+	li	$2, 0
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.strcmp
+runtime.l66:
+	lw	$5, -4($fp)	# i.runtime.37 -> $5
+	addi	$5, $5, 1
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	j	runtime.l68
+
+runtime.l69:
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.strcmp
+runtime.itoa:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -52
+	li	$25, 12
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# s.runtime.41 -> $6
+	sw	$6, -8($fp)	# spilled s.runtime.41, freed $6
+	li	$6, 11		# i.runtime.42 -> $6
+	sw	$6, -12($fp)	# spilled i.runtime.42, freed $6
+	li	$6, 0		# neg.runtime.43 -> $6
+	sw	$6, -16($fp)	# spilled neg.runtime.43, freed $6
+	lw	$6, 8($fp)	# n.runtime.40 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	bge	$6, 0, runtime.l70
+
+	li	$5, 1		# t71 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	j	runtime.l71
+
+runtime.l70:
+	li	$5, 0		# t71 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+
+runtime.l71:
+	lw	$5, -20($fp)		# t71 -> $5
+	blt	$5, 1, runtime.l73
+
+	li	$5, 1		# neg.runtime.43 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+	j	runtime.l72
+
+runtime.l73:
+	lw	$5, 8($fp)	# n.runtime.40 -> $5
+	mul	$6, $5, -1
+	move	$5, $6		# n.runtime.40 -> $5
+	# Store dirty variables back into memory
+	sw	$5, 8($fp)
+	sw	$6, -24($fp)
+
+runtime.l72:
+
+runtime.l78:
+	lw	$5, -12($fp)	# i.runtime.42 -> $5
+	sub	$5, $5, 1
+	lw	$6, 8($fp)	# n.runtime.40 -> $6
+	rem	$7, $6, 10
+	li	$8, 48		# t75 -> $8
+	sub	$9, $8, $7
+	lw	$10, -8($fp)	# s.runtime.41 -> $10
+	add	$24, $5, $10
+	sb	$9, 0($24)	# variable -> byte
+	div	$10, $6, 10
+	move	$6, $10		# n.runtime.40 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+	sw	$6, 8($fp)
+	sw	$7, -28($fp)
+	sw	$8, -32($fp)
+	sw	$9, -36($fp)
+	sw	$10, -40($fp)
+	bne	$6, 0, runtime.l74
+
+	li	$5, 1		# t77 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -44($fp)
+	j	runtime.l75
+
+runtime.l74:
+	li	$5, 0		# t77 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -44($fp)
+
+runtime.l75:
+	lw	$5, -44($fp)		# t77 -> $5
+	blt	$5, 1, runtime.l78
+
+	j	runtime.l79
+
+runtime.l79:
+	lw	$5, -16($fp)	# neg.runtime.43 -> $5
+	bne	$5, 1, runtime.l80
+
+	li	$5, 1		# t78 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -48($fp)
+	j	runtime.l81
+
+runtime.l80:
+	li	$5, 0		# t78 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -48($fp)
+
+runtime.l81:
+	lw	$5, -48($fp)		# t78 -> $5
+	blt	$5, 1, runtime.l82
+
+	lw	$5, -12($fp)	# i.runtime.42 -> $5
+	sub	$5, $5, 1
+	lw	$6, -8($fp)	# s.runtime.41 -> $6
+	li	$25, 45
+	add	$24, $5, $6
+	sb	$25, 0($24)	# variable -> byte
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+
+runtime.l82:
+	lw	$5, -8($fp)	# s.runtime.41 -> $5
+	lw	$6, -12($fp)	# i.runtime.42 -> $6
+	add	$7, $5, $6
+	# Store dirty variables back into memory
+	sw	$7, -52($fp)
+	move	$2, $7
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.itoa
+runtime.runestring:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -132
+	lw	$5, 8($fp)	# r.runtime.44 -> $5
+	bge	$5, 0, runtime.l84
+
+	li	$5, 1		# t80 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	j	runtime.l85
+
+runtime.l84:
+	li	$5, 0		# t80 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+
+runtime.l85:
+	lw	$5, -4($fp)		# t80 -> $5
+	beq	$5, 1, runtime.l89
+
+	lw	$5, 8($fp)	# r.runtime.44 -> $5
+	ble	$5, 1114111, runtime.l86
+
+	li	$5, 1		# t81 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+	j	runtime.l87
+
+runtime.l86:
+	li	$5, 0		# t81 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+
+runtime.l87:
+	lw	$5, -8($fp)		# t81 -> $5
+	beq	$5, 1, runtime.l89
+
+	li	$5, 0		# t82 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+	j	runtime.l88
+
+runtime.l89:
+	li	$5, 1		# t82 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+
+runtime.l88:
+	lw	$5, -12($fp)		# t82 -> $5
+	beq	$5, 1, runtime.l97
+
+	lw	$5, 8($fp)	# r.runtime.44 -> $5
+	blt	$5, 55296, runtime.l90
+
+	li	$5, 1		# t83 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+	j	runtime.l91
+
+runtime.l90:
+	li	$5, 0		# t83 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+
+runtime.l91:
+	lw	$5, -16($fp)		# t83 -> $5
+	beq	$5, 0, runtime.l95
+
+	lw	$5, 8($fp)	# r.runtime.44 -> $5
+	bgt	$5, 57343, runtime.l92
+
+	li	$5, 1		# t84 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	j	runtime.l93
+
+runtime.l92:
+	li	$5, 0		# t84 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+
+runtime.l93:
+	lw	$5, -20($fp)		# t84 -> $5
+	beq	$5, 0, runtime.l95
+
+	li	$5, 1		# t85 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+	j	runtime.l94
+
+runtime.l95:
+	li	$5, 0		# t85 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+
+runtime.l94:
+	lw	$5, -24($fp)		# t85 -> $5
+	beq	$5, 1, runtime.l97
+
+	li	$5, 0		# t86 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -28($fp)
+	j	runtime.l96
+
+runtime.l97:
+	li	$5, 1		# t86 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -28($fp)
+
+runtime.l96:
+	lw	$5, -28($fp)		# t86 -> $5
+	blt	$5, 1, runtime.l98
+
+	li	$5, 65533		# r.runtime.44 -> $5
+	# Store dirty variables back into memory
+	sw	$5, 8($fp)
+
+runtime.l98:
+	li	$25, 5
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# s.runtime.45 -> $6
+	sw	$6, -36($fp)	# spilled s.runtime.45, freed $6
+	lw	$6, 8($fp)	# r.runtime.44 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+	bge	$6, 128, runtime.l100
+
+	li	$5, 1		# t88 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -40($fp)
+	j	runtime.l101
+
+runtime.l100:
+	li	$5, 0		# t88 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -40($fp)
+
+runtime.l101:
+	lw	$5, -40($fp)		# t88 -> $5
+	blt	$5, 1, runtime.l111
+
+	lw	$5, -36($fp)	# s.runtime.45 -> $5
+	lw	$6, 8($fp)	# r.runtime.44 -> $6
+	sb	$6, 0($5)	# variable -> byte
+	j	runtime.l110
+
+runtime.l111:
+	lw	$5, 8($fp)	# r.runtime.44 -> $5
+	bge	$5, 2048, runtime.l102
+
+	li	$5, 1		# t89 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -44($fp)
+	j	runtime.l103
+
+runtime.l102:
+	li	$5, 0		# t89 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -44($fp)
+
+runtime.l103:
+	lw	$5, -44($fp)		# t89 -> $5
+	blt	$5, 1, runtime.l109
+
+	lw	$5, 8($fp)	# r.runtime.44 -> $5
+	sra	$6, $5, 6
+	or	$7, $6, 192
+	lw	$8, -36($fp)	# s.runtime.45 -> $8
+	sb	$7, 0($8)	# variable -> byte
+	and	$9, $5, 63
+	or	$10, $9, 128
+	sb	$10, 1($8)	# variable -> byte
+	# Store dirty variables back into memory
+	sw	$6, -48($fp)
+	sw	$7, -52($fp)
+	sw	$9, -56($fp)
+	sw	$10, -60($fp)
+	j	runtime.l108
+
+runtime.l109:
+	lw	$5, 8($fp)	# r.runtime.44 -> $5
+	bge	$5, 65536, runtime.l104
+
+	li	$5, 1		# t94 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -64($fp)
+	j	runtime.l105
+
+runtime.l104:
+	li	$5, 0		# t94 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -64($fp)
+
+runtime.l105:
+	lw	$5, -64($fp)		# t94 -> $5
+	blt	$5, 1, runtime.l107
+
+	lw	$5, 8($fp)	# r.runtime.44 -> $5
+	sra	$6, $5, 12
+	or	$7, $6, 224
+	lw	$8, -36($fp)	# s.runtime.45 -> $8
+	sb	$7, 0($8)	# variable -> byte
+	sra	$9, $5, 6
+	and	$10, $9, 63
+	or	$11, $10, 128
+	sb	$11, 1($8)	# variable -> byte
+	and	$12, $5, 63
+	or	$13, $12, 128
+	sb	$13, 2($8)	# variable -> byte
+	# Store dirty variables back into memory
+	sw	$6, -68($fp)
+	sw	$7, -72($fp)
+	sw	$9, -76($fp)
+	sw	$10, -80($fp)
+	sw	$11, -84($fp)
+	sw	$12, -88($fp)
+	sw	$13, -92($fp)
+	j	runtime.l106
+
+runtime.l107:
+	lw	$5, 8($fp)	# r.runtime.44 -> $5
+	sra	$6, $5, 18
+	or	$7, $6, 240
+	lw	$8, -36($fp)	# s.runtime.45 -> $8
+	sb	$7, 0($8)	# variable -> byte
+	sra	$9, $5, 12
+	and	$10, $9, 63
+	or	$11, $10, 128
+	sb	$11, 1($8)	# variable -> byte
+	sra	$12, $5, 6
+	and	$13, $12, 63
+	or	$14, $13, 128
+	sb	$14, 2($8)	# variable -> byte
+	and	$15, $5, 63
+	or	$16, $15, 128
+	sb	$16, 3($8)	# variable -> byte
+	# Store dirty variables back into memory
+	sw	$6, -96($fp)
+	sw	$7, -100($fp)
+	sw	$9, -104($fp)
+	sw	$10, -108($fp)
+	sw	$11, -112($fp)
+	sw	$12, -116($fp)
+	sw	$13, -120($fp)
+	sw	$14, -124($fp)
+	sw	$15, -128($fp)
+	sw	$16, -132($fp)
+
+runtime.l106:
+
+runtime.l108:
+
+runtime.l110:
+	lw	$2, -36($fp)
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.runestring
+runtime.printrune:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -20
+	lw	$5, 8($fp)	# r.runtime.46 -> $5
+	blt	$5, 0, runtime.l112
+
+	li	$5, 1		# t112 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	j	runtime.l113
+
+runtime.l112:
+	li	$5, 0		# t112 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+
+runtime.l113:
+	lw	$5, -4($fp)	# t112 -> $5
+	beq	$5, 0, runtime.l117
+
+	lw	$5, 8($fp)	# r.runtime.46 -> $5
+	bge	$5, 128, runtime.l114
+
+	li	$5, 1		# t113 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+	j	runtime.l115
+
+runtime.l114:
+	li	$5, 0		# t113 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+
+runtime.l115:
+	lw	$5, -8($fp)	# t113 -> $5
+	beq	$5, 0, runtime.l117
+
+	li	$5, 1		# t114 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+	j	runtime.l116
+
+runtime.l117:
+	li	$5, 0		# t114 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+
+runtime.l116:
+	lw	$5, -12($fp)	# t114 -> $5
+	blt	$5, 1, runtime.l118
+
+	li	$2, 11
+	lw	$4, 8($fp)
+	syscall
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.printrune
+runtime.l118:
+	lw	$5, 8($fp)	# r.runtime.46 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	jal	runtime.runestring
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# s.runtime.47 -> $6
+	li	$2, 4
+	move	$4, $6
+	syscall
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+	sw	$6, -20($fp)
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.printrune
+runtime.fmtint:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -68
+	li	$25, 36
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# s.runtime.50 -> $6
+	sw	$6, -8($fp)	# spilled s.runtime.50, freed $6
+	li	$6, 35		# i.runtime.51 -> $6
+	sw	$6, -12($fp)	# spilled i.runtime.51, freed $6
+	li	$6, 0		# neg.runtime.52 -> $6
+	sw	$6, -16($fp)	# spilled neg.runtime.52, freed $6
+	lw	$6, 12($fp)	# n.runtime.48 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	bge	$6, 0, runtime.l120
+
+	li	$5, 1		# t117 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	j	runtime.l121
+
+runtime.l120:
+	li	$5, 0		# t117 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+
+runtime.l121:
+	lw	$5, -20($fp)	# t117 -> $5
+	blt	$5, 1, runtime.l123
+
+	li	$5, 1		# neg.runtime.52 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+	j	runtime.l122
+
+runtime.l123:
+	lw	$5, 12($fp)	# n.runtime.48 -> $5
+	mul	$6, $5, -1
+	move	$5, $6		# n.runtime.48 -> $5
+	# Store dirty variables back into memory
+	sw	$5, 12($fp)
+	sw	$6, -24($fp)
+
+runtime.l122:
+
+runtime.l132:
+	lw	$5, -12($fp)	# i.runtime.51 -> $5
+	sub	$5, $5, 1
+	sw	$5, -12($fp)	# spilled i.runtime.51, freed $5
+	lw	$5, 12($fp)	# n.runtime.48 -> $5
+	lw	$6, 8($fp)	# base.runtime.49 -> $6
+	rem	$7, $5, $6
+	mul	$5, $7, -1
+	move	$6, $5		# d.runtime.53 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+	sw	$6, -36($fp)
+	sw	$7, -28($fp)
+	bge	$6, 10, runtime.l124
+
+	li	$5, 1		# t121 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -40($fp)
+	j	runtime.l125
+
+runtime.l124:
+	li	$5, 0		# t121 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -40($fp)
+
+runtime.l125:
+	lw	$5, -40($fp)	# t121 -> $5
+	blt	$5, 1, runtime.l127
+
+	lw	$5, -36($fp)	# d.runtime.53 -> $5
+	addi	$6, $5, 48
+	lw	$5, -8($fp)	# s.runtime.50 -> $5
+	lw	$7, -12($fp)	# i.runtime.51 -> $7
+	add	$24, $7, $5
+	sb	$6, 0($24)	# variable -> byte
+	# Store dirty variables back into memory
+	sw	$6, -44($fp)
+	j	runtime.l126
+
+runtime.l127:
+	lw	$5, -36($fp)	# d.runtime.53 -> $5
+	addi	$6, $5, 97
+	sub	$5, $6, 10
+	lw	$7, -8($fp)	# s.runtime.50 -> $7
+	lw	$8, -12($fp)	# i.runtime.51 -> $8
+	add	$24, $8, $7
+	sb	$5, 0($24)	# variable -> byte
+	# Store dirty variables back into memory
+	sw	$5, -52($fp)
+	sw	$6, -48($fp)
+
+runtime.l126:
+	lw	$5, 12($fp)	# n.runtime.48 -> $5
+	lw	$6, 8($fp)	# base.runtime.49 -> $6
+	div	$7, $5, $6
+	move	$5, $7		# n.runtime.48 -> $5
+	# Store dirty variables back into memory
+	sw	$5, 12($fp)
+	sw	$7, -56($fp)
+	bne	$5, 0, runtime.l128
+
+	li	$5, 1		# t126 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -60($fp)
+	j	runtime.l129
+
+runtime.l128:
+	li	$5, 0		# t126 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -60($fp)
+
+runtime.l129:
+	lw	$5, -60($fp)	# t126 -> $5
+	blt	$5, 1, runtime.l132
+
+	j	runtime.l133
+
+runtime.l133:
+	lw	$5, -16($fp)	# neg.runtime.52 -> $5
+	bne	$5, 1, runtime.l134
+
+	li	$5, 1		# t127 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -64($fp)
+	j	runtime.l135
+
+runtime.l134:
+	li	$5, 0		# t127 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -64($fp)
+
+runtime.l135:
+	lw	$5, -64($fp)	# t127 -> $5
+	blt	$5, 1, runtime.l136
+
+	lw	$5, -12($fp)	# i.runtime.51 -> $5
+	sub	$5, $5, 1
+	lw	$6, -8($fp)	# s.runtime.50 -> $6
+	li	$25, 45
+	add	$24, $5, $6
+	sb	$25, 0($24)	# variable -> byte
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+
+runtime.l136:
+	lw	$5, -8($fp)	# s.runtime.50 -> $5
+	lw	$6, -12($fp)	# i.runtime.51 -> $6
+	add	$7, $5, $6
+	# Store dirty variables back into memory
+	sw	$7, -68($fp)
+	move	$2, $7
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.fmtint
+runtime.fmtbool:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -20
+	la	$5, yes.runtime.55.str
+	sw	$5, -4($fp)	# spilled yes.runtime.55, freed $5
+	la	$5, no.runtime.56.str
+	sw	$5, -12($fp)	# spilled no.runtime.56, freed $5
+	lw	$5, 8($fp)	# b.runtime.54 -> $5
+	# Store dirty variables back into memory
+	beq	$5, 0, runtime.l138
+
+	li	$5, 1		# t129 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	j	runtime.l139
+
+runtime.l138:
+	li	$5, 0		# t129 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+
+runtime.l139:
+	lw	$5, -20($fp)	# t129 -> $5
+	blt	$5, 1, runtime.l140
+
+	lw	$2, -4($fp)
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.fmtbool
+runtime.l140:
+	lw	$2, -12($fp)
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.fmtbool
+runtime.fmtiface:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -24
+	lw	$5, 8($fp)	# v.runtime.57 -> $5
+	beq	$5, 0, runtime.l142
+
+	li	$5, 1		# t130 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	j	runtime.l143
+
+runtime.l142:
+	li	$5, 0		# t130 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+
+runtime.l143:
+	lw	$5, -4($fp)	# t130 -> $5
+	blt	$5, 1, runtime.l144
+
+	lw	$2, 8($fp)
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.fmtiface
+runtime.l144:
+	la	$5, nilValue.runtime.58.str
+	la	$6, empty.runtime.59.str
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	addi	$sp, $sp, -4
+	sw	$6, 0($sp)
+	sw	$5, -8($fp)
+	sw	$6, -16($fp)
+	jal	runtime.concat
+	addi	$sp, $sp, 8
+	move	$5, $2
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+	move	$2, $5
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.fmtiface
+runtime.fmtpad:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -140
+	lw	$5, 16($fp)	# s.runtime.60 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	jal	runtime.strlen
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# n.runtime.63 -> $6
+	sw	$6, -8($fp)	# spilled n.runtime.63, freed $6
+	li	$6, 0		# runes.runtime.64 -> $6
+	sw	$6, -12($fp)	# spilled runes.runtime.64, freed $6
+	li	$6, 0		# i.runtime.65 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	sw	$6, -16($fp)
+
+runtime.l152:
+	lw	$5, -16($fp)	# i.runtime.65 -> $5
+	lw	$6, -8($fp)	# n.runtime.63 -> $6
+	bge	$5, $6, runtime.l146
+
+	li	$5, 1		# t133 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	j	runtime.l147
+
+runtime.l146:
+	li	$5, 0		# t133 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+
+runtime.l147:
+	lw	$5, -20($fp)	# t133 -> $5
+	blt	$5, 1, runtime.l153
+
+	lw	$5, 16($fp)	# s.runtime.60 -> $5
+	lw	$6, -16($fp)	# i.runtime.65 -> $6
+	add	$24, $6, $5
+	lbu	$7, 0($24)	# variable <- byte
+	and	$5, $7, 192
+	# Store dirty variables back into memory
+	sw	$5, -28($fp)
+	sw	$7, -24($fp)
+	beq	$5, 128, runtime.l148
+
+	li	$5, 1		# t136 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+	j	runtime.l149
+
+runtime.l148:
+	li	$5, 0		# t136 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+
+runtime.l149:
+	lw	$5, -32($fp)	# t136 -> $5
+	blt	$5, 1, runtime.l150
+
+	lw	$5, -12($fp)	# runes.runtime.64 -> $5
+	addi	$5, $5, 1
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+
+runtime.l150:
+	lw	$5, -16($fp)	# i.runtime.65 -> $5
+	addi	$5, $5, 1
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+	j	runtime.l152
+
+runtime.l153:
+	lw	$5, -12($fp)	# runes.runtime.64 -> $5
+	lw	$6, 12($fp)	# width.runtime.61 -> $6
+	blt	$5, $6, runtime.l154
+
+	li	$5, 1		# t137 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -36($fp)
+	j	runtime.l155
+
+runtime.l154:
+	li	$5, 0		# t137 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -36($fp)
+
+runtime.l155:
+	lw	$5, -36($fp)	# t137 -> $5
+	blt	$5, 1, runtime.l156
+
+	lw	$2, 16($fp)
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.fmtpad
+runtime.l156:
+	lw	$5, 12($fp)	# width.runtime.61 -> $5
+	lw	$6, -12($fp)	# runes.runtime.64 -> $6
+	sub	$7, $5, $6
+	move	$5, $7		# pad.runtime.66 -> $5
+	lw	$6, -8($fp)	# n.runtime.63 -> $6
+	add	$8, $6, $5
+	addi	$6, $8, 1
+	addi	$sp, $sp, -4
+	sw	$6, 0($sp)
+	sw	$5, -44($fp)
+	sw	$6, -52($fp)
+	sw	$7, -40($fp)
+	sw	$8, -48($fp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# p.runtime.67 -> $6
+	sw	$6, -60($fp)	# spilled p.runtime.67, freed $6
+	li	$6, 0		# i.runtime.68 -> $6
+	sw	$6, -64($fp)	# spilled i.runtime.68, freed $6
+	li	$6, 0		# j.runtime.69 -> $6
+	sw	$6, -68($fp)	# spilled j.runtime.69, freed $6
+	lw	$6, 8($fp)	# flags.runtime.62 -> $6
+	and	$7, $6, 1
+	# Store dirty variables back into memory
+	sw	$5, -56($fp)
+	sw	$7, -72($fp)
+	bne	$7, 0, runtime.l158
+
+	li	$5, 1		# t143 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -76($fp)
+	j	runtime.l159
+
+runtime.l158:
+	li	$5, 0		# t143 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -76($fp)
+
+runtime.l159:
+	lw	$5, -76($fp)	# t143 -> $5
+	blt	$5, 1, runtime.l176
+
+	li	$5, 32		# c.runtime.70 -> $5
+	sw	$5, -80($fp)	# spilled c.runtime.70, freed $5
+	lw	$5, 8($fp)	# flags.runtime.62 -> $5
+	and	$6, $5, 2
+	# Store dirty variables back into memory
+	sw	$6, -84($fp)
+	beq	$6, 0, runtime.l160
+
+	li	$5, 1		# t145 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -88($fp)
+	j	runtime.l161
+
+runtime.l160:
+	li	$5, 0		# t145 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -88($fp)
+
+runtime.l161:
+	lw	$5, -88($fp)	# t145 -> $5
+	blt	$5, 1, runtime.l170
+
+	li	$5, 48		# c.runtime.70 -> $5
+	sw	$5, -80($fp)	# spilled c.runtime.70, freed $5
+	lw	$5, 8($fp)	# flags.runtime.62 -> $5
+	and	$6, $5, 4
+	# Store dirty variables back into memory
+	sw	$6, -92($fp)
+	beq	$6, 0, runtime.l162
+
+	li	$5, 1		# t147 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -96($fp)
+	j	runtime.l163
+
+runtime.l162:
+	li	$5, 0		# t147 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -96($fp)
+
+runtime.l163:
+	lw	$5, -96($fp)	# t147 -> $5
+	beq	$5, 0, runtime.l167
+
+	lw	$5, 16($fp)	# s.runtime.60 -> $5
+	lbu	$6, 0($5)	# variable <- byte
+	# Store dirty variables back into memory
+	sw	$6, -100($fp)
+	bne	$6, 45, runtime.l164
+
+	li	$5, 1		# t149 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -104($fp)
+	j	runtime.l165
+
+runtime.l164:
+	li	$5, 0		# t149 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -104($fp)
+
+runtime.l165:
+	lw	$5, -104($fp)	# t149 -> $5
+	beq	$5, 0, runtime.l167
+
+	li	$5, 1		# t150 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -108($fp)
+	j	runtime.l166
+
+runtime.l167:
+	li	$5, 0		# t150 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -108($fp)
+
+runtime.l166:
+	lw	$5, -108($fp)	# t150 -> $5
+	blt	$5, 1, runtime.l168
+
+	lw	$5, -60($fp)	# p.runtime.67 -> $5
+	li	$25, 45
+	sb	$25, 0($5)	# variable -> byte
+	li	$5, 1		# i.runtime.68 -> $5
+	sw	$5, -64($fp)	# spilled i.runtime.68, freed $5
+	li	$5, 1		# j.runtime.69 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -68($fp)
+
+runtime.l168:
+
+runtime.l170:
+	li	$5, 0		# k.runtime.71 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -112($fp)
+
+runtime.l174:
+	lw	$5, -112($fp)	# k.runtime.71 -> $5
+	lw	$6, -44($fp)	# pad.runtime.66 -> $6
+	bge	$5, $6, runtime.l172
+
+	li	$5, 1		# t151 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -116($fp)
+	j	runtime.l173
+
+runtime.l172:
+	li	$5, 0		# t151 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -116($fp)
+
+runtime.l173:
+	lw	$5, -116($fp)	# t151 -> $5
+	blt	$5, 1, runtime.l175
+
+	lw	$5, -60($fp)	# p.runtime.67 -> $5
+	lw	$6, -68($fp)	# j.runtime.69 -> $6
+	lw	$7, -80($fp)	# c.runtime.70 -> $7
+	add	$24, $6, $5
+	sb	$7, 0($24)	# variable -> byte
+	addi	$6, $6, 1
+	lw	$5, -112($fp)	# k.runtime.71 -> $5
+	addi	$5, $5, 1
+	# Store dirty variables back into memory
+	sw	$5, -112($fp)
+	sw	$6, -68($fp)
+	j	runtime.l174
+
+runtime.l175:
+
+runtime.l176:
+
+runtime.l180:
+	lw	$5, -64($fp)	# i.runtime.68 -> $5
+	lw	$6, -8($fp)	# n.runtime.63 -> $6
+	bge	$5, $6, runtime.l178
+
+	li	$5, 1		# t152 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -120($fp)
+	j	runtime.l179
+
+runtime.l178:
+	li	$5, 0		# t152 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -120($fp)
+
+runtime.l179:
+	lw	$5, -120($fp)	# t152 -> $5
+	blt	$5, 1, runtime.l181
+
+	lw	$5, 16($fp)	# s.runtime.60 -> $5
+	lw	$6, -64($fp)	# i.runtime.68 -> $6
+	add	$24, $6, $5
+	lbu	$7, 0($24)	# variable <- byte
+	lw	$5, -60($fp)	# p.runtime.67 -> $5
+	lw	$8, -68($fp)	# j.runtime.69 -> $8
+	add	$24, $8, $5
+	sb	$7, 0($24)	# variable -> byte
+	addi	$6, $6, 1
+	addi	$8, $8, 1
+	# Store dirty variables back into memory
+	sw	$6, -64($fp)
+	sw	$7, -124($fp)
+	sw	$8, -68($fp)
+	j	runtime.l180
+
+runtime.l181:
+	lw	$5, 8($fp)	# flags.runtime.62 -> $5
+	and	$6, $5, 1
+	# Store dirty variables back into memory
+	sw	$6, -128($fp)
+	beq	$6, 0, runtime.l182
+
+	li	$5, 1		# t155 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -132($fp)
+	j	runtime.l183
+
+runtime.l182:
+	li	$5, 0		# t155 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -132($fp)
+
+runtime.l183:
+	lw	$5, -132($fp)	# t155 -> $5
+	blt	$5, 1, runtime.l188
+
+	li	$5, 0		# k.runtime.72 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -136($fp)
+
+runtime.l186:
+	lw	$5, -136($fp)	# k.runtime.72 -> $5
+	lw	$6, -44($fp)	# pad.runtime.66 -> $6
+	bge	$5, $6, runtime.l184
+
+	li	$5, 1		# t156 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -140($fp)
+	j	runtime.l185
+
+runtime.l184:
+	li	$5, 0		# t156 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -140($fp)
+
+runtime.l185:
+	lw	$5, -140($fp)	# t156 -> $5
+	blt	$5, 1, runtime.l187
+
+	lw	$5, -60($fp)	# p.runtime.67 -> $5
+	lw	$6, -68($fp)	# j.runtime.69 -> $6
+	li	$25, 32
+	add	$24, $6, $5
+	sb	$25, 0($24)	# variable -> byte
+	addi	$6, $6, 1
+	lw	$5, -136($fp)	# k.runtime.72 -> $5
+	addi	$5, $5, 1
+	# Store dirty variables back into memory
+	sw	$5, -136($fp)
+	sw	$6, -68($fp)
+	j	runtime.l186
+
+runtime.l187:
+
+runtime.l188:
+	lw	$2, -60($fp)
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.fmtpad
+runtime.getg:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -8
+	lw	$5, curg.runtime.73	# curg.runtime.73 -> $5
+	bne	$5, 0, runtime.l190
+
+	li	$5, 1		# t157 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	j	runtime.l191
+
+runtime.l190:
+	li	$5, 0		# t157 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+
+runtime.l191:
+	lw	$5, -4($fp)	# t157 -> $5
+	blt	$5, 1, runtime.l192
+
+	li	$25, 32
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# curg.runtime.73 -> $6
+	li	$25, 1 	# const value -> $25
+	sw	$25, 16($6)	# variable -> array
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+	sw	$6, curg.runtime.73
+
+runtime.l192:
+	lw	$2, curg.runtime.73
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.getg
+runtime.newg:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -24
+	li	$25, 32
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# g.runtime.77 -> $6
+	li	$7, 65536		# size.runtime.78 -> $7
+	addi	$sp, $sp, -4
+	sw	$7, 0($sp)
+	sw	$5, -4($fp)
+	sw	$6, -8($fp)
+	sw	$7, -12($fp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	lw	$6, -12($fp)	# size.runtime.78 -> $6
+	add	$7, $5, $6
+	lw	$6, -8($fp)	# g.runtime.77 -> $6
+	sw	$7, 0($6)	# variable -> array
+	lw	$8, goidgen.runtime.76	# goidgen.runtime.76 -> $8
+	addi	$8, $8, 1
+	addi	$9, $8, 1
+	sw	$9, 16($6)	# variable -> array
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+	sw	$7, -20($fp)
+	sw	$8, goidgen.runtime.76
+	sw	$9, -24($fp)
+	move	$2, $6
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.newg
+runtime.ready:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -4
+	lw	$5, 8($fp)	# g.runtime.79 -> $5
+	li	$25, 0 	# const value -> $25
+	sw	$25, 12($5)	# variable -> array
+	lw	$5, runqtail.runtime.75	# runqtail.runtime.75 -> $5
+	bne	$5, 0, runtime.l194
+
+	li	$5, 1		# t163 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	j	runtime.l195
+
+runtime.l194:
+	li	$5, 0		# t163 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+
+runtime.l195:
+	lw	$5, -4($fp)	# t163 -> $5
+	blt	$5, 1, runtime.l197
+
+	lw	$5, 8($fp)	# g.runtime.79 -> $5
+	move	$6, $5		# runqhead.runtime.74 -> $6
+	# Store dirty variables back into memory
+	sw	$6, runqhead.runtime.74
+	j	runtime.l196
+
+runtime.l197:
+	lw	$5, runqtail.runtime.75	# runqtail.runtime.75 -> $5
+	lw	$6, 8($fp)	# g.runtime.79 -> $6
+	sw	$6, 12($5)	# variable -> array
+
+runtime.l196:
+	lw	$5, 8($fp)	# g.runtime.79 -> $5
+	move	$6, $5		# runqtail.runtime.75 -> $6
+	# Store dirty variables back into memory
+	sw	$6, runqtail.runtime.75
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.ready
+runtime.park:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -32
+	lw	$5, runqhead.runtime.74	# runqhead.runtime.74 -> $5
+	move	$6, $5		# next.runtime.80 -> $6
+	# Store dirty variables back into memory
+	sw	$6, -4($fp)
+	bne	$6, 0, runtime.l198
+
+	li	$5, 1		# t164 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+	j	runtime.l199
+
+runtime.l198:
+	li	$5, 0		# t164 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+
+runtime.l199:
+	lw	$5, -8($fp)	# t164 -> $5
+	blt	$5, 1, runtime.l200
+
+	la	$5, msg.runtime.81.str
+	li	$2, 4
+	move	$4, $5
+	syscall
+	li	$4, 2
+	li	$2, 17
+	syscall
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+
+runtime.l200:
+	lw	$5, -4($fp)	# next.runtime.80 -> $5
+	lw	$6, 12($5)	# variable <- array
+	move	$5, $6		# runqhead.runtime.74 -> $5
+	# Store dirty variables back into memory
+	sw	$5, runqhead.runtime.74
+	sw	$6, -20($fp)
+	bne	$5, 0, runtime.l202
+
+	li	$5, 1		# t166 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+	j	runtime.l203
+
+runtime.l202:
+	li	$5, 0		# t166 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+
+runtime.l203:
+	lw	$5, -24($fp)	# t166 -> $5
+	blt	$5, 1, runtime.l204
+
+	li	$5, 0		# runqtail.runtime.75 -> $5
+	# Store dirty variables back into memory
+	sw	$5, runqtail.runtime.75
+
+runtime.l204:
+	jal	runtime.getg
+	move	$5, $2
+	move	$6, $5		# prev.runtime.82 -> $6
+	lw	$7, -4($fp)	# next.runtime.80 -> $7
+	move	$8, $7		# curg.runtime.73 -> $8
+	sw	$5, -28($fp)
+	sw	$6, -32($fp)
+	sw	$8, curg.runtime.73
+	lw	$24, -32($fp)
+	lw	$25, -4($fp)
+	sw	$sp, 0($24)
+	sw	$fp, 4($24)
+	la	$2, runtime.l206
+	sw	$2, 8($24)
+	lw	$sp, 0($25)
+	lw	$fp, 4($25)
+	lw	$2, 8($25)
+	jr	$2
+
+runtime.l206:
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.park
+runtime.goexit:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	jal	runtime.park
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.goexit
+runtime.findfunc:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -60
+	la	$5, runtime.functab
+	move	$6, $5		# tab.runtime.84 -> $6
+	lw	$7, 4($6)	# variable <- array
+	lw	$8, 8($fp)	# pc.runtime.83 -> $8
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	sw	$6, -8($fp)
+	sw	$7, -12($fp)
+	bge	$8, $7, runtime.l207
+
+	li	$5, 1		# t170 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+	j	runtime.l208
+
+runtime.l207:
+	li	$5, 0		# t170 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+
+runtime.l208:
+	lw	$5, -16($fp)	# t170 -> $5
+	blt	$5, 1, runtime.l209
+
+	li	$2, 0
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.findfunc
+runtime.l209:
+	li	$5, 1		# i.runtime.85 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+
+runtime.l217:
+	lw	$5, -20($fp)	# i.runtime.85 -> $5
+	addi	$6, $5, 1
+	lw	$5, -8($fp)	# tab.runtime.84 -> $5
+	sll	$24, $6, 2	# iterator *= 4
+	add	$24, $24, $5
+	lw	$7, 0($24)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$6, -24($fp)
+	sw	$7, -28($fp)
+	beq	$7, 0, runtime.l211
+
+	li	$5, 1		# t173 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+	j	runtime.l212
+
+runtime.l211:
+	li	$5, 0		# t173 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+
+runtime.l212:
+	lw	$5, -32($fp)	# t173 -> $5
+	beq	$5, 0, runtime.l216
+
+	lw	$5, -20($fp)	# i.runtime.85 -> $5
+	addi	$6, $5, 2
+	lw	$5, -8($fp)	# tab.runtime.84 -> $5
+	sll	$24, $6, 2	# iterator *= 4
+	add	$24, $24, $5
+	lw	$7, 0($24)	# variable <- array
+	lw	$5, 8($fp)	# pc.runtime.83 -> $5
+	# Store dirty variables back into memory
+	sw	$6, -36($fp)
+	sw	$7, -40($fp)
+	bgt	$7, $5, runtime.l213
+
+	li	$5, 1		# t176 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -44($fp)
+	j	runtime.l214
+
+runtime.l213:
+	li	$5, 0		# t176 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -44($fp)
+
+runtime.l214:
+	lw	$5, -44($fp)	# t176 -> $5
+	beq	$5, 0, runtime.l216
+
+	li	$5, 1		# t177 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -48($fp)
+	j	runtime.l215
+
+runtime.l216:
+	li	$5, 0		# t177 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -48($fp)
+
+runtime.l215:
+	lw	$5, -48($fp)	# t177 -> $5
+	blt	$5, 1, runtime.l218
+
+	lw	$5, -20($fp)	# i.runtime.85 -> $5
+	addi	$5, $5, 2
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	j	runtime.l217
+
+runtime.l218:
+	lw	$5, -20($fp)	# i.runtime.85 -> $5
+	addi	$6, $5, 1
+	lw	$5, -8($fp)	# tab.runtime.84 -> $5
+	sll	$24, $6, 2	# iterator *= 4
+	add	$24, $24, $5
+	lw	$7, 0($24)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$6, -52($fp)
+	sw	$7, -56($fp)
+	bne	$7, 0, runtime.l219
+
+	li	$5, 1		# t180 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -60($fp)
+	j	runtime.l220
+
+runtime.l219:
+	li	$5, 0		# t180 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -60($fp)
+
+runtime.l220:
+	lw	$5, -60($fp)	# t180 -> $5
+	blt	$5, 1, runtime.l221
+
+	li	$2, 0
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.findfunc
+runtime.l221:
+	lw	$2, -20($fp)
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.findfunc
+runtime.traceback:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -172
+	li	$5, 1		# id.runtime.86 -> $5
+	sw	$5, -4($fp)	# spilled id.runtime.86, freed $5
+	li	$5, 0		# base.runtime.87 -> $5
+	sw	$5, -8($fp)	# spilled base.runtime.87, freed $5
+	lw	$5, curg.runtime.73	# curg.runtime.73 -> $5
+	# Store dirty variables back into memory
+	beq	$5, 0, runtime.l223
+
+	li	$5, 1		# t181 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+	j	runtime.l224
+
+runtime.l223:
+	li	$5, 0		# t181 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+
+runtime.l224:
+	lw	$5, -12($fp)	# t181 -> $5
+	blt	$5, 1, runtime.l225
+
+	lw	$5, curg.runtime.73	# curg.runtime.73 -> $5
+	lw	$6, 16($5)	# variable <- array
+	move	$7, $6		# id.runtime.86 -> $7
+	sw	$7, -4($fp)	# spilled id.runtime.86, freed $7
+	lw	$7, 20($5)	# variable <- array
+	move	$8, $7		# base.runtime.87 -> $8
+	# Store dirty variables back into memory
+	sw	$6, -16($fp)
+	sw	$7, -20($fp)
+	sw	$8, -8($fp)
+
+runtime.l225:
+	la	$5, header.runtime.88.str
+	la	$6, running.runtime.89.str
+	la	$7, call.runtime.90.str
+	sw	$7, -40($fp)	# spilled call.runtime.90, freed $7
+	la	$7, createdBy.runtime.91.str
+	sw	$7, -48($fp)	# spilled createdBy.runtime.91, freed $7
+	la	$7, newline.runtime.92.str
+	sw	$7, -56($fp)	# spilled newline.runtime.92, freed $7
+	lw	$7, -4($fp)	# id.runtime.86 -> $7
+	addi	$sp, $sp, -4
+	sw	$7, 0($sp)
+	sw	$5, -24($fp)
+	sw	$6, -32($fp)
+	jal	runtime.itoa
+	addi	$sp, $sp, 4
+	move	$5, $2
+	lw	$6, -24($fp)	# header.runtime.88 -> $6
+	addi	$sp, $sp, -4
+	sw	$6, 0($sp)
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	sw	$5, -60($fp)
+	jal	runtime.concat
+	addi	$sp, $sp, 8
+	move	$5, $2
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	lw	$6, -32($fp)	# running.runtime.89 -> $6
+	addi	$sp, $sp, -4
+	sw	$6, 0($sp)
+	sw	$5, -64($fp)
+	jal	runtime.concat
+	addi	$sp, $sp, 8
+	move	$5, $2
+	move	$6, $5		# s.runtime.93 -> $6
+	sw	$6, -72($fp)	# spilled s.runtime.93, freed $6
+	la	$6, runtime.functab
+	move	$7, $6		# tab.runtime.94 -> $7
+	sw	$7, -80($fp)	# spilled tab.runtime.94, freed $7
+	move	$7, $fp
+	move	$8, $7		# fp.runtime.95 -> $8
+	# Store dirty variables back into memory
+	sw	$5, -68($fp)
+	sw	$6, -76($fp)
+	sw	$7, -84($fp)
+	sw	$8, -88($fp)
+
+runtime.l245:
+	lw	$5, -88($fp)	# fp.runtime.95 -> $5
+	beq	$5, 0, runtime.l227
+
+	li	$5, 1		# t189 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -92($fp)
+	j	runtime.l228
+
+runtime.l227:
+	li	$5, 0		# t189 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -92($fp)
+
+runtime.l228:
+	lw	$5, -92($fp)	# t189 -> $5
+	blt	$5, 1, runtime.l246
+
+	lw	$5, -88($fp)	# fp.runtime.95 -> $5
+	lw	$6, 4($5)	# variable <- array
+	move	$7, $6		# pc.runtime.96 -> $7
+	lw	$8, 0($5)	# variable <- array
+	move	$5, $8		# fp.runtime.95 -> $5
+	addi	$sp, $sp, -4
+	sw	$7, 0($sp)
+	sw	$5, -88($fp)
+	sw	$6, -96($fp)
+	sw	$7, -100($fp)
+	sw	$8, -104($fp)
+	jal	runtime.findfunc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# i.runtime.97 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -108($fp)
+	sw	$6, -112($fp)
+	bne	$6, 0, runtime.l229
+
+	li	$5, 1		# t193 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -116($fp)
+	j	runtime.l230
+
+runtime.l229:
+	li	$5, 0		# t193 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -116($fp)
+
+runtime.l230:
+	lw	$5, -116($fp)	# t193 -> $5
+	blt	$5, 1, runtime.l231
+
+	j	runtime.l245
+
+runtime.l231:
+	lw	$5, -112($fp)	# i.runtime.97 -> $5
+	addi	$6, $5, 1
+	lw	$5, -80($fp)	# tab.runtime.94 -> $5
+	sll	$24, $6, 2	# iterator *= 4
+	add	$24, $24, $5
+	lw	$7, 0($24)	# variable <- array
+	move	$5, $7		# name.runtime.98 -> $5
+	sw	$5, -128($fp)	# spilled name.runtime.98, freed $5
+	lw	$5, -8($fp)	# base.runtime.87 -> $5
+	# Store dirty variables back into memory
+	sw	$6, -120($fp)
+	sw	$7, -124($fp)
+	beq	$5, 0, runtime.l233
+
+	li	$5, 1		# t196 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -132($fp)
+	j	runtime.l234
+
+runtime.l233:
+	li	$5, 0		# t196 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -132($fp)
+
+runtime.l234:
+	lw	$5, -132($fp)	# t196 -> $5
+	beq	$5, 0, runtime.l238
+
+	lw	$5, -88($fp)	# fp.runtime.95 -> $5
+	lw	$6, -8($fp)	# base.runtime.87 -> $6
+	bne	$5, $6, runtime.l235
+
+	li	$5, 1		# t197 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -136($fp)
+	j	runtime.l236
+
+runtime.l235:
+	li	$5, 0		# t197 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -136($fp)
+
+runtime.l236:
+	lw	$5, -136($fp)	# t197 -> $5
+	beq	$5, 0, runtime.l238
+
+	li	$5, 1		# t198 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -140($fp)
+	j	runtime.l237
+
+runtime.l238:
+	li	$5, 0		# t198 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -140($fp)
+
+runtime.l237:
+	lw	$5, -140($fp)	# t198 -> $5
+	blt	$5, 1, runtime.l239
+
+	lw	$5, -72($fp)	# s.runtime.93 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	lw	$5, -48($fp)	# createdBy.runtime.91 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	jal	runtime.concat
+	addi	$sp, $sp, 8
+	move	$5, $2
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	lw	$6, -128($fp)	# name.runtime.98 -> $6
+	addi	$sp, $sp, -4
+	sw	$6, 0($sp)
+	sw	$5, -144($fp)
+	jal	runtime.concat
+	addi	$sp, $sp, 8
+	move	$5, $2
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	lw	$6, -56($fp)	# newline.runtime.92 -> $6
+	addi	$sp, $sp, -4
+	sw	$6, 0($sp)
+	sw	$5, -148($fp)
+	jal	runtime.concat
+	addi	$sp, $sp, 8
+	move	$5, $2
+	move	$6, $5		# s.runtime.93 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -152($fp)
+	sw	$6, -72($fp)
+	j	runtime.l246
+
+runtime.l239:
+	lw	$5, -72($fp)	# s.runtime.93 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	lw	$5, -128($fp)	# name.runtime.98 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	jal	runtime.concat
+	addi	$sp, $sp, 8
+	move	$5, $2
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	lw	$6, -40($fp)	# call.runtime.90 -> $6
+	addi	$sp, $sp, -4
+	sw	$6, 0($sp)
+	sw	$5, -156($fp)
+	jal	runtime.concat
+	addi	$sp, $sp, 8
+	move	$5, $2
+	move	$6, $5		# s.runtime.93 -> $6
+	sw	$6, -72($fp)	# spilled s.runtime.93, freed $6
+	lw	$6, -80($fp)	# tab.runtime.94 -> $6
+	lw	$7, -112($fp)	# i.runtime.97 -> $7
+	sll	$24, $7, 2	# iterator *= 4
+	add	$24, $24, $6
+	lw	$8, 0($24)	# variable <- array
+	lw	$7, 0($6)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$5, -160($fp)
+	sw	$7, -168($fp)
+	sw	$8, -164($fp)
+	bne	$8, $7, runtime.l241
+
+	li	$5, 1		# t206 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -172($fp)
+	j	runtime.l242
+
+runtime.l241:
+	li	$5, 0		# t206 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -172($fp)
+
+runtime.l242:
+	lw	$5, -172($fp)	# t206 -> $5
+	blt	$5, 1, runtime.l245
+
+	j	runtime.l246
+
+runtime.l246:
+	lw	$2, -72($fp)
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.traceback
+runtime.unwind:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -76
+	jal	runtime.getg
+	move	$5, $2
+	move	$6, $5		# g.runtime.100 -> $6
+	lw	$7, 24($6)	# variable <- array
+	move	$8, $7		# d.runtime.101 -> $8
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	sw	$6, -8($fp)
+	sw	$7, -12($fp)
+	sw	$8, -16($fp)
+	bne	$8, 0, runtime.l247
+
+	li	$5, 1		# t209 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	j	runtime.l248
+
+runtime.l247:
+	li	$5, 0		# t209 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+
+runtime.l248:
+	lw	$5, -20($fp)	# t209 -> $5
+	blt	$5, 1, runtime.l249
+
+	la	$5, prefix.runtime.102.str
+	la	$6, newline.runtime.103.str
+	lw	$7, 8($fp)	# p.runtime.99 -> $7
+	lw	$8, 0($7)	# variable <- array
+	move	$9, $8		# msg.runtime.104 -> $9
+	lw	$10, 8($7)	# variable <- array
+	move	$11, $10	# trace.runtime.105 -> $11
+	li	$2, 4
+	move	$4, $5
+	syscall
+	li	$2, 4
+	move	$4, $9
+	syscall
+	li	$2, 4
+	move	$4, $6
+	syscall
+	li	$2, 4
+	move	$4, $11
+	syscall
+	li	$4, 2
+	li	$2, 17
+	syscall
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+	sw	$6, -32($fp)
+	sw	$8, -36($fp)
+	sw	$9, -40($fp)
+	sw	$10, -44($fp)
+	sw	$11, -48($fp)
+
+runtime.l249:
+	lw	$5, -16($fp)	# d.runtime.101 -> $5
+	lw	$6, 4($5)	# variable <- array
+	lw	$7, 8($fp)	# p.runtime.99 -> $7
+	sw	$6, 12($7)	# variable -> array
+	li	$25, 12
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	sw	$6, -52($fp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# ctx.runtime.106 -> $6
+	move	$7, $fp
+	sw	$7, 0($6)	# variable -> array
+	lw	$8, -16($fp)	# d.runtime.101 -> $8
+	lw	$9, 4($8)	# variable <- array
+	sw	$9, 4($6)	# variable -> array
+	lw	$10, 8($8)	# variable <- array
+	sw	$10, 8($6)	# variable -> array
+	li	$25, 12
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	sw	$5, -56($fp)
+	sw	$6, -60($fp)
+	sw	$7, -64($fp)
+	sw	$9, -68($fp)
+	sw	$10, -72($fp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	sw	$5, -76($fp)
+	lw	$24, -76($fp)
+	lw	$25, -60($fp)
+	sw	$sp, 0($24)
+	sw	$fp, 4($24)
+	la	$2, runtime.l251
+	sw	$2, 8($24)
+	lw	$sp, 0($25)
+	lw	$fp, 4($25)
+	lw	$2, 8($25)
+	jr	$2
+
+runtime.l251:
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.unwind
+runtime.gopanic:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -28
+	jal	runtime.getg
+	move	$5, $2
+	move	$6, $5		# g.runtime.108 -> $6
+	lw	$7, 28($6)	# variable <- array
+	move	$8, $7		# p.runtime.109 -> $8
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	sw	$6, -8($fp)
+	sw	$7, -12($fp)
+	sw	$8, -16($fp)
+	bne	$8, 0, runtime.l252
+
+	li	$5, 1		# t220 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	j	runtime.l253
+
+runtime.l252:
+	li	$5, 0		# t220 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+
+runtime.l253:
+	lw	$5, -20($fp)	# t220 -> $5
+	blt	$5, 1, runtime.l254
+
+	li	$25, 16
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# p.runtime.109 -> $6
+	lw	$7, -8($fp)	# g.runtime.108 -> $7
+	sw	$6, 28($7)	# variable -> array
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+	sw	$6, -16($fp)
+
+runtime.l254:
+	lw	$5, -16($fp)	# p.runtime.109 -> $5
+	lw	$6, 8($fp)	# msg.runtime.107 -> $6
+	sw	$6, 0($5)	# variable -> array
+	li	$25, 0 	# const value -> $25
+	sw	$25, 4($5)	# variable -> array
+	jal	runtime.traceback
+	move	$5, $2
+	lw	$6, -16($fp)	# p.runtime.109 -> $6
+	sw	$5, 8($6)	# variable -> array
+	addi	$sp, $sp, -4
+	sw	$6, 0($sp)
+	sw	$5, -28($fp)
+	jal	runtime.unwind
+	addi	$sp, $sp, 4
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.gopanic
+runtime.deferproc:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -28
+	jal	runtime.getg
+	move	$5, $2
+	move	$6, $5		# g.runtime.114 -> $6
+	lw	$7, 8($fp)	# n.runtime.113 -> $7
+	mul	$8, $7, 4
+	addi	$7, $8, 16
+	addi	$sp, $sp, -4
+	sw	$7, 0($sp)
+	sw	$5, -4($fp)
+	sw	$6, -8($fp)
+	sw	$7, -16($fp)
+	sw	$8, -12($fp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# d.runtime.115 -> $6
+	lw	$7, -8($fp)	# g.runtime.114 -> $7
+	lw	$8, 24($7)	# variable <- array
+	sw	$8, 0($6)	# variable -> array
+	lw	$9, 20($fp)	# fp.runtime.110 -> $9
+	sw	$9, 4($6)	# variable -> array
+	lw	$9, 16($fp)	# pc.runtime.111 -> $9
+	sw	$9, 8($6)	# variable -> array
+	lw	$9, 12($fp)	# site.runtime.112 -> $9
+	sw	$9, 12($6)	# variable -> array
+	sw	$6, 24($7)	# variable -> array
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	sw	$6, -24($fp)
+	sw	$8, -28($fp)
+	move	$2, $6
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.deferproc
+runtime.deferpop:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -36
+	jal	runtime.getg
+	move	$5, $2
+	move	$6, $5		# g.runtime.117 -> $6
+	lw	$7, 24($6)	# variable <- array
+	move	$8, $7		# d.runtime.118 -> $8
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	sw	$6, -8($fp)
+	sw	$7, -12($fp)
+	sw	$8, -16($fp)
+	bne	$8, 0, runtime.l256
+
+	li	$5, 1		# t230 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	j	runtime.l257
+
+runtime.l256:
+	li	$5, 0		# t230 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+
+runtime.l257:
+	lw	$5, -20($fp)	# t230 -> $5
+	beq	$5, 1, runtime.l261
+
+	lw	$5, -16($fp)	# d.runtime.118 -> $5
+	lw	$6, 4($5)	# variable <- array
+	lw	$5, 8($fp)	# fp.runtime.116 -> $5
+	# Store dirty variables back into memory
+	sw	$6, -24($fp)
+	beq	$6, $5, runtime.l258
+
+	li	$5, 1		# t232 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -28($fp)
+	j	runtime.l259
+
+runtime.l258:
+	li	$5, 0		# t232 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -28($fp)
+
+runtime.l259:
+	lw	$5, -28($fp)	# t232 -> $5
+	beq	$5, 1, runtime.l261
+
+	li	$5, 0		# t233 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+	j	runtime.l260
+
+runtime.l261:
+	li	$5, 1		# t233 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+
+runtime.l260:
+	lw	$5, -32($fp)	# t233 -> $5
+	blt	$5, 1, runtime.l262
+
+	li	$2, 0
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.deferpop
+runtime.l262:
+	lw	$5, -16($fp)	# d.runtime.118 -> $5
+	lw	$6, 0($5)	# variable <- array
+	lw	$7, -8($fp)	# g.runtime.117 -> $7
+	sw	$6, 24($7)	# variable -> array
+	# Store dirty variables back into memory
+	sw	$6, -36($fp)
+	move	$2, $5
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.deferpop
+runtime.deferreturn:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -40
+	jal	runtime.getg
+	move	$5, $2
+	move	$6, $5		# g.runtime.120 -> $6
+	lw	$7, 28($6)	# variable <- array
+	move	$8, $7		# p.runtime.121 -> $8
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	sw	$6, -8($fp)
+	sw	$7, -12($fp)
+	sw	$8, -16($fp)
+	bne	$8, 0, runtime.l264
+
+	li	$5, 1		# t237 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	j	runtime.l265
+
+runtime.l264:
+	li	$5, 0		# t237 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+
+runtime.l265:
+	lw	$5, -20($fp)	# t237 -> $5
+	beq	$5, 1, runtime.l269
+
+	lw	$5, -16($fp)	# p.runtime.121 -> $5
+	lw	$6, 12($5)	# variable <- array
+	lw	$5, 8($fp)	# fp.runtime.119 -> $5
+	# Store dirty variables back into memory
+	sw	$6, -24($fp)
+	beq	$6, $5, runtime.l266
+
+	li	$5, 1		# t239 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -28($fp)
+	j	runtime.l267
+
+runtime.l266:
+	li	$5, 0		# t239 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -28($fp)
+
+runtime.l267:
+	lw	$5, -28($fp)	# t239 -> $5
+	beq	$5, 1, runtime.l269
+
+	li	$5, 0		# t240 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+	j	runtime.l268
+
+runtime.l269:
+	li	$5, 1		# t240 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+
+runtime.l268:
+	lw	$5, -32($fp)	# t240 -> $5
+	blt	$5, 1, runtime.l270
+
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.deferreturn
+runtime.l270:
+	lw	$5, -16($fp)	# p.runtime.121 -> $5
+	lw	$6, 4($5)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$6, -36($fp)
+	bne	$6, 0, runtime.l272
+
+	li	$5, 1		# t242 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -40($fp)
+	j	runtime.l273
+
+runtime.l272:
+	li	$5, 0		# t242 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -40($fp)
+
+runtime.l273:
+	lw	$5, -40($fp)	# t242 -> $5
+	blt	$5, 1, runtime.l274
+
+	lw	$5, -16($fp)	# p.runtime.121 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	jal	runtime.unwind
+	addi	$sp, $sp, 4
+
+runtime.l274:
+	lw	$5, -8($fp)	# g.runtime.120 -> $5
+	li	$25, 0 	# const value -> $25
+	sw	$25, 28($5)	# variable -> array
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.deferreturn
+runtime.gorecover:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -32
+	jal	runtime.getg
+	move	$5, $2
+	lw	$6, 28($5)	# variable <- array
+	move	$7, $6		# p.runtime.122 -> $7
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	sw	$6, -8($fp)
+	sw	$7, -12($fp)
+	bne	$7, 0, runtime.l276
+
+	li	$5, 1		# t245 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+	j	runtime.l277
+
+runtime.l276:
+	li	$5, 0		# t245 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+
+runtime.l277:
+	lw	$5, -16($fp)	# t245 -> $5
+	beq	$5, 1, runtime.l281
+
+	lw	$5, -12($fp)	# p.runtime.122 -> $5
+	lw	$6, 4($5)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$6, -20($fp)
+	beq	$6, 0, runtime.l278
+
+	li	$5, 1		# t247 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+	j	runtime.l279
+
+runtime.l278:
+	li	$5, 0		# t247 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+
+runtime.l279:
+	lw	$5, -24($fp)	# t247 -> $5
+	beq	$5, 1, runtime.l281
+
+	li	$5, 0		# t248 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -28($fp)
+	j	runtime.l280
+
+runtime.l281:
+	li	$5, 1		# t248 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -28($fp)
+
+runtime.l280:
+	lw	$5, -28($fp)	# t248 -> $5
+	blt	$5, 1, runtime.l282
+
+	li	$2, 0
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.gorecover
+runtime.l282:
+	lw	$5, -12($fp)	# p.runtime.122 -> $5
+	li	$25, 1 	# const value -> $25
+	sw	$25, 4($5)	# variable -> array
+	lw	$6, 0($5)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$6, -32($fp)
+	move	$2, $6
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.gorecover
+runtime.panicNilMap:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -8
+	la	$5, msg.runtime.123.str
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	sw	$5, -4($fp)
+	jal	runtime.gopanic
+	addi	$sp, $sp, 4
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.panicNilMap
+runtime.hashkey:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -36
+	lw	$5, 8($fp)	# k.runtime.125 -> $5
+	move	$6, $5		# h.runtime.126 -> $6
+	lw	$5, 12($fp)	# m.runtime.124 -> $5
+	sw	$6, -4($fp)	# spilled h.runtime.126, freed $6
+	lw	$6, 12($5)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$6, -8($fp)
+	beq	$6, 0, runtime.l284
+
+	li	$5, 1		# t251 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+	j	runtime.l285
+
+runtime.l284:
+	li	$5, 0		# t251 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+
+runtime.l285:
+	lw	$5, -12($fp)	# t251 -> $5
+	blt	$5, 1, runtime.l286
+
+	lw	$5, 8($fp)	# k.runtime.125 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	jal	runtime.strhash
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# h.runtime.126 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+	sw	$6, -4($fp)
+
+runtime.l286:
+	lw	$5, -4($fp)	# h.runtime.126 -> $5
+	sra	$6, $5, 16
+	xor	$7, $5, $6
+	move	$5, $7		# h.runtime.126 -> $5
+	lw	$8, 12($fp)	# m.runtime.124 -> $8
+	lw	$9, 4($8)	# variable <- array
+	sub	$8, $9, 1
+	and	$10, $5, $8
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	sw	$6, -20($fp)
+	sw	$7, -24($fp)
+	sw	$8, -32($fp)
+	sw	$9, -28($fp)
+	sw	$10, -36($fp)
+	move	$2, $10
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.hashkey
+runtime.keyequal:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -16
+	lw	$5, 16($fp)	# m.runtime.127 -> $5
+	lw	$6, 12($5)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$6, -4($fp)
+	beq	$6, 0, runtime.l288
+
+	li	$5, 1		# t259 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+	j	runtime.l289
+
+runtime.l288:
+	li	$5, 0		# t259 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+
+runtime.l289:
+	lw	$5, -8($fp)	# t259 -> $5
+	blt	$5, 1, runtime.l290
+
+	lw	$5, 12($fp)	# a.runtime.128 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	lw	$5, 8($fp)	# b.runtime.129 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	jal	runtime.strequal
+	addi	$sp, $sp, 8
+	move	$5, $2
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+	move	$2, $5
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.keyequal
+runtime.l290:
+	lw	$5, 12($fp)	# a.runtime.128 -> $5
+	lw	$6, 8($fp)	# b.runtime.129 -> $6
+	bne	$5, $6, runtime.l292
+
+	li	$5, 1		# t261 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+	j	runtime.l293
+
+runtime.l292:
+	li	$5, 0		# t261 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+
+runtime.l293:
+	lw	$5, -16($fp)	# t261 -> $5
+	blt	$5, 1, runtime.l294
+
+	li	$2, 1
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.keyequal
+runtime.l294:
+	li	$2, 0
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.keyequal
+runtime.mapaccess:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -44
+	lw	$5, 12($fp)	# m.runtime.130 -> $5
+	bne	$5, 0, runtime.l296
+
+	li	$5, 1		# t262 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	j	runtime.l297
+
+runtime.l296:
+	li	$5, 0		# t262 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+
+runtime.l297:
+	lw	$5, -4($fp)	# t262 -> $5
+	blt	$5, 1, runtime.l298
+
+	li	$2, 0
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.mapaccess
+runtime.l298:
+	lw	$5, 12($fp)	# m.runtime.130 -> $5
+	lw	$6, 8($5)	# variable <- array
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	lw	$7, 8($fp)	# k.runtime.131 -> $7
+	addi	$sp, $sp, -4
+	sw	$7, 0($sp)
+	sw	$6, -8($fp)
+	jal	runtime.hashkey
+	addi	$sp, $sp, 8
+	move	$5, $2
+	lw	$6, -8($fp)	# t263 -> $6
+	sll	$24, $5, 2	# iterator *= 4
+	add	$24, $24, $6
+	lw	$7, 0($24)	# variable <- array
+	move	$6, $7		# e.runtime.132 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+	sw	$6, -20($fp)
+	sw	$7, -16($fp)
+
+runtime.l306:
+	lw	$5, -20($fp)	# e.runtime.132 -> $5
+	beq	$5, 0, runtime.l300
+
+	li	$5, 1		# t266 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+	j	runtime.l301
+
+runtime.l300:
+	li	$5, 0		# t266 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+
+runtime.l301:
+	lw	$5, -24($fp)	# t266 -> $5
+	blt	$5, 1, runtime.l307
+
+	lw	$5, -20($fp)	# e.runtime.132 -> $5
+	lw	$6, 0($5)	# variable <- array
+	lw	$5, 12($fp)	# m.runtime.130 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	addi	$sp, $sp, -4
+	sw	$6, 0($sp)
+	lw	$5, 8($fp)	# k.runtime.131 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	sw	$6, -28($fp)
+	jal	runtime.keyequal
+	addi	$sp, $sp, 12
+	move	$5, $2
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+	beq	$5, 0, runtime.l302
+
+	li	$5, 1		# t269 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -36($fp)
+	j	runtime.l303
+
+runtime.l302:
+	li	$5, 0		# t269 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -36($fp)
+
+runtime.l303:
+	lw	$5, -36($fp)	# t269 -> $5
+	blt	$5, 1, runtime.l304
+
+	lw	$5, -20($fp)	# e.runtime.132 -> $5
+	addi	$6, $5, 4
+	# Store dirty variables back into memory
+	sw	$6, -40($fp)
+	move	$2, $6
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.mapaccess
+runtime.l304:
+	lw	$5, -20($fp)	# e.runtime.132 -> $5
+	lw	$6, 8($5)	# variable <- array
+	move	$5, $6		# e.runtime.132 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	sw	$6, -44($fp)
+	j	runtime.l306
+
+runtime.l307:
+	li	$2, 0
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.mapaccess
+runtime.mapgrow:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -76
+	lw	$5, 8($fp)	# m.runtime.133 -> $5
+	lw	$6, 4($5)	# variable <- array
+	move	$7, $6		# nb.runtime.134 -> $7
+	lw	$8, 8($5)	# variable <- array
+	move	$9, $8		# old.runtime.135 -> $9
+	sw	$9, -16($fp)	# spilled old.runtime.135, freed $9
+	mul	$9, $7, 8
+	addi	$sp, $sp, -4
+	sw	$9, 0($sp)
+	sw	$6, -4($fp)
+	sw	$7, -8($fp)
+	sw	$8, -12($fp)
+	sw	$9, -20($fp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# buckets.runtime.136 -> $6
+	lw	$7, -8($fp)	# nb.runtime.134 -> $7
+	mul	$8, $7, 2
+	lw	$7, 8($fp)	# m.runtime.133 -> $7
+	sw	$8, 4($7)	# variable -> array
+	sw	$6, 8($7)	# variable -> array
+	li	$9, 0		# i.runtime.137 -> $9
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+	sw	$6, -28($fp)
+	sw	$8, -32($fp)
+	sw	$9, -36($fp)
+
+runtime.l314:
+	lw	$5, -36($fp)	# i.runtime.137 -> $5
+	lw	$6, -8($fp)	# nb.runtime.134 -> $6
+	bge	$5, $6, runtime.l308
+
+	li	$5, 1		# t277 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -40($fp)
+	j	runtime.l309
+
+runtime.l308:
+	li	$5, 0		# t277 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -40($fp)
+
+runtime.l309:
+	lw	$5, -40($fp)	# t277 -> $5
+	blt	$5, 1, runtime.l315
+
+	lw	$5, -16($fp)	# old.runtime.135 -> $5
+	lw	$6, -36($fp)	# i.runtime.137 -> $6
+	sll	$24, $6, 2	# iterator *= 4
+	add	$24, $24, $5
+	lw	$7, 0($24)	# variable <- array
+	move	$5, $7		# e.runtime.138 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -48($fp)
+	sw	$7, -44($fp)
+
+runtime.l312:
+	lw	$5, -48($fp)	# e.runtime.138 -> $5
+	beq	$5, 0, runtime.l310
+
+	li	$5, 1		# t279 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -52($fp)
+	j	runtime.l311
+
+runtime.l310:
+	li	$5, 0		# t279 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -52($fp)
+
+runtime.l311:
+	lw	$5, -52($fp)	# t279 -> $5
+	blt	$5, 1, runtime.l313
+
+	lw	$5, -48($fp)	# e.runtime.138 -> $5
+	lw	$6, 8($5)	# variable <- array
+	move	$7, $6		# next.runtime.139 -> $7
+	lw	$8, 0($5)	# variable <- array
+	lw	$9, 8($fp)	# m.runtime.133 -> $9
+	addi	$sp, $sp, -4
+	sw	$9, 0($sp)
+	addi	$sp, $sp, -4
+	sw	$8, 0($sp)
+	sw	$6, -56($fp)
+	sw	$7, -60($fp)
+	sw	$8, -64($fp)
+	jal	runtime.hashkey
+	addi	$sp, $sp, 8
+	move	$5, $2
+	move	$6, $5		# j.runtime.140 -> $6
+	lw	$7, -28($fp)	# buckets.runtime.136 -> $7
+	sll	$24, $6, 2	# iterator *= 4
+	add	$24, $24, $7
+	lw	$8, 0($24)	# variable <- array
+	lw	$9, -48($fp)	# e.runtime.138 -> $9
+	sw	$8, 8($9)	# variable -> array
+	sll	$24, $6, 2	# iterator *= 4
+	add	$24, $24, $7
+	sw	$9, 0($24)	# variable -> array
+	lw	$10, -60($fp)	# next.runtime.139 -> $10
+	move	$9, $10		# e.runtime.138 -> $9
+	# Store dirty variables back into memory
+	sw	$5, -68($fp)
+	sw	$6, -72($fp)
+	sw	$8, -76($fp)
+	sw	$9, -48($fp)
+	j	runtime.l312
+
+runtime.l313:
+	lw	$5, -36($fp)	# i.runtime.137 -> $5
+	addi	$5, $5, 1
+	# Store dirty variables back into memory
+	sw	$5, -36($fp)
+	j	runtime.l314
+
+runtime.l315:
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.mapgrow
+runtime.mapassign:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -72
+	lw	$5, 12($fp)	# m.runtime.141 -> $5
+	bne	$5, 0, runtime.l316
+
+	li	$5, 1		# t284 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	j	runtime.l317
+
+runtime.l316:
+	li	$5, 0		# t284 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+
+runtime.l317:
+	lw	$5, -4($fp)	# t284 -> $5
+	blt	$5, 1, runtime.l318
+
+	jal	runtime.panicNilMap
+
+runtime.l318:
+	lw	$5, 12($fp)	# m.runtime.141 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	lw	$5, 8($fp)	# k.runtime.142 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	jal	runtime.mapaccess
+	addi	$sp, $sp, 8
+	move	$5, $2
+	move	$6, $5		# p.runtime.143 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+	sw	$6, -12($fp)
+	beq	$6, 0, runtime.l320
+
+	li	$5, 1		# t286 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+	j	runtime.l321
+
+runtime.l320:
+	li	$5, 0		# t286 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+
+runtime.l321:
+	lw	$5, -16($fp)	# t286 -> $5
+	blt	$5, 1, runtime.l322
+
+	lw	$2, -12($fp)
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.mapassign
+runtime.l322:
+	lw	$5, 12($fp)	# m.runtime.141 -> $5
+	lw	$6, 0($5)	# variable <- array
+	lw	$7, 4($5)	# variable <- array
+	mul	$8, $7, 2
+	# Store dirty variables back into memory
+	sw	$6, -20($fp)
+	sw	$7, -24($fp)
+	sw	$8, -28($fp)
+	blt	$6, $8, runtime.l324
+
+	li	$5, 1		# t290 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+	j	runtime.l325
+
+runtime.l324:
+	li	$5, 0		# t290 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+
+runtime.l325:
+	lw	$5, -32($fp)	# t290 -> $5
+	blt	$5, 1, runtime.l326
+
+	lw	$5, 12($fp)	# m.runtime.141 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	jal	runtime.mapgrow
+	addi	$sp, $sp, 4
+
+runtime.l326:
+	li	$25, 12
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# e.runtime.144 -> $6
+	lw	$7, 8($fp)	# k.runtime.142 -> $7
+	sw	$7, 0($6)	# variable -> array
+	lw	$8, 12($fp)	# m.runtime.141 -> $8
+	lw	$9, 8($8)	# variable <- array
+	move	$10, $9		# b.runtime.145 -> $10
+	addi	$sp, $sp, -4
+	sw	$8, 0($sp)
+	addi	$sp, $sp, -4
+	sw	$7, 0($sp)
+	sw	$5, -36($fp)
+	sw	$6, -40($fp)
+	sw	$9, -44($fp)
+	sw	$10, -48($fp)
+	jal	runtime.hashkey
+	addi	$sp, $sp, 8
+	move	$5, $2
+	move	$6, $5		# i.runtime.146 -> $6
+	lw	$7, -48($fp)	# b.runtime.145 -> $7
+	sll	$24, $6, 2	# iterator *= 4
+	add	$24, $24, $7
+	lw	$8, 0($24)	# variable <- array
+	lw	$9, -40($fp)	# e.runtime.144 -> $9
+	sw	$8, 8($9)	# variable -> array
+	sll	$24, $6, 2	# iterator *= 4
+	add	$24, $24, $7
+	sw	$9, 0($24)	# variable -> array
+	lw	$10, 12($fp)	# m.runtime.141 -> $10
+	lw	$11, 0($10)	# variable <- array
+	addi	$12, $11, 1
+	sw	$12, 0($10)	# variable -> array
+	addi	$13, $9, 4
+	# Store dirty variables back into memory
+	sw	$5, -52($fp)
+	sw	$6, -56($fp)
+	sw	$8, -60($fp)
+	sw	$11, -64($fp)
+	sw	$12, -68($fp)
+	sw	$13, -72($fp)
+	move	$2, $13
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.mapassign
+runtime.mapdelete:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -72
+	lw	$5, 12($fp)	# m.runtime.147 -> $5
+	bne	$5, 0, runtime.l328
+
+	li	$5, 1		# t298 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	j	runtime.l329
+
+runtime.l328:
+	li	$5, 0		# t298 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+
+runtime.l329:
+	lw	$5, -4($fp)	# t298 -> $5
+	blt	$5, 1, runtime.l330
+
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.mapdelete
+runtime.l330:
+	lw	$5, 12($fp)	# m.runtime.147 -> $5
+	lw	$6, 8($5)	# variable <- array
+	move	$7, $6		# b.runtime.149 -> $7
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	lw	$8, 8($fp)	# k.runtime.148 -> $8
+	addi	$sp, $sp, -4
+	sw	$8, 0($sp)
+	sw	$6, -8($fp)
+	sw	$7, -12($fp)
+	jal	runtime.hashkey
+	addi	$sp, $sp, 8
+	move	$5, $2
+	move	$6, $5		# i.runtime.150 -> $6
+	li	$7, 0		# prev.runtime.151 -> $7
+	sw	$7, -24($fp)	# spilled prev.runtime.151, freed $7
+	lw	$7, -12($fp)	# b.runtime.149 -> $7
+	sll	$24, $6, 2	# iterator *= 4
+	add	$24, $24, $7
+	lw	$8, 0($24)	# variable <- array
+	move	$7, $8		# e.runtime.152 -> $7
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+	sw	$6, -20($fp)
+	sw	$7, -32($fp)
+	sw	$8, -28($fp)
+
+runtime.l342:
+	lw	$5, -32($fp)	# e.runtime.152 -> $5
+	beq	$5, 0, runtime.l332
+
+	li	$5, 1		# t302 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -36($fp)
+	j	runtime.l333
+
+runtime.l332:
+	li	$5, 0		# t302 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -36($fp)
+
+runtime.l333:
+	lw	$5, -36($fp)	# t302 -> $5
+	blt	$5, 1, runtime.l343
+
+	lw	$5, -32($fp)	# e.runtime.152 -> $5
+	lw	$6, 0($5)	# variable <- array
+	lw	$5, 12($fp)	# m.runtime.147 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	addi	$sp, $sp, -4
+	sw	$6, 0($sp)
+	lw	$5, 8($fp)	# k.runtime.148 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	sw	$6, -40($fp)
+	jal	runtime.keyequal
+	addi	$sp, $sp, 12
+	move	$5, $2
+	# Store dirty variables back into memory
+	sw	$5, -44($fp)
+	beq	$5, 0, runtime.l334
+
+	li	$5, 1		# t305 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -48($fp)
+	j	runtime.l335
+
+runtime.l334:
+	li	$5, 0		# t305 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -48($fp)
+
+runtime.l335:
+	lw	$5, -48($fp)	# t305 -> $5
+	blt	$5, 1, runtime.l340
+
+	lw	$5, -24($fp)	# prev.runtime.151 -> $5
+	bne	$5, 0, runtime.l336
+
+	li	$5, 1		# t306 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -52($fp)
+	j	runtime.l337
+
+runtime.l336:
+	li	$5, 0		# t306 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -52($fp)
+
+runtime.l337:
+	lw	$5, -52($fp)	# t306 -> $5
+	blt	$5, 1, runtime.l339
+
+	lw	$5, -32($fp)	# e.runtime.152 -> $5
+	lw	$6, 8($5)	# variable <- array
+	lw	$5, -12($fp)	# b.runtime.149 -> $5
+	lw	$7, -20($fp)	# i.runtime.150 -> $7
+	sll	$24, $7, 2	# iterator *= 4
+	add	$24, $24, $5
+	sw	$6, 0($24)	# variable -> array
+	# Store dirty variables back into memory
+	sw	$6, -56($fp)
+	j	runtime.l338
+
+runtime.l339:
+	lw	$5, -32($fp)	# e.runtime.152 -> $5
+	lw	$6, 8($5)	# variable <- array
+	lw	$5, -24($fp)	# prev.runtime.151 -> $5
+	sw	$6, 8($5)	# variable -> array
+	# Store dirty variables back into memory
+	sw	$6, -60($fp)
+
+runtime.l338:
+	lw	$5, 12($fp)	# m.runtime.147 -> $5
+	lw	$6, 0($5)	# variable <- array
+	sub	$7, $6, 1
+	sw	$7, 0($5)	# variable -> array
+	# Store dirty variables back into memory
+	sw	$6, -64($fp)
+	sw	$7, -68($fp)
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.mapdelete
+runtime.l340:
+	lw	$5, -32($fp)	# e.runtime.152 -> $5
+	move	$6, $5		# prev.runtime.151 -> $6
+	sw	$6, -24($fp)	# spilled prev.runtime.151, freed $6
+	lw	$6, 8($5)	# variable <- array
+	move	$5, $6		# e.runtime.152 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+	sw	$6, -72($fp)
+	j	runtime.l342
+
+runtime.l343:
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.mapdelete
+runtime.maplen:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -8
+	lw	$5, 8($fp)	# m.runtime.153 -> $5
+	bne	$5, 0, runtime.l344
+
+	li	$5, 1		# t312 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	j	runtime.l345
+
+runtime.l344:
+	li	$5, 0		# t312 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+
+runtime.l345:
+	lw	$5, -4($fp)	# t312 -> $5
+	blt	$5, 1, runtime.l346
+
+	li	$2, 0
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.maplen
+runtime.l346:
+	lw	$5, 8($fp)	# m.runtime.153 -> $5
+	lw	$6, 0($5)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$6, -8($fp)
+	move	$2, $6
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.maplen
+runtime.mapiterinit:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -8
+	li	$25, 12
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# it.runtime.155 -> $6
+	lw	$7, 8($fp)	# m.runtime.154 -> $7
+	sw	$7, 0($6)	# variable -> array
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	sw	$6, -8($fp)
+	move	$2, $6
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.mapiterinit
+runtime.mapiternext:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -52
+	lw	$5, 8($fp)	# it.runtime.156 -> $5
+	lw	$6, 0($5)	# variable <- array
+	move	$5, $6		# m.runtime.157 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+	sw	$6, -4($fp)
+	bne	$5, 0, runtime.l348
+
+	li	$5, 1		# t316 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+	j	runtime.l349
+
+runtime.l348:
+	li	$5, 0		# t316 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+
+runtime.l349:
+	lw	$5, -12($fp)	# t316 -> $5
+	blt	$5, 1, runtime.l350
+
+	li	$2, 0
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.mapiternext
+runtime.l350:
+	lw	$5, 8($fp)	# it.runtime.156 -> $5
+	lw	$6, 8($5)	# variable <- array
+	move	$7, $6		# e.runtime.158 -> $7
+	sw	$7, -20($fp)	# spilled e.runtime.158, freed $7
+	lw	$7, 4($5)	# variable <- array
+	move	$8, $7		# i.runtime.159 -> $8
+	# Store dirty variables back into memory
+	sw	$6, -16($fp)
+	sw	$7, -24($fp)
+	sw	$8, -28($fp)
+
+runtime.l358:
+	lw	$5, -20($fp)	# e.runtime.158 -> $5
+	bne	$5, 0, runtime.l352
+
+	li	$5, 1		# t319 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+	j	runtime.l353
+
+runtime.l352:
+	li	$5, 0		# t319 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+
+runtime.l353:
+	lw	$5, -32($fp)	# t319 -> $5
+	blt	$5, 1, runtime.l359
+
+	lw	$5, -8($fp)	# m.runtime.157 -> $5
+	lw	$6, 4($5)	# variable <- array
+	lw	$5, -28($fp)	# i.runtime.159 -> $5
+	# Store dirty variables back into memory
+	sw	$6, -36($fp)
+	blt	$5, $6, runtime.l354
+
+	li	$5, 1		# t321 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -40($fp)
+	j	runtime.l355
+
+runtime.l354:
+	li	$5, 0		# t321 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -40($fp)
+
+runtime.l355:
+	lw	$5, -40($fp)	# t321 -> $5
+	blt	$5, 1, runtime.l356
+
+	li	$2, 0
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.mapiternext
+runtime.l356:
+	lw	$5, -8($fp)	# m.runtime.157 -> $5
+	lw	$6, 8($5)	# variable <- array
+	lw	$5, -28($fp)	# i.runtime.159 -> $5
+	sll	$24, $5, 2	# iterator *= 4
+	add	$24, $24, $6
+	lw	$7, 0($24)	# variable <- array
+	move	$8, $7		# e.runtime.158 -> $8
+	addi	$5, $5, 1
+	# Store dirty variables back into memory
+	sw	$5, -28($fp)
+	sw	$6, -44($fp)
+	sw	$7, -48($fp)
+	sw	$8, -20($fp)
+	j	runtime.l358
+
+runtime.l359:
+	lw	$5, 8($fp)	# it.runtime.156 -> $5
+	lw	$6, -28($fp)	# i.runtime.159 -> $6
+	sw	$6, 4($5)	# variable -> array
+	lw	$6, -20($fp)	# e.runtime.158 -> $6
+	lw	$7, 8($6)	# variable <- array
+	sw	$7, 8($5)	# variable -> array
+	# Store dirty variables back into memory
+	sw	$7, -52($fp)
+	move	$2, $6
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.mapiternext
+runtime.panicIndex:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -36
+	la	$5, msg.runtime.162.str
+	la	$6, withLen.runtime.163.str
+	lw	$7, 12($fp)	# i.runtime.160 -> $7
+	addi	$sp, $sp, -4
+	sw	$7, 0($sp)
+	sw	$5, -4($fp)
+	sw	$6, -12($fp)
+	jal	runtime.itoa
+	addi	$sp, $sp, 4
+	move	$5, $2
+	lw	$6, -4($fp)	# msg.runtime.162 -> $6
+	addi	$sp, $sp, -4
+	sw	$6, 0($sp)
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	sw	$5, -20($fp)
+	jal	runtime.concat
+	addi	$sp, $sp, 8
+	move	$5, $2
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	lw	$6, -12($fp)	# withLen.runtime.163 -> $6
+	addi	$sp, $sp, -4
+	sw	$6, 0($sp)
+	sw	$5, -24($fp)
+	jal	runtime.concat
+	addi	$sp, $sp, 8
+	move	$5, $2
+	lw	$6, 8($fp)	# n.runtime.161 -> $6
+	addi	$sp, $sp, -4
+	sw	$6, 0($sp)
+	sw	$5, -28($fp)
+	jal	runtime.itoa
+	addi	$sp, $sp, 4
+	move	$5, $2
+	lw	$6, -28($fp)	# t327 -> $6
+	addi	$sp, $sp, -4
+	sw	$6, 0($sp)
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	sw	$5, -32($fp)
+	jal	runtime.concat
+	addi	$sp, $sp, 8
+	move	$5, $2
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	sw	$5, -36($fp)
+	jal	runtime.gopanic
+	addi	$sp, $sp, 4
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.panicIndex
+runtime.panicSlice:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -8
+	la	$5, msg.runtime.164.str
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	sw	$5, -4($fp)
+	jal	runtime.gopanic
+	addi	$sp, $sp, 4
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.panicSlice
+runtime.panicMakeSlice:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -8
+	la	$5, msg.runtime.165.str
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	sw	$5, -4($fp)
+	jal	runtime.gopanic
+	addi	$sp, $sp, 4
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.panicMakeSlice
+runtime.panicDivide:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -8
+	la	$5, msg.runtime.166.str
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	sw	$5, -4($fp)
+	jal	runtime.gopanic
+	addi	$sp, $sp, 4
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.panicDivide
+runtime.panicNil:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -8
+	la	$5, msg.runtime.167.str
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	sw	$5, -4($fp)
+	jal	runtime.gopanic
+	addi	$sp, $sp, 4
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.panicNil
+runtime.makechan:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -28
+	lw	$5, 12($fp)	# size.runtime.168 -> $5
+	bge	$5, 0, runtime.l360
+
+	li	$5, 1		# t330 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	j	runtime.l361
+
+runtime.l360:
+	li	$5, 0		# t330 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+
+runtime.l361:
+	lw	$5, -4($fp)	# t330 -> $5
+	blt	$5, 1, runtime.l362
+
+	la	$5, msg.runtime.170.str
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	sw	$5, -8($fp)
+	jal	runtime.gopanic
+	addi	$sp, $sp, 4
+
+runtime.l362:
+	li	$25, 32
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# c.runtime.171 -> $6
+	lw	$7, 12($fp)	# size.runtime.168 -> $7
+	mul	$8, $7, 4
+	addi	$sp, $sp, -4
+	sw	$8, 0($sp)
+	sw	$5, -16($fp)
+	sw	$6, -20($fp)
+	sw	$8, -24($fp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	lw	$6, -20($fp)	# c.runtime.171 -> $6
+	sw	$5, 0($6)	# variable -> array
+	lw	$7, 12($fp)	# size.runtime.168 -> $7
+	sw	$7, 4($6)	# variable -> array
+	lw	$7, 8($fp)	# zero.runtime.169 -> $7
+	sw	$7, 20($6)	# variable -> array
+	# Store dirty variables back into memory
+	sw	$5, -28($fp)
+	move	$2, $6
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.makechan
+runtime.chanlen:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -8
+	lw	$5, 8($fp)	# c.runtime.172 -> $5
+	bne	$5, 0, runtime.l364
+
+	li	$5, 1		# t334 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	j	runtime.l365
+
+runtime.l364:
+	li	$5, 0		# t334 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+
+runtime.l365:
+	lw	$5, -4($fp)	# t334 -> $5
+	blt	$5, 1, runtime.l366
+
+	li	$2, 0
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.chanlen
+runtime.l366:
+	lw	$5, 8($fp)	# c.runtime.172 -> $5
+	lw	$6, 8($5)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$6, -8($fp)
+	move	$2, $6
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.chanlen
+runtime.chancap:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -8
+	lw	$5, 8($fp)	# c.runtime.173 -> $5
+	bne	$5, 0, runtime.l368
+
+	li	$5, 1		# t336 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	j	runtime.l369
+
+runtime.l368:
+	li	$5, 0		# t336 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+
+runtime.l369:
+	lw	$5, -4($fp)	# t336 -> $5
+	blt	$5, 1, runtime.l370
+
+	li	$2, 0
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.chancap
+runtime.l370:
+	lw	$5, 8($fp)	# c.runtime.173 -> $5
+	lw	$6, 4($5)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$6, -8($fp)
+	move	$2, $6
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.chancap
+runtime.enqueue:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -24
+	lw	$5, 8($fp)	# w.runtime.176 -> $5
+	li	$25, 0 	# const value -> $25
+	sw	$25, 12($5)	# variable -> array
+	lw	$5, 16($fp)	# c.runtime.174 -> $5
+	lw	$6, 12($fp)	# q.runtime.175 -> $6
+	sll	$24, $6, 2	# iterator *= 4
+	add	$24, $24, $5
+	lw	$7, 0($24)	# variable <- array
+	move	$5, $7		# p.runtime.177 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+	sw	$7, -4($fp)
+	bne	$5, 0, runtime.l372
+
+	li	$5, 1		# t339 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+	j	runtime.l373
+
+runtime.l372:
+	li	$5, 0		# t339 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+
+runtime.l373:
+	lw	$5, -12($fp)	# t339 -> $5
+	blt	$5, 1, runtime.l374
+
+	lw	$5, 16($fp)	# c.runtime.174 -> $5
+	lw	$6, 12($fp)	# q.runtime.175 -> $6
+	lw	$7, 8($fp)	# w.runtime.176 -> $7
+	sll	$24, $6, 2	# iterator *= 4
+	add	$24, $24, $5
+	sw	$7, 0($24)	# variable -> array
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.enqueue
+runtime.l374:
+
+runtime.l378:
+	lw	$5, -8($fp)	# p.runtime.177 -> $5
+	lw	$6, 12($5)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$6, -16($fp)
+	beq	$6, 0, runtime.l376
+
+	li	$5, 1		# t341 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	j	runtime.l377
+
+runtime.l376:
+	li	$5, 0		# t341 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+
+runtime.l377:
+	lw	$5, -20($fp)	# t341 -> $5
+	blt	$5, 1, runtime.l379
+
+	lw	$5, -8($fp)	# p.runtime.177 -> $5
+	lw	$6, 12($5)	# variable <- array
+	move	$5, $6		# p.runtime.177 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+	sw	$6, -24($fp)
+	j	runtime.l378
+
+runtime.l379:
+	lw	$5, -8($fp)	# p.runtime.177 -> $5
+	lw	$6, 8($fp)	# w.runtime.176 -> $6
+	sw	$6, 12($5)	# variable -> array
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.enqueue
+runtime.dequeue:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -40
+	lw	$5, 12($fp)	# c.runtime.178 -> $5
+	lw	$6, 8($fp)	# q.runtime.179 -> $6
+	sll	$24, $6, 2	# iterator *= 4
+	add	$24, $24, $5
+	lw	$7, 0($24)	# variable <- array
+	move	$5, $7		# w.runtime.180 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+	sw	$7, -4($fp)
+
+runtime.l390:
+	lw	$5, -8($fp)	# w.runtime.180 -> $5
+	beq	$5, 0, runtime.l380
+
+	li	$5, 1		# t344 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+	j	runtime.l381
+
+runtime.l380:
+	li	$5, 0		# t344 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+
+runtime.l381:
+	lw	$5, -12($fp)	# t344 -> $5
+	blt	$5, 1, runtime.l391
+
+	lw	$5, -8($fp)	# w.runtime.180 -> $5
+	lw	$6, 12($5)	# variable <- array
+	lw	$7, 12($fp)	# c.runtime.178 -> $7
+	lw	$8, 8($fp)	# q.runtime.179 -> $8
+	sll	$24, $8, 2	# iterator *= 4
+	add	$24, $24, $7
+	sw	$6, 0($24)	# variable -> array
+	lw	$7, 16($5)	# variable <- array
+	move	$8, $7		# sel.runtime.181 -> $8
+	# Store dirty variables back into memory
+	sw	$6, -16($fp)
+	sw	$7, -20($fp)
+	sw	$8, -24($fp)
+	bne	$8, 0, runtime.l382
+
+	li	$5, 1		# t347 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -28($fp)
+	j	runtime.l383
+
+runtime.l382:
+	li	$5, 0		# t347 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -28($fp)
+
+runtime.l383:
+	lw	$5, -28($fp)	# t347 -> $5
+	blt	$5, 1, runtime.l384
+
+	lw	$2, -8($fp)
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.dequeue
+runtime.l384:
+	lw	$5, -24($fp)	# sel.runtime.181 -> $5
+	lw	$6, 0($5)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$6, -32($fp)
+	bne	$6, 0, runtime.l386
+
+	li	$5, 1		# t349 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -36($fp)
+	j	runtime.l387
+
+runtime.l386:
+	li	$5, 0		# t349 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -36($fp)
+
+runtime.l387:
+	lw	$5, -36($fp)	# t349 -> $5
+	blt	$5, 1, runtime.l388
+
+	lw	$5, -24($fp)	# sel.runtime.181 -> $5
+	lw	$6, -8($fp)	# w.runtime.180 -> $6
+	sw	$6, 0($5)	# variable -> array
+	move	$2, $6
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.dequeue
+runtime.l388:
+	lw	$5, 12($fp)	# c.runtime.178 -> $5
+	lw	$6, 8($fp)	# q.runtime.179 -> $6
+	sll	$24, $6, 2	# iterator *= 4
+	add	$24, $24, $5
+	lw	$7, 0($24)	# variable <- array
+	move	$5, $7		# w.runtime.180 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+	sw	$7, -40($fp)
+	j	runtime.l390
+
+runtime.l391:
+	li	$2, 0
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.dequeue
+runtime.trysend:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -72
+	lw	$5, 12($fp)	# c.runtime.182 -> $5
+	bne	$5, 0, runtime.l392
+
+	li	$5, 1		# t351 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	j	runtime.l393
+
+runtime.l392:
+	li	$5, 0		# t351 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+
+runtime.l393:
+	lw	$5, -4($fp)	# t351 -> $5
+	blt	$5, 1, runtime.l394
+
+	li	$2, 0
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.trysend
+runtime.l394:
+	lw	$5, 12($fp)	# c.runtime.182 -> $5
+	lw	$6, 16($5)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$6, -8($fp)
+	beq	$6, 0, runtime.l396
+
+	li	$5, 1		# t353 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+	j	runtime.l397
+
+runtime.l396:
+	li	$5, 0		# t353 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+
+runtime.l397:
+	lw	$5, -12($fp)	# t353 -> $5
+	blt	$5, 1, runtime.l398
+
+	la	$5, msg.runtime.184.str
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	sw	$5, -16($fp)
+	jal	runtime.gopanic
+	addi	$sp, $sp, 4
+
+runtime.l398:
+	lw	$5, 12($fp)	# c.runtime.182 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	li	$25, 7
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	jal	runtime.dequeue
+	addi	$sp, $sp, 8
+	move	$5, $2
+	move	$6, $5		# w.runtime.185 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	sw	$6, -24($fp)
+	beq	$6, 0, runtime.l400
+
+	li	$5, 1		# t355 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -28($fp)
+	j	runtime.l401
+
+runtime.l400:
+	li	$5, 0		# t355 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -28($fp)
+
+runtime.l401:
+	lw	$5, -28($fp)	# t355 -> $5
+	blt	$5, 1, runtime.l402
+
+	lw	$5, -24($fp)	# w.runtime.185 -> $5
+	lw	$6, 8($fp)	# v.runtime.183 -> $6
+	sw	$6, 4($5)	# variable -> array
+	li	$25, 1 	# const value -> $25
+	sw	$25, 8($5)	# variable -> array
+	lw	$6, 0($5)	# variable <- array
+	addi	$sp, $sp, -4
+	sw	$6, 0($sp)
+	sw	$6, -32($fp)
+	jal	runtime.ready
+	addi	$sp, $sp, 4
+	li	$2, 1
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.trysend
+runtime.l402:
+	lw	$5, 12($fp)	# c.runtime.182 -> $5
+	lw	$6, 8($5)	# variable <- array
+	move	$7, $6		# n.runtime.186 -> $7
+	lw	$8, 4($5)	# variable <- array
+	move	$9, $8		# size.runtime.187 -> $9
+	# Store dirty variables back into memory
+	sw	$6, -36($fp)
+	sw	$7, -40($fp)
+	sw	$8, -44($fp)
+	sw	$9, -48($fp)
+	bge	$7, $9, runtime.l404
+
+	li	$5, 1		# t359 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -52($fp)
+	j	runtime.l405
+
+runtime.l404:
+	li	$5, 0		# t359 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -52($fp)
+
+runtime.l405:
+	lw	$5, -52($fp)	# t359 -> $5
+	blt	$5, 1, runtime.l406
+
+	lw	$5, 12($fp)	# c.runtime.182 -> $5
+	lw	$6, 0($5)	# variable <- array
+	lw	$7, 12($5)	# variable <- array
+	lw	$8, -40($fp)	# n.runtime.186 -> $8
+	add	$9, $7, $8
+	lw	$10, -48($fp)	# size.runtime.187 -> $10
+	rem	$11, $9, $10
+	lw	$10, 8($fp)	# v.runtime.183 -> $10
+	sll	$24, $11, 2	# iterator *= 4
+	add	$24, $24, $6
+	sw	$10, 0($24)	# variable -> array
+	addi	$10, $8, 1
+	sw	$10, 8($5)	# variable -> array
+	# Store dirty variables back into memory
+	sw	$6, -56($fp)
+	sw	$7, -60($fp)
+	sw	$9, -64($fp)
+	sw	$10, -72($fp)
+	sw	$11, -68($fp)
+	li	$2, 1
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.trysend
+runtime.l406:
+	li	$2, 0
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.trysend
+runtime.tryrecv:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -116
+	lw	$5, 12($fp)	# c.runtime.188 -> $5
+	bne	$5, 0, runtime.l408
+
+	li	$5, 1		# t365 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	j	runtime.l409
+
+runtime.l408:
+	li	$5, 0		# t365 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+
+runtime.l409:
+	lw	$5, -4($fp)	# t365 -> $5
+	blt	$5, 1, runtime.l410
+
+	li	$2, 0
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.tryrecv
+runtime.l410:
+	lw	$5, 12($fp)	# c.runtime.188 -> $5
+	lw	$6, 8($5)	# variable <- array
+	move	$5, $6		# n.runtime.190 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+	sw	$6, -8($fp)
+	ble	$5, 0, runtime.l412
 
-runtime:
+	li	$5, 1		# t367 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+	j	runtime.l413
+
+runtime.l412:
+	li	$5, 0		# t367 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+
+runtime.l413:
+	lw	$5, -16($fp)	# t367 -> $5
+	blt	$5, 1, runtime.l418
+
+	lw	$5, 12($fp)	# c.runtime.188 -> $5
+	lw	$6, 0($5)	# variable <- array
+	move	$7, $6		# buf.runtime.191 -> $7
+	lw	$8, 4($5)	# variable <- array
+	move	$9, $8		# size.runtime.192 -> $9
+	lw	$10, 12($5)	# variable <- array
+	move	$11, $10	# i.runtime.193 -> $11
+	sll	$24, $11, 2	# iterator *= 4
+	add	$24, $24, $7
+	lw	$12, 0($24)	# variable <- array
+	lw	$13, 8($fp)	# w.runtime.189 -> $13
+	sw	$12, 4($13)	# variable -> array
+	li	$25, 1 	# const value -> $25
+	sw	$25, 8($13)	# variable -> array
+	addi	$14, $11, 1
+	rem	$15, $14, $9
+	sw	$15, 12($5)	# variable -> array
+	lw	$16, -12($fp)	# n.runtime.190 -> $16
+	sub	$17, $16, 1
+	sw	$17, 8($5)	# variable -> array
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	li	$25, 6
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	sw	$6, -20($fp)
+	sw	$7, -24($fp)
+	sw	$8, -28($fp)
+	sw	$9, -32($fp)
+	sw	$10, -36($fp)
+	sw	$11, -40($fp)
+	sw	$12, -44($fp)
+	sw	$14, -48($fp)
+	sw	$15, -52($fp)
+	sw	$17, -56($fp)
+	jal	runtime.dequeue
+	addi	$sp, $sp, 8
+	move	$5, $2
+	move	$6, $5		# s.runtime.194 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -60($fp)
+	sw	$6, -64($fp)
+	beq	$6, 0, runtime.l414
+
+	li	$5, 1		# t376 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -68($fp)
+	j	runtime.l415
+
+runtime.l414:
+	li	$5, 0		# t376 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -68($fp)
+
+runtime.l415:
+	lw	$5, -68($fp)	# t376 -> $5
+	blt	$5, 1, runtime.l416
+
+	lw	$5, -40($fp)	# i.runtime.193 -> $5
+	lw	$6, -12($fp)	# n.runtime.190 -> $6
+	add	$7, $5, $6
+	lw	$5, -32($fp)	# size.runtime.192 -> $5
+	rem	$8, $7, $5
+	lw	$5, -64($fp)	# s.runtime.194 -> $5
+	lw	$9, 4($5)	# variable <- array
+	lw	$10, -24($fp)	# buf.runtime.191 -> $10
+	sll	$24, $8, 2	# iterator *= 4
+	add	$24, $24, $10
+	sw	$9, 0($24)	# variable -> array
+	lw	$10, 12($fp)	# c.runtime.188 -> $10
+	sw	$6, 8($10)	# variable -> array
+	li	$25, 1 	# const value -> $25
+	sw	$25, 8($5)	# variable -> array
+	lw	$10, 0($5)	# variable <- array
+	addi	$sp, $sp, -4
+	sw	$10, 0($sp)
+	sw	$7, -72($fp)
+	sw	$8, -76($fp)
+	sw	$9, -80($fp)
+	sw	$10, -84($fp)
+	jal	runtime.ready
+	addi	$sp, $sp, 4
+
+runtime.l416:
+	li	$2, 1
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.tryrecv
+runtime.l418:
+	lw	$5, 12($fp)	# c.runtime.188 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	li	$25, 6
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	jal	runtime.dequeue
+	addi	$sp, $sp, 8
+	move	$5, $2
+	move	$6, $5		# s.runtime.195 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -88($fp)
+	sw	$6, -92($fp)
+	beq	$6, 0, runtime.l420
+
+	li	$5, 1		# t382 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -96($fp)
+	j	runtime.l421
+
+runtime.l420:
+	li	$5, 0		# t382 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -96($fp)
+
+runtime.l421:
+	lw	$5, -96($fp)	# t382 -> $5
+	blt	$5, 1, runtime.l422
+
+	lw	$5, -92($fp)	# s.runtime.195 -> $5
+	lw	$6, 4($5)	# variable <- array
+	lw	$7, 8($fp)	# w.runtime.189 -> $7
+	sw	$6, 4($7)	# variable -> array
+	li	$25, 1 	# const value -> $25
+	sw	$25, 8($7)	# variable -> array
+	li	$25, 1 	# const value -> $25
+	sw	$25, 8($5)	# variable -> array
+	lw	$8, 0($5)	# variable <- array
+	addi	$sp, $sp, -4
+	sw	$8, 0($sp)
+	sw	$6, -100($fp)
+	sw	$8, -104($fp)
+	jal	runtime.ready
+	addi	$sp, $sp, 4
+	li	$2, 1
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.tryrecv
+runtime.l422:
+	lw	$5, 12($fp)	# c.runtime.188 -> $5
+	lw	$6, 16($5)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$6, -108($fp)
+	beq	$6, 0, runtime.l424
+
+	li	$5, 1		# t386 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -112($fp)
+	j	runtime.l425
+
+runtime.l424:
+	li	$5, 0		# t386 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -112($fp)
+
+runtime.l425:
+	lw	$5, -112($fp)	# t386 -> $5
+	blt	$5, 1, runtime.l426
+
+	lw	$5, 12($fp)	# c.runtime.188 -> $5
+	lw	$6, 20($5)	# variable <- array
+	lw	$5, 8($fp)	# w.runtime.189 -> $5
+	sw	$6, 4($5)	# variable -> array
+	li	$25, 0 	# const value -> $25
+	sw	$25, 8($5)	# variable -> array
+	# Store dirty variables back into memory
+	sw	$6, -116($fp)
+	li	$2, 1
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.tryrecv
+runtime.l426:
+	li	$2, 0
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.tryrecv
+runtime.chansend:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -36
+	lw	$5, 12($fp)	# c.runtime.196 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	lw	$5, 8($fp)	# v.runtime.197 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	jal	runtime.trysend
+	addi	$sp, $sp, 8
+	move	$5, $2
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	beq	$5, 0, runtime.l428
+
+	li	$5, 1		# t389 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+	j	runtime.l429
+
+runtime.l428:
+	li	$5, 0		# t389 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+
+runtime.l429:
+	lw	$5, -8($fp)	# t389 -> $5
+	blt	$5, 1, runtime.l430
+
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.chansend
+runtime.l430:
+	li	$25, 20
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# w.runtime.198 -> $6
+	sw	$5, -12($fp)
+	sw	$6, -16($fp)
+	jal	runtime.getg
+	move	$5, $2
+	lw	$6, -16($fp)	# w.runtime.198 -> $6
+	sw	$5, 0($6)	# variable -> array
+	lw	$7, 8($fp)	# v.runtime.197 -> $7
+	sw	$7, 4($6)	# variable -> array
+	lw	$7, 12($fp)	# c.runtime.196 -> $7
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	beq	$7, 0, runtime.l432
+
+	li	$5, 1		# t392 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+	j	runtime.l433
+
+runtime.l432:
+	li	$5, 0		# t392 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+
+runtime.l433:
+	lw	$5, -24($fp)	# t392 -> $5
+	blt	$5, 1, runtime.l434
+
+	lw	$5, 12($fp)	# c.runtime.196 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	li	$25, 6
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	lw	$5, -16($fp)	# w.runtime.198 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	jal	runtime.enqueue
+	addi	$sp, $sp, 12
+
+runtime.l434:
+	jal	runtime.park
+	lw	$5, -16($fp)	# w.runtime.198 -> $5
+	lw	$6, 8($5)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$6, -28($fp)
+	bne	$6, 0, runtime.l436
+
+	li	$5, 1		# t394 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+	j	runtime.l437
+
+runtime.l436:
+	li	$5, 0		# t394 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+
+runtime.l437:
+	lw	$5, -32($fp)	# t394 -> $5
+	blt	$5, 1, runtime.l438
+
+	la	$5, msg.runtime.199.str
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	sw	$5, -36($fp)
+	jal	runtime.gopanic
+	addi	$sp, $sp, 4
+
+runtime.l438:
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.chansend
+runtime.chanrecv:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -32
+	li	$25, 20
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# w.runtime.201 -> $6
+	lw	$7, 8($fp)	# c.runtime.200 -> $7
+	addi	$sp, $sp, -4
+	sw	$7, 0($sp)
+	addi	$sp, $sp, -4
+	sw	$6, 0($sp)
+	sw	$5, -4($fp)
+	sw	$6, -8($fp)
+	jal	runtime.tryrecv
+	addi	$sp, $sp, 8
+	move	$5, $2
+	# Store dirty variables back into memory
+	sw	$5, -12($fp)
+	bne	$5, 0, runtime.l440
+
+	li	$5, 1		# t397 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+	j	runtime.l441
+
+runtime.l440:
+	li	$5, 0		# t397 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -16($fp)
+
+runtime.l441:
+	lw	$5, -16($fp)	# t397 -> $5
+	blt	$5, 1, runtime.l446
+
+	jal	runtime.getg
+	move	$5, $2
+	lw	$6, -8($fp)	# w.runtime.201 -> $6
+	sw	$5, 0($6)	# variable -> array
+	lw	$6, 8($fp)	# c.runtime.200 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	beq	$6, 0, runtime.l442
+
+	li	$5, 1		# t399 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+	j	runtime.l443
+
+runtime.l442:
+	li	$5, 0		# t399 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -24($fp)
+
+runtime.l443:
+	lw	$5, -24($fp)	# t399 -> $5
+	blt	$5, 1, runtime.l444
+
+	lw	$5, 8($fp)	# c.runtime.200 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	li	$25, 7
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	lw	$5, -8($fp)	# w.runtime.201 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	jal	runtime.enqueue
+	addi	$sp, $sp, 12
+
+runtime.l444:
+	jal	runtime.park
+
+runtime.l446:
+	lw	$5, -8($fp)	# w.runtime.201 -> $5
+	lw	$6, 4($5)	# variable <- array
+	lw	$7, 8($5)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$6, -28($fp)
+	sw	$7, -32($fp)
+	move	$2, $6
+	move	$3, $7
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.chanrecv
+runtime.closechan:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -68
+	lw	$5, 8($fp)	# c.runtime.202 -> $5
+	bne	$5, 0, runtime.l448
+
+	li	$5, 1		# t402 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	j	runtime.l449
+
+runtime.l448:
+	li	$5, 0		# t402 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+
+runtime.l449:
+	lw	$5, -4($fp)	# t402 -> $5
+	blt	$5, 1, runtime.l450
+
+	la	$5, msg.runtime.203.str
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	sw	$5, -8($fp)
+	jal	runtime.gopanic
+	addi	$sp, $sp, 4
+
+runtime.l450:
+	lw	$5, 8($fp)	# c.runtime.202 -> $5
+	lw	$6, 16($5)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$6, -16($fp)
+	beq	$6, 0, runtime.l452
+
+	li	$5, 1		# t404 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	j	runtime.l453
+
+runtime.l452:
+	li	$5, 0		# t404 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+
+runtime.l453:
+	lw	$5, -20($fp)	# t404 -> $5
+	blt	$5, 1, runtime.l454
+
+	la	$5, msg.runtime.204.str
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	sw	$5, -24($fp)
+	jal	runtime.gopanic
+	addi	$sp, $sp, 4
+
+runtime.l454:
+	lw	$5, 8($fp)	# c.runtime.202 -> $5
+	li	$25, 1 	# const value -> $25
+	sw	$25, 16($5)	# variable -> array
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	li	$25, 7
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	jal	runtime.dequeue
+	addi	$sp, $sp, 8
+	move	$5, $2
+	move	$6, $5		# w.runtime.205 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -32($fp)
+	sw	$6, -36($fp)
+
+runtime.l458:
+	lw	$5, -36($fp)	# w.runtime.205 -> $5
+	beq	$5, 0, runtime.l456
+
+	li	$5, 1		# t406 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -40($fp)
+	j	runtime.l457
+
+runtime.l456:
+	li	$5, 0		# t406 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -40($fp)
+
+runtime.l457:
+	lw	$5, -40($fp)	# t406 -> $5
+	blt	$5, 1, runtime.l459
+
+	lw	$5, 8($fp)	# c.runtime.202 -> $5
+	lw	$6, 20($5)	# variable <- array
+	lw	$7, -36($fp)	# w.runtime.205 -> $7
+	sw	$6, 4($7)	# variable -> array
+	li	$25, 0 	# const value -> $25
+	sw	$25, 8($7)	# variable -> array
+	lw	$8, 0($7)	# variable <- array
+	addi	$sp, $sp, -4
+	sw	$8, 0($sp)
+	sw	$6, -44($fp)
+	sw	$8, -48($fp)
+	jal	runtime.ready
+	addi	$sp, $sp, 4
+	lw	$5, 8($fp)	# c.runtime.202 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	li	$25, 7
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	jal	runtime.dequeue
+	addi	$sp, $sp, 8
+	move	$5, $2
+	move	$6, $5		# w.runtime.205 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -52($fp)
+	sw	$6, -36($fp)
+	j	runtime.l458
+
+runtime.l459:
+	lw	$5, 8($fp)	# c.runtime.202 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	li	$25, 6
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	jal	runtime.dequeue
+	addi	$sp, $sp, 8
+	move	$5, $2
+	move	$6, $5		# w.runtime.205 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -56($fp)
+	sw	$6, -36($fp)
+
+runtime.l462:
+	lw	$5, -36($fp)	# w.runtime.205 -> $5
+	beq	$5, 0, runtime.l460
+
+	li	$5, 1		# t411 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -60($fp)
+	j	runtime.l461
+
+runtime.l460:
+	li	$5, 0		# t411 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -60($fp)
+
+runtime.l461:
+	lw	$5, -60($fp)	# t411 -> $5
+	blt	$5, 1, runtime.l463
+
+	lw	$5, -36($fp)	# w.runtime.205 -> $5
+	lw	$6, 0($5)	# variable <- array
+	addi	$sp, $sp, -4
+	sw	$6, 0($sp)
+	sw	$6, -64($fp)
+	jal	runtime.ready
+	addi	$sp, $sp, 4
+	lw	$5, 8($fp)	# c.runtime.202 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	li	$25, 6
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	jal	runtime.dequeue
+	addi	$sp, $sp, 8
+	move	$5, $2
+	move	$6, $5		# w.runtime.205 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -68($fp)
+	sw	$6, -36($fp)
+	j	runtime.l462
+
+runtime.l463:
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.closechan
+runtime.selectgo:
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -156
+	li	$5, 0		# i.runtime.209 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+
+runtime.l478:
+	lw	$5, -4($fp)	# i.runtime.209 -> $5
+	lw	$6, 12($fp)	# n.runtime.207 -> $6
+	bge	$5, $6, runtime.l464
+
+	li	$5, 1		# t414 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+	j	runtime.l465
+
+runtime.l464:
+	li	$5, 0		# t414 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -8($fp)
+
+runtime.l465:
+	lw	$5, -8($fp)	# t414 -> $5
+	blt	$5, 1, runtime.l479
+
+	lw	$5, -4($fp)	# i.runtime.209 -> $5
+	mul	$6, $5, 28
+	lw	$5, 16($fp)	# cases.runtime.206 -> $5
+	add	$7, $5, $6
+	move	$5, $7		# w.runtime.210 -> $5
+	lw	$8, 20($5)	# variable <- array
+	move	$9, $8		# c.runtime.211 -> $9
+	sw	$9, -28($fp)	# spilled c.runtime.211, freed $9
+	lw	$9, 24($5)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$5, -20($fp)
+	sw	$6, -12($fp)
+	sw	$7, -16($fp)
+	sw	$8, -24($fp)
+	sw	$9, -32($fp)
+	beq	$9, 0, runtime.l466
+
+	li	$5, 1		# t419 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -36($fp)
+	j	runtime.l467
+
+runtime.l466:
+	li	$5, 0		# t419 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -36($fp)
+
+runtime.l467:
+	lw	$5, -36($fp)	# t419 -> $5
+	blt	$5, 1, runtime.l477
+
+	lw	$5, -20($fp)	# w.runtime.210 -> $5
+	lw	$6, 4($5)	# variable <- array
+	lw	$5, -28($fp)	# c.runtime.211 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	addi	$sp, $sp, -4
+	sw	$6, 0($sp)
+	sw	$6, -40($fp)
+	jal	runtime.trysend
+	addi	$sp, $sp, 8
+	move	$5, $2
+	# Store dirty variables back into memory
+	sw	$5, -44($fp)
+	beq	$5, 0, runtime.l468
+
+	li	$5, 1		# t422 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -48($fp)
+	j	runtime.l469
+
+runtime.l468:
+	li	$5, 0		# t422 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -48($fp)
+
+runtime.l469:
+	lw	$5, -48($fp)	# t422 -> $5
+	blt	$5, 1, runtime.l470
+
+	lw	$2, -4($fp)
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.selectgo
+runtime.l470:
+	j	runtime.l476
+
+runtime.l477:
+	lw	$5, -28($fp)	# c.runtime.211 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	lw	$5, -20($fp)	# w.runtime.210 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	jal	runtime.tryrecv
+	addi	$sp, $sp, 8
+	move	$5, $2
+	# Store dirty variables back into memory
+	sw	$5, -52($fp)
+	beq	$5, 0, runtime.l472
+
+	li	$5, 1		# t424 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -56($fp)
+	j	runtime.l473
+
+runtime.l472:
+	li	$5, 0		# t424 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -56($fp)
+
+runtime.l473:
+	lw	$5, -56($fp)	# t424 -> $5
+	blt	$5, 1, runtime.l474
+
+	lw	$2, -4($fp)
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.selectgo
+runtime.l474:
+
+runtime.l476:
+	lw	$5, -4($fp)	# i.runtime.209 -> $5
+	addi	$5, $5, 1
+	# Store dirty variables back into memory
+	sw	$5, -4($fp)
+	j	runtime.l478
+
+runtime.l479:
+	lw	$5, 8($fp)	# block.runtime.208 -> $5
+	bne	$5, 0, runtime.l480
+
+	li	$5, 1		# t425 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -60($fp)
+	j	runtime.l481
+
+runtime.l480:
+	li	$5, 0		# t425 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -60($fp)
+
+runtime.l481:
+	lw	$5, -60($fp)	# t425 -> $5
+	blt	$5, 1, runtime.l482
+
+	li	$2, -1
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
+	jr	$ra
+	.end runtime.selectgo
+runtime.l482:
+	li	$25, 4
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	jal	runtime.malloc
+	addi	$sp, $sp, 4
+	move	$5, $2
+	move	$6, $5		# sel.runtime.212 -> $6
+	sw	$5, -64($fp)
+	sw	$6, -68($fp)
+	jal	runtime.getg
+	move	$5, $2
+	move	$6, $5		# g.runtime.213 -> $6
+	sw	$6, -76($fp)	# spilled g.runtime.213, freed $6
+	li	$6, 0		# i.runtime.214 -> $6
+	# Store dirty variables back into memory
+	sw	$5, -72($fp)
+	sw	$6, -80($fp)
+
+runtime.l494:
+	lw	$5, -80($fp)	# i.runtime.214 -> $5
+	lw	$6, 12($fp)	# n.runtime.207 -> $6
+	bge	$5, $6, runtime.l484
+
+	li	$5, 1		# t428 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -84($fp)
+	j	runtime.l485
+
+runtime.l484:
+	li	$5, 0		# t428 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -84($fp)
+
+runtime.l485:
+	lw	$5, -84($fp)	# t428 -> $5
+	blt	$5, 1, runtime.l495
+
+	lw	$5, -80($fp)	# i.runtime.214 -> $5
+	mul	$6, $5, 28
+	lw	$5, 16($fp)	# cases.runtime.206 -> $5
+	add	$7, $5, $6
+	move	$5, $7		# w.runtime.215 -> $5
+	lw	$8, 20($5)	# variable <- array
+	move	$9, $8		# c.runtime.216 -> $9
+	# Store dirty variables back into memory
+	sw	$5, -96($fp)
+	sw	$6, -88($fp)
+	sw	$7, -92($fp)
+	sw	$8, -100($fp)
+	sw	$9, -104($fp)
+	beq	$9, 0, runtime.l486
+
+	li	$5, 1		# t432 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -108($fp)
+	j	runtime.l487
+
+runtime.l486:
+	li	$5, 0		# t432 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -108($fp)
+
+runtime.l487:
+	lw	$5, -108($fp)	# t432 -> $5
+	blt	$5, 1, runtime.l492
+
+	lw	$5, -96($fp)	# w.runtime.215 -> $5
+	lw	$6, -76($fp)	# g.runtime.213 -> $6
+	sw	$6, 0($5)	# variable -> array
+	lw	$6, -68($fp)	# sel.runtime.212 -> $6
+	sw	$6, 16($5)	# variable -> array
+	lw	$6, 24($5)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$6, -112($fp)
+	beq	$6, 0, runtime.l488
+
+	li	$5, 1		# t434 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -116($fp)
+	j	runtime.l489
+
+runtime.l488:
+	li	$5, 0		# t434 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -116($fp)
+
+runtime.l489:
+	lw	$5, -116($fp)	# t434 -> $5
+	blt	$5, 1, runtime.l491
+
+	lw	$5, -104($fp)	# c.runtime.216 -> $5
 	addi	$sp, $sp, -4
-	sw	$ra, 0($sp)
+	sw	$5, 0($sp)
+	li	$25, 6
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	lw	$5, -96($fp)	# w.runtime.215 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	jal	runtime.enqueue
+	addi	$sp, $sp, 12
+	j	runtime.l490
+
+runtime.l491:
+	lw	$5, -104($fp)	# c.runtime.216 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	li	$25, 7
+	addi	$sp, $sp, -4
+	sw	$25, 0($sp)
+	lw	$5, -96($fp)	# w.runtime.215 -> $5
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	jal	runtime.enqueue
+	addi	$sp, $sp, 12
+
+runtime.l490:
+
+runtime.l492:
+	lw	$5, -80($fp)	# i.runtime.214 -> $5
+	addi	$5, $5, 1
+	# Store dirty variables back into memory
+	sw	$5, -80($fp)
+	j	runtime.l494
+
+runtime.l495:
+	jal	runtime.park
+	lw	$5, -68($fp)	# sel.runtime.212 -> $5
+	lw	$6, 0($5)	# variable <- array
+	move	$5, $6		# w.runtime.217 -> $5
+	lw	$7, 24($5)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$5, -124($fp)
+	sw	$6, -120($fp)
+	sw	$7, -128($fp)
+	beq	$7, 0, runtime.l496
+
+	li	$5, 1		# t437 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -132($fp)
+	j	runtime.l497
+
+runtime.l496:
+	li	$5, 0		# t437 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -132($fp)
+
+runtime.l497:
+	lw	$5, -132($fp)	# t437 -> $5
+	beq	$5, 0, runtime.l501
 
-	lw	$ra, 0($sp)
+	lw	$5, -124($fp)	# w.runtime.217 -> $5
+	lw	$6, 8($5)	# variable <- array
+	# Store dirty variables back into memory
+	sw	$6, -136($fp)
+	bne	$6, 0, runtime.l498
+
+	li	$5, 1		# t439 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -140($fp)
+	j	runtime.l499
+
+runtime.l498:
+	li	$5, 0		# t439 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -140($fp)
+
+runtime.l499:
+	lw	$5, -140($fp)	# t439 -> $5
+	beq	$5, 0, runtime.l501
+
+	li	$5, 1		# t440 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -144($fp)
+	j	runtime.l500
+
+runtime.l501:
+	li	$5, 0		# t440 -> $5
+	# Store dirty variables back into memory
+	sw	$5, -144($fp)
+
+runtime.l500:
+	lw	$5, -144($fp)	# t440 -> $5
+	blt	$5, 1, runtime.l502
+
+	la	$5, msg.runtime.218.str
+	addi	$sp, $sp, -4
+	sw	$5, 0($sp)
+	sw	$5, -148($fp)
+	jal	runtime.gopanic
 	addi	$sp, $sp, 4
+
+runtime.l502:
+	lw	$5, -124($fp)	# w.runtime.217 -> $5
+	lw	$6, 16($fp)	# cases.runtime.206 -> $6
+	sub	$7, $5, $6
+	div	$5, $7, 28
+	# Store dirty variables back into memory
+	sw	$5, -156($fp)
+	sw	$7, -152($fp)
+	move	$2, $5
+	move	$sp, $fp
+	lw	$fp, 0($sp)
+	lw	$ra, 4($sp)
+	addi	$sp, $sp, 8
 	jr	$ra
-	.end runtime
+	.end runtime.selectgo
 
 	.globl main
 	.ent main
 main:
-	la	$3, a.0
-	lw	$5, 0($3)	# variable <- array
-	li	$5, 1		# t0 -> $5
-	sw	$5, 0($3)	# variable -> array
+	addi	$sp, $sp, -8
+	sw	$ra, 4($sp)
+	sw	$fp, 0($sp)
+	move	$fp, $sp
+	addi	$sp, $sp, -24
+	la	$5, -8($fp)
+	lw	$6, 0($5)	# variable <- array
+	li	$6, 1		# t0 -> $6
+	sw	$6, 0($5)	# variable -> array
 	# Store dirty variables back into memory
-	sw	$5, t0
+	sw	$6, -12($fp)
 
 l4:
-	la	$3, a.0
-	lw	$5, 0($3)	# variable <- array
+	la	$5, -8($fp)
+	lw	$6, 0($5)	# variable <- array
 	# Store dirty variables back into memory
-	sw	$5, t1
-	bne	$5, 1, l0
+	sw	$6, -16($fp)
+	bne	$6, 1, l0
 
-	li	$3, 1		# t2 -> $3
+	li	$5, 1		# t2 -> $5
 	# Store dirty variables back into memory
-	sw	$3, t2
+	sw	$5, -20($fp)
 	j	l1
 
 l0:
-	li	$3, 0		# t2 -> $3
+	li	$5, 0		# t2 -> $5
 	# Store dirty variables back into memory
-	sw	$3, t2
+	sw	$5, -20($fp)
 
 l1:
-	lw	$3, t2		# t2 -> $3
-	blt	$3, 1, l4
+	lw	$5, -20($fp)		# t2 -> $5
+	blt	$5, 1, l4
 
 	j	l5
 
 l5:
-	la	$3, a.0
-	lw	$5, 0($3)	# variable <- array
+	la	$5, -8($fp)
+	lw	$6, 0($5)	# variable <- array
 	li	$2, 1
-	move	$4, $5
+	move	$4, $6
 	syscall
 	# Store dirty variables back into memory
-	sw	$5, t3
+	sw	$6, -24($fp)
 	li	$2, 10
 	syscall
 	.end main
+runtime.etext:
@@ -1,10 +1,49 @@
 package main
 
+// positive determines whether the first n elements of the array are positive.
+// The right operand of && is evaluated only while i < n holds, hence the array
+// is never indexed out of bounds.
+func positive(n int) bool {
+	a := [3]int{}
+	a[0] = 4
+	a[1] = 2
+	a[2] = 7
+	i := 0
+	for i < n && a[i] > 0 {
+		i++
+	}
+	return i == n
+}
+
+func xor(p, q bool) bool {
+	return (p || q) && (!p || !q)
+}
+
 func main() {
+        newline := "\n"
         a := 1
         b := 2
         if (a < b) && (a < b) {
                 printInt a
+                printStr newline
+        }
+        var done bool
+        found := true
+        if !done && found == true {
+                printInt 1
+                printStr newline
+        }
+        if positive(3) {
+                printInt 3
+                printStr newline
+        }
+        if xor(true, false) || xor(false, false) {
+                printInt 4
+                printStr newline
+        }
+        if xor(true, true) {
+                printInt 0
+                printStr newline
         }
         return
 }
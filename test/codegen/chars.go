@@ -0,0 +1,79 @@
+package main
+
+// isPalindrome reports whether a string reads the same in both directions.
+func isPalindrome(s string) bool {
+	n := len(s)
+	for i := 0; i < n/2; i++ {
+		if s[i] != s[n-1-i] {
+			return false
+		}
+	}
+	return true
+}
+
+// vowels returns the number of vowels in a string.
+func vowels(s string) int {
+	count := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case 'a', 'e', 'i', 'o', 'u':
+			count++
+		}
+	}
+	return count
+}
+
+// upper returns the upper case of a lower case letter.
+func upper(c byte) byte {
+	if c >= 'a' && c <= 'z' {
+		return c - 'a' + 'A'
+	}
+	return c
+}
+
+func main() {
+	newline := '\n'
+	word := "racecar"
+	if isPalindrome(word) {
+		printInt 1
+	}
+	if !isPalindrome("gopher") {
+		printInt 0
+	}
+	printChar newline
+
+	printInt vowels("programming language")
+	printChar newline
+
+	for i := 0; i < len(word); i++ {
+		printChar upper(word[i])
+	}
+	printChar '\n'
+
+	// A byte wraps around on overflow.
+	var b byte = 250
+	b += 10
+	printInt int(b)
+	b--
+	printInt int(b)
+	printChar '\t'
+	var r rune = 'é'
+	printInt int(r)
+	printChar '\n'
+
+	c := "hello"[1]
+	printChar c
+	printInt len("hello")
+	printChar '\n'
+
+	// The runes beyond ASCII are printed in UTF-8.
+	quote, hex, oct, long := '\'', '\x41', '\101', '\U0001F600'
+	printInt int(quote) + int(hex) + int(oct)
+	printChar quote
+	printChar r
+	printChar 'ß'
+	printChar '\u00e9'
+	printChar long
+	printChar '\\'
+	printChar '\n'
+}
@@ -0,0 +1,105 @@
+package main
+
+// counter returns a function which returns successive integers, starting
+// from start.
+func counter(start int) func() int {
+	n := start
+	return func() int {
+		n++
+		return n
+	}
+}
+
+// adder returns a function which adds x to its argument.
+func adder(x int) func(int) int {
+	return func(y int) int {
+		return x + y
+	}
+}
+
+// apply applies f to x twice.
+func apply(f func(int) int, x int) int {
+	return f(f(x))
+}
+
+func square(x int) int {
+	return x * x
+}
+
+func main() {
+	newline := "\n"
+	next := counter(10)
+	next()
+	next()
+	printInt next()
+	printStr newline
+
+	// Each call to counter creates a new variable.
+	other := counter(0)
+	printInt other()*100 + next()
+	printStr newline
+
+	add5 := adder(5)
+	printInt apply(add5, 1)
+	printStr newline
+
+	// A function declared at top level can be used as a value.
+	printInt apply(square, 3)
+	f := square
+	printStr newline
+	printInt f(7)
+	printStr newline
+
+	// A closure modifies the variables it captures.
+	sum := 0
+	acc := func(x int) {
+		sum += x
+	}
+	for i := 1; i <= 10; i++ {
+		acc(i)
+	}
+	printInt sum
+	printStr newline
+
+	// A nested function literal captures the variables of all the
+	// enclosing functions.
+	k := 3
+	mul := func(x int) func() int {
+		return func() int {
+			return x * k
+		}
+	}
+	g := mul(4)
+	k = 5
+	printInt g()
+	printStr newline
+
+	// A function literal can be called directly.
+	printInt func(a, b int) int {
+		return a - b
+	}(10, 4)
+	printStr newline
+
+	// A function value can be compared with nil.
+	var h func() int
+	if h == nil && g != nil {
+		h = g
+	}
+	printInt h()
+	printStr newline
+
+	// The elements of slices and maps of function values can be called.
+	fs := []func(int) int{square, adder(1)}
+	ops := map[string]func(int) int{"inc": fs[1]}
+	op := ops["inc"]
+	printInt fs[0](3)*100 + ops["inc"](40) + op(-1)
+	printStr newline
+
+	// The values assigned by a parallel assignment are evaluated before
+	// the captured variables are assigned.
+	p, q := 1, 2
+	pair := func() int { return p*10 + q }
+	p, q = q, p
+	printInt pair()
+	printStr newline
+}
@@ -0,0 +1,104 @@
+package main
+
+// The specifications which omit the expression list repeat the previous one,
+// with iota being the index of the specification.
+const (
+	KB = 1 << (10 * (iota + 1))
+	MB
+	GB
+)
+
+const (
+	sunday = iota
+	monday
+	tuesday
+	_
+	thursday
+)
+
+const size = 4
+const pi float64 = 3.14159
+const greeting = "hi"
+const debug = false
+const big = 1 << 30
+const hex = 0x1F
+const large = size > 3
+
+// The untyped constants are exact, hence the intermediate values need not be
+// representable by an int.
+const (
+	huge   = 1 << 40
+	scaled = huge >> 38
+	lowest = -2147483648
+)
+
+const (
+	a, b = iota, iota * 10
+	c, d
+)
+
+
+func main() {
+	printInt KB
+	printStr " "
+	printInt MB
+	printStr " "
+	printInt GB
+	printStr "\n"
+	printInt monday + tuesday*10 + thursday*100
+	printStr "\n"
+	arr := [size]int{}
+	printInt len(arr)
+	table := [size * 2]int{}
+	printInt len(table)
+	printStr "\n"
+	printFloat pi * 2
+	printStr "\n"
+	printStr greeting + "\n"
+	if !debug {
+		printInt big / KB
+	}
+	printStr "\n"
+	printInt hex + a + b + c + d
+	printStr "\n"
+	const local, n = 7, size + 1
+	x := local * n
+	printInt x
+	printStr "\n"
+	// A typed constant retains its type.
+	const (
+		zero byte = iota
+		one
+	)
+	var y byte = one
+	printInt int(y + zero)
+	printStr "\n"
+
+	// The comparisons of constants are evaluated during compilation, and a
+	// constant on the left of a comparison is compared as such.
+	if size > 3 && large {
+		printInt 1
+	}
+	z := 3 > 2
+	if z {
+		printInt 2
+	}
+	count := 0
+	for i := 0; 3 > i; i++ {
+		count++
+	}
+	printInt count
+	switch size {
+	case 3:
+		printInt 0
+	case 4:
+		printInt 4
+	}
+	printStr "\n"
+	printInt scaled
+	printStr " "
+	printInt lowest
+	printStr " "
+	printInt huge / GB
+	printStr "\n"
+}
@@ -1,5 +1,21 @@
 package main
 
+import "fmt"
+
+type counter struct {
+	name string
+	n    int
+}
+
+func (c *counter) inc() {
+	c.n++
+	fmt.Println("inc", c.name, c.n)
+}
+
+func (c counter) show(tag string) {
+	fmt.Println(tag, c.name, c.n)
+}
+
 func firstFunc() {
 	str := "First function call!\n"
 	printStr str
@@ -22,9 +38,82 @@ func lastFunc() {
 	return
 }
 
+// loop defers a call in each iteration, the calls running in the reverse
+// order once the function returns.
+func loop(n int) {
+	for i := 0; i < n; i++ {
+		defer fmt.Println("deferred", i)
+	}
+	fmt.Println("loop done")
+}
+
+// sign defers a call in either branch of a conditional.
+func sign(x int) int {
+	if x > 0 {
+		defer fmt.Println("positive", x)
+		return x * 2
+	}
+	defer fmt.Println("not positive", x)
+	return -x
+}
+
+// snapshot shows that the arguments of a deferred call are evaluated at the
+// defer statement, whereas a function literal sees the latest values.
+func snapshot() {
+	x := 1
+	defer fmt.Println("x at defer:", x)
+	defer func() {
+		fmt.Println("x at return:", x)
+	}()
+	x = 42
+}
+
+// double modifies its named result after the return statement.
+func double(a, b int) (sum int) {
+	defer func() {
+		sum *= 2
+	}()
+	return a + b
+}
+
+func swap(a, b int) (x, y int) {
+	x, y = a, b
+	defer func() {
+		fmt.Println("swapped", x, y)
+	}()
+	return y, x
+}
+
+// exchange returns its arguments, which are swapped by a deferred call after
+// they are set as the results.
+func exchange(a, b int) (x, y int) {
+	defer func() {
+		x, y = y, x
+	}()
+	return a, b
+}
+
+// methods defers calls of methods, whose receivers are evaluated at the defer
+// statements.
+func methods() {
+	c := &counter{"c", 0}
+	defer c.show("show")
+	defer c.inc()
+	c.n = 10
+}
+
 func main() {
 	defer lastFunc()
 	defer midFunc(1+2, 3)
 	defer firstFunc()
+	loop(3)
+	fmt.Println(sign(4), sign(-5))
+	snapshot()
+	fmt.Println("double:", double(3, 4))
+	a, b := swap(1, 2)
+	fmt.Println("swap:", a, b)
+	a, b = exchange(a, b)
+	fmt.Println("exchange:", a, b)
+	methods()
 	return
 }
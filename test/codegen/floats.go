@@ -0,0 +1,87 @@
+package main
+
+var scale float64 = 1.5
+
+// area returns the area of a circle.
+func area(r float64) float64 {
+	return 3.14159 * r * r
+}
+
+// divmod returns the quotient of two floating-point values along with the
+// integral part of the quotient.
+func divmod(a, b float64) (float64, int) {
+	q := a / b
+	return q, int(q)
+}
+
+// mix returns its arguments in the reverse order, converting the integer.
+func mix(n int, x float32, y float64) (float64, float32, float64) {
+	return y, x, float64(n)
+}
+
+// sqrt returns the square root of a value by Newton's method.
+func sqrt(x float64) float64 {
+	z := 1.0
+	for i := 0; i < 20; i++ {
+		z -= (z*z - x) / (2 * z)
+	}
+	return z
+}
+
+func main() {
+	newline := "\n"
+	space := " "
+
+	printFloat area(2)
+	printStr newline
+
+	q, n := divmod(7.5, 2)
+	printFloat q
+	printStr space
+	printInt n
+	printStr newline
+
+	a, b, c := mix(3, 0.5, 2.25)
+	printFloat a
+	printStr space
+	printFloat b
+	printStr space
+	printFloat c
+	printStr newline
+
+	printFloat sqrt(2)
+	printStr newline
+
+	half := func(v float32) float32 { return v / 2 }
+	printFloat half(float32(5))
+	printStr newline
+
+	// The conversion of a floating-point value to an integer truncates it
+	// towards zero.
+	x := -2.75
+	printInt int(x)
+	printStr space
+	printInt int(x * scale)
+	printStr newline
+
+	var f float32 = 1.25
+	f *= 4
+	f = -f + 1
+	printFloat f
+	printStr newline
+
+	count := 0
+	for y := 0.0; y < 1; y += 0.125 {
+		if y >= 0.5 && y != 0.75 {
+			count++
+		}
+	}
+	printInt count
+	printStr newline
+
+	var g float64
+	if g == 0 && 1.5 > float64(f) {
+		printInt 1
+	}
+	printStr newline
+}
@@ -0,0 +1,28 @@
+package main
+
+import "fmt"
+
+type celsius int
+
+func main() {
+	n, s := 42, "gogo"
+	ok := n > 40
+	var r rune = 'é'
+	var b byte = 'A'
+	var t celsius = -7
+	fmt.Println("n =", n, s, ok, r, b, t)
+	fmt.Println(-n, -t, -b, -r)
+	fmt.Println()
+	fmt.Print(n, t, "|", s, 1.5, "\n")
+	fmt.Printf("[%d] [%5d] [%-5d] [%05d] [%05d]\n", n, n, n, n, t)
+	fmt.Printf("[%x] [%4x] [%08x] [%x]\n", 255, n, 3054, t)
+	fmt.Printf("[%s] [%8s] [%-8s] [%c] [%3c%c]\n", s, s, s, r, b, 'z')
+	fmt.Printf("%v %v %v %v 100%%\n", n, s, ok, !ok)
+	fmt.Printf("%v|%6v|%-6v|\n", 2.25, ok, t)
+	fmt.Printf(`raw %d`+"\n", 7)
+
+	var x, y int
+	p := &y
+	fmt.Scan(&x, p)
+	fmt.Printf("%d+%d=%d\n", x, y, x+y)
+}
@@ -0,0 +1,46 @@
+package main
+
+// fib returns the n'th fibonacci number.
+func fib(n int) int {
+	if n < 2 {
+		return n
+	}
+	a := fib(n - 1)
+	b := fib(n - 2)
+	return a + b
+}
+
+// hanoi returns the number of moves required to move n disks, with the local
+// variables of each activation surviving the nested recursive calls.
+func hanoi(n, from, to, via int) int {
+	if n == 0 {
+		return 0
+	}
+	moves := hanoi(n-1, from, via, to)
+	moves = moves + 1
+	rest := hanoi(n-1, via, to, from)
+	return moves + rest
+}
+
+// sumDigits stores the digits of n in a local array at each level of the
+// recursion.
+func sumDigits(n int) int {
+	digits := [2]int{}
+	digits[0] = n % 10
+	digits[1] = n / 10
+	if digits[1] == 0 {
+		return digits[0]
+	}
+	return digits[0] + sumDigits(digits[1])
+}
+
+func main() {
+	newline := "\n"
+	printInt fib(10)
+	printStr newline
+	printInt hanoi(5, 1, 3, 2)
+	printStr newline
+	printInt sumDigits(98765)
+	printStr newline
+	return
+}
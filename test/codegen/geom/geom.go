@@ -0,0 +1,29 @@
+package geom
+
+// Point is a point in the plane.
+type Point struct {
+	X, Y int
+}
+
+// Dim is the number of coordinates of a point.
+const Dim = 2
+
+// Moves counts the points moved.
+var Moves int
+
+// helper returns the square of an integer.
+func helper(n int) int {
+	return n * n
+}
+
+// Dist2 returns the square of the distance between two points.
+func Dist2(p, q Point) int {
+	return helper(p.X-q.X) + helper(p.Y-q.Y)
+}
+
+// Move translates a point.
+func (p *Point) Move(dx, dy int) {
+	p.X += dx
+	p.Y += dy
+	Moves++
+}
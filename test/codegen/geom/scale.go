@@ -0,0 +1,11 @@
+package geom
+
+// Sum returns the sum of the coordinates of a point.
+func (p Point) Sum() int {
+	return p.X + p.Y
+}
+
+// Scale returns a point whose coordinates are those of p multiplied by k.
+func Scale(p Point, k int) Point {
+	return Point{p.X * k, p.Y * k}
+}
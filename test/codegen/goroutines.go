@@ -0,0 +1,151 @@
+package main
+
+import "fmt"
+
+// produce sends the squares of the integers below n on a channel, which is
+// closed once all of them have been sent.
+func produce(n int, out chan int) {
+	for i := 0; i < n; i++ {
+		out <- i * i
+	}
+	close(out)
+}
+
+// worker doubles the jobs it receives until the channel of jobs is closed.
+func worker(id int, jobs chan int, results chan int, done chan bool) {
+	for j := range jobs {
+		results <- 2 * j
+	}
+	done <- true
+}
+
+// pingPong passes a counter back and forth between two goroutines.
+func pingPong(ping, pong chan int, rounds int) {
+	for i := 0; i < rounds; i++ {
+		n := <-ping
+		pong <- n + 1
+	}
+}
+
+func main() {
+	// An unbuffered channel synchronizes the sender and the receiver.
+	squares := make(chan int)
+	go produce(5, squares)
+	sum := 0
+	for v := range squares {
+		sum += v
+	}
+	fmt.Println("sum of squares:", sum)
+	v, ok := <-squares
+	fmt.Println("after close:", v, ok)
+
+	ping, pong := make(chan int), make(chan int)
+	go pingPong(ping, pong, 3)
+	n := 0
+	for i := 0; i < 3; i++ {
+		ping <- n
+		n = <-pong + 1
+	}
+	fmt.Println("ping-pong:", n)
+
+	// A buffered channel does not block until its buffer is full.
+	buf := make(chan string, 3)
+	buf <- "a"
+	buf <- "b"
+	fmt.Println("len:", len(buf), "cap:", cap(buf))
+	buf <- "c"
+	close(buf)
+	s := ""
+	for x := range buf {
+		s += x
+	}
+	last, more := <-buf
+	fmt.Printf("buffered: %s [%s] %v\n", s, last, more)
+
+	// The results of a pool of workers are summed in any order.
+	jobs := make(chan int, 10)
+	results := make(chan int, 10)
+	done := make(chan bool)
+	for w := 1; w <= 3; w++ {
+		go worker(w, jobs, results, done)
+	}
+	for j := 1; j <= 10; j++ {
+		jobs <- j
+	}
+	close(jobs)
+	for w := 1; w <= 3; w++ {
+		<-done
+	}
+	close(results)
+	total := 0
+	for r := range results {
+		total += r
+	}
+	fmt.Println("workers:", total)
+
+	// A goroutine can be started from a function literal, which shares
+	// the variables of the enclosing function.
+	count := 0
+	finished := make(chan int)
+	for i := 0; i < 4; i++ {
+		go func(k int) {
+			count += k
+			finished <- k
+		}(i)
+	}
+	for i := 0; i < 4; i++ {
+		<-finished
+	}
+	fmt.Println("closures:", count)
+
+	// A select statement with a default case does not block.
+	empty := make(chan int)
+	select {
+	case x := <-empty:
+		fmt.Println("received", x)
+	default:
+		fmt.Println("nothing ready")
+	}
+
+	ready := make(chan int, 1)
+	ready <- 42
+	var nilChan chan int
+	select {
+	case x := <-nilChan:
+		fmt.Println("nil channel", x)
+	case x, ok := <-ready:
+		fmt.Println("select received:", x, ok)
+	}
+
+	// A select statement blocks until one of its cases can proceed.
+	quit := make(chan bool)
+	values := make(chan int)
+	go func() {
+		for i := 1; i <= 3; i++ {
+			values <- i * 10
+		}
+		quit <- true
+	}()
+	got := 0
+	for running := true; running; {
+		select {
+		case x := <-values:
+			got += x
+		case <-quit:
+			running = false
+		}
+	}
+	fmt.Println("select loop:", got)
+
+	out := make(chan int, 1)
+	select {
+	case out <- 7:
+		fmt.Println("sent:", <-out)
+	default:
+		fmt.Println("buffer full")
+	}
+
+	// Receiving from a channel which is never sent to blocks forever.
+	fmt.Println("waiting")
+	<-empty
+}
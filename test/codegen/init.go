@@ -0,0 +1,35 @@
+package main
+
+import "fmt"
+
+// The package-level variables are initialized in the order of their
+// dependencies, irrespective of the order of their declarations.
+var Counter = initial()
+
+var (
+	total, last = count + 1, name + "!"
+	count       = step * 10
+	step        = len(name)
+	name        = "gogo"
+)
+
+func main() {
+	fmt.Println(Counter)
+	fmt.Println(total, last, count)
+	p := point{1, 2}
+	fmt.Println(p.sum())
+}
+
+func (p point) sum() int {
+	return p.x + p.y + base
+}
+
+type point struct {
+	x, y int
+}
+
+const base = 100
+
+func initial() int {
+	return 7
+}
@@ -1,10 +1,75 @@
 package main
 
+// firstPair returns the first pair of indices of a grid whose values sum to n.
+func firstPair(n int) (int, int) {
+	a, b := -1, -1
+outer:
+	for i := 0; i < 5; i++ {
+		for j := i; j < 5; j++ {
+			if i*j == n {
+				a, b = i, j
+				break outer
+			}
+		}
+	}
+	return a, b
+}
+
 func main() {
 	a := 1
 	b := 2
 	newline := "\n"
 
+	x, y := firstPair(6)
+	printInt x*10 + y
+	printStr newline
+
+	// Skip the rows containing a multiple of 4.
+	sum := 0
+rows:
+	for i := 1; i <= 4; i++ {
+		for j := 1; j <= 3; j++ {
+			if (i*j)%4 == 0 {
+				continue rows
+			}
+		}
+		sum += i
+	}
+	printInt sum
+	printStr newline
+
+	// A break out of a loop from a switch.
+	k := 0
+loop:
+	for {
+		switch {
+		case k == 3:
+			break loop
+		case k%2 == 0:
+			k += 1
+			continue
+		}
+		k++
+	}
+	printInt k
+	printStr newline
+
+	// A break out of a labeled switch from a loop within it.
+	found := 0
+sw:
+	switch k {
+	case 3:
+		for i := 1; i < 10; i++ {
+			if i*k > 10 {
+				found = i
+				break sw
+			}
+		}
+		found = -1
+	}
+	printInt found
+	printStr newline
+
 	goto l2
 
 l1:
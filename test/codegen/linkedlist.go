@@ -0,0 +1,152 @@
+package main
+
+type Elem struct {
+	val  int
+	next *Elem
+}
+
+type Tree struct {
+	key         int
+	left, right *Tree
+}
+
+type Account struct {
+	id      int
+	balance float64
+}
+
+var total int
+
+// push returns a list with a new element holding v at its front.
+func push(head *Elem, v int) *Elem {
+	return &Elem{v, head}
+}
+
+// reverse reverses a list in place and returns its new front.
+func reverse(head *Elem) *Elem {
+	var prev *Elem
+	for head != nil {
+		next := head.next
+		head.next = prev
+		prev = head
+		head = next
+	}
+	return prev
+}
+
+// insert inserts a key into a binary search tree and returns its root.
+func insert(t *Tree, key int) *Tree {
+	if t == nil {
+		t = new(Tree)
+		t.key = key
+		return t
+	}
+	if key < t.key {
+		t.left = insert(t.left, key)
+	} else {
+		t.right = insert(t.right, key)
+	}
+	return t
+}
+
+// walk prints the keys of a tree in order.
+func walk(t *Tree) {
+	if t == nil {
+		return
+	}
+	walk(t.left)
+	printInt t.key
+	printStr " "
+	walk(t.right)
+}
+
+func swap(a, b *int) {
+	*a, *b = *b, *a
+}
+
+// Deposit adds an amount to the balance of the account.
+func (a *Account) Deposit(amount float64) {
+	a.balance += amount
+}
+
+func main() {
+	var head *Elem
+	for i := 1; i <= 4; i++ {
+		head = push(head, i)
+	}
+	head = reverse(head)
+	for e := head; e != nil; e = e.next {
+		printInt e.val
+		printStr " "
+	}
+	printStr "\n"
+
+	var root *Tree
+	keys := [6]int{}
+	for i := 0; i < 6; i++ {
+		keys[i] = (i*7 + 5) % 11
+		root = insert(root, keys[i])
+	}
+	walk(root)
+	printStr "\n"
+
+	// Addresses of a local, a global, a field and an array element.
+	x, y := 1, 2
+	swap(&x, &y)
+	p := &total
+	*p += 10
+	e := &head.next.val
+	*e = 20
+	q := &keys[2]
+	*q++
+	printInt x*10 + y
+	printStr " "
+	printInt total
+	printStr " "
+	printInt head.next.val
+	printStr " "
+	printInt keys[2]
+	printStr "\n"
+
+	// A pointer to a pointer.
+	pp := &p
+	**pp = 7
+	printInt total
+	printStr "\n"
+
+	// A floating-point value through a pointer.
+	acc := &Account{id: 1}
+	acc.Deposit(2.5)
+	acc.Deposit(1.25)
+	f := 0.5
+	g := &f
+	*g = *g * 4
+	printFloat acc.balance
+	printStr " "
+	printFloat f
+	printStr "\n"
+
+	n := new(int)
+	if n != nil && p != nil && head.next != nil && p == &total {
+		*n = 3
+	}
+	printInt *n
+	printStr "\n"
+
+	// An array allocated on the heap is shared through pointers to it.
+	arr := &[3]int{4, 5}
+	alias := arr
+	alias[2] = 6
+	sum := 0
+	for _, v := range arr {
+		sum += v
+	}
+	copied := *arr
+	copied[0] = 40
+	names := new([2]string)
+	names[1] += "b"
+	printInt sum*10 + len(arr)
+	printStr " "
+	printInt (*arr)[0] + copied[0]
+	printStr " " + names[0] + names[1] + "\n"
+}
@@ -0,0 +1,104 @@
+package main
+
+// count returns the number of occurrences of the digits of n.
+func count(n int) map[int]int {
+	digits := make(map[int]int)
+	for n > 0 {
+		digits[n%10]++
+		n /= 10
+	}
+	return digits
+}
+
+// total returns the sum of the elements of a map.
+func total(m map[string]int) int {
+	sum := 0
+	for _, v := range m {
+		sum += v
+	}
+	return sum
+}
+
+// memo is a package level map declared after functions, which is initialized at
+// the start of main.
+var memo = make(map[int]int)
+
+// fib returns the nth fibonacci number, memoizing the numbers computed.
+func fib(n int) int {
+	if n < 2 {
+		return n
+	}
+	if v, ok := memo[n]; ok {
+		return v
+	}
+	memo[n] = fib(n-1) + fib(n-2)
+	return memo[n]
+}
+
+// primes is a package level map literal.
+var primes = map[int]bool{2: true, 3: true, 5: true}
+
+func main() {
+	newline := "\n"
+	digits := count(1223334)
+	printInt digits[3]*100 + digits[2]*10 + digits[1]
+	printStr newline
+	printInt len(digits)
+	printStr newline
+
+	ages := map[string]int{"alice": 31, "bob": 27}
+	ages["carol"] = 45
+	ages["bob"] += 1
+	key := "alice"
+	printInt ages[key] + ages["bob"]
+	printStr newline
+	printInt total(ages)
+	printStr newline
+
+	// The comma-ok form reports whether a key is present.
+	v, ok := ages["dave"]
+	if ok {
+		printInt 1
+	} else {
+		printInt v
+	}
+	printStr newline
+	delete(ages, "carol")
+	_, ok = ages["carol"]
+	if !ok {
+		printInt len(ages)
+	}
+	printStr newline
+
+	// The hash table is grown as the entries are inserted.
+	squares := make(map[int]int)
+	for i := 0; i < 100; i++ {
+		squares[i] = i * i
+	}
+	keys, sum := 0, 0
+	for k, v := range squares {
+		keys += k
+		sum += v
+	}
+	printInt keys
+	printStr newline
+	printInt sum
+	printStr newline
+
+	printInt fib(20) + len(memo)
+	printStr newline
+	if primes[3] && !primes[4] {
+		printInt len(primes)
+		printStr newline
+	}
+
+	// A nil map behaves like an empty map, except for assignments.
+	var empty map[int]int
+	printInt len(empty) + empty[5]
+	printStr newline
+	if empty == nil && nil != squares {
+		printInt len(squares)
+		printStr newline
+	}
+	empty[1] = 1
+}
@@ -0,0 +1,26 @@
+package mathx
+
+import (
+	"geom"
+	"strconv"
+)
+
+// helper returns the successor of an integer.
+func helper(n int) int {
+	return n + 1
+}
+
+// Succ returns the successor of an integer.
+func Succ(n int) int {
+	return helper(n)
+}
+
+// Label returns a label for an integer.
+func Label(n int) string {
+	return "#" + strconv.Itoa(n)
+}
+
+// Norm returns the square of the distance of a point from the origin.
+func Norm(p geom.Point) int {
+	return geom.Dist2(p, geom.Point{})
+}
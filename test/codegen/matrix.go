@@ -0,0 +1,139 @@
+package main
+
+type Point struct {
+	X, Y int
+}
+
+type Body struct {
+	pos  Point
+	mass float64
+	next *Body
+}
+
+const size = 3
+
+var board [size][size]int
+
+type Person struct {
+	name string
+	age  int
+}
+
+// The strings of the global arrays start out empty.
+var labels [2]string
+var people [2]Person
+
+// trace returns the sum of the diagonal of a matrix, which is passed by value
+// and is thus not modified by the caller.
+func trace(m [size][size]int) int {
+	t := 0
+	for i, row := range m {
+		t += row[i]
+		m[i][i] = 0
+	}
+	return t
+}
+
+// sum returns the sum of the elements of an array.
+func sum(g [3]int) int {
+	s := 0
+	for _, v := range g {
+		s += v
+	}
+	g[0] = 0
+	return s
+}
+
+func main() {
+	a := [size][size]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+	id := [size][size]int{}
+	for i := 0; i < len(id); i++ {
+		id[i][i] = 2
+	}
+	var c [size][size]int
+	for i := 0; i < size; i++ {
+		for j := 0; j < size; j++ {
+			for k := 0; k < size; k++ {
+				c[i][j] += a[i][k] * id[k][j]
+			}
+		}
+	}
+	for i := range c {
+		for _, v := range c[i] {
+			printInt v
+			printStr " "
+		}
+		printStr "\n"
+	}
+
+	// Arrays are copied when they are passed, as are the rows ranged over.
+	printInt trace(a) + sum(a[1])
+	printStr " "
+	for _, row := range a {
+		row[0] = 0
+		printInt row[0] + a[0][0] + sum(row)
+		printStr " "
+	}
+	printStr "\n"
+
+	// Rows are copied on assignment.
+	row := a[0]
+	row[0] = 10
+	a[2] = row
+	printInt a[0][0] + a[2][0]
+	printStr " "
+	printInt len(a) * len(a[1])
+	printStr "\n"
+
+	// A global array starts out zeroed.
+	board[1][2] = 5
+	board[2][1] = board[1][2] * 2
+	printInt board[0][0] + board[1][2] + board[2][1]
+	printStr "\n"
+
+	bodies := [3]Body{{Point{1, 2}, 1.5, nil}, {pos: Point{3, 4}}}
+	bodies[1].mass = 2.25
+	bodies[2] = Body{Point{5, 6}, 0.25, &bodies[0]}
+	total := 0.0
+	for i := range bodies {
+		total += bodies[i].mass
+		bodies[i].pos.X *= 10
+	}
+	printFloat total
+	printStr " "
+	printInt bodies[2].next.pos.X + bodies[1].pos.Y
+	printStr "\n"
+
+	var ptrs [2]*Point
+	ptrs[1] = &Point{7, 8}
+	if ptrs[0] == nil && ptrs[1] != nil {
+		printInt ptrs[1].Y
+	}
+	printStr "\n"
+
+	cube := [2][2][2]int{}
+	n := 0
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			for k := 0; k < 2; k++ {
+				n++
+				cube[i][j][k] = n
+			}
+		}
+	}
+	printInt cube[1][0][1]*10 + cube[0][1][1]
+	printStr "\n"
+
+	names := [3]string{"x", "y"}
+	weights := [2]float64{0.5, 2}
+	printStr names[1] + names[0] + names[2] + " "
+	printFloat weights[0] * weights[1]
+	printStr "\n"
+
+	var crowd [2]Person
+	crowd[0].age = 30
+	labels[0] = "a"
+	printStr labels[0] + labels[1] + people[1].name + crowd[1].name + "|"
+	printInt len(labels[1]) + crowd[0].age + people[0].age
+	printStr "\n"
+}
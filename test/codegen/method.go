@@ -0,0 +1,117 @@
+package main
+
+type Point struct {
+	x int
+	y int
+}
+
+// Move translates the point by (dx, dy).
+func (p *Point) Move(dx, dy int) {
+	p.x += dx
+	p.y += dy
+}
+
+// Dist returns the Manhattan distance of the point from the origin.
+func (p Point) Dist() int {
+	d := p.x + p.y
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// Reset moves the point back to the origin. Since the receiver is not a
+// pointer, the point remains unchanged.
+func (p Point) Reset() {
+	p.x = 0
+	p.y = 0
+}
+
+// Print prints the abscissa of the point.
+func (p Point) Print() {
+	space := " "
+	printInt p.x
+	printStr space
+}
+
+// Show prints the abscissa of the point.
+func (p *Point) Show() {
+	space := " "
+	printInt p.x
+	printStr space
+}
+
+// Scale scales the point by k and returns its distance from the origin.
+func (p *Point) Scale(k int) int {
+	p.x *= k
+	p.y *= k
+	return p.Dist()
+}
+
+// Celsius is a temperature, whose methods are declared on a named type which
+// is not a struct.
+type Celsius int
+
+// Fahrenheit converts the temperature.
+func (c Celsius) Fahrenheit() int {
+	return int(c)*9/5 + 32
+}
+
+// Warm raises the temperature by d.
+func (c *Celsius) Warm(d int) {
+	*c += Celsius(d)
+}
+
+// apply calls f with the translation (dx, dy).
+func apply(f func(int, int), dx, dy int) {
+	f(dx, dy)
+}
+
+func main() {
+	newline := "\n"
+	a := Point{1, 2}
+	a.Move(3, 4)
+	printInt a.x*10 + a.y
+	printStr newline
+	a.Reset()
+	printInt a.Dist()
+	printStr newline
+	printInt a.Scale(2)
+	printStr newline
+
+	// The receiver is dereferenced automatically.
+	q := &a
+	q.Move(-10, -20)
+	printInt q.Dist()
+	printStr newline
+
+	// A method value with a value receiver holds a copy of the receiver,
+	// whereas one with a pointer receiver refers to it.
+	dist := a.Dist
+	move := a.Move
+	move(1, 1)
+	printInt dist()*100 + a.Dist()
+	printStr newline
+
+	// A method value is a function value, which can be passed around.
+	apply(a.Move, 2, 3)
+	apply(move, 1, 1)
+	var f func() int = a.Dist
+	a.x = 100
+	printInt a.y*100 + f()
+	printStr newline
+
+	// The methods of a named type which is not a struct.
+	t := Celsius(30)
+	t.Warm(10)
+	printInt t.Fahrenheit()
+	printStr newline
+
+	// The value receiver of a deferred call is evaluated at the defer site,
+	// whereas a pointer receiver is dereferenced when the call is made.
+	defer a.Show()
+	defer a.Print()
+	defer a.Move(5, 5)
+	printInt a.x
+	printStr newline
+}
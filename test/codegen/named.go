@@ -0,0 +1,93 @@
+package main
+
+type (
+	Celsius    int
+	Fahrenheit int
+	Meters     float64
+	Count      = int
+	Temp       = Celsius
+	op         func(int, int) int
+	Board      [size][size]int
+)
+
+const size = 2
+
+type reading struct {
+	place string
+	temp  Celsius
+}
+
+const boiling Celsius = 100
+
+var (
+	freezing Celsius = 0
+	limit    = 3
+	unit     string
+)
+
+// toFahrenheit converts a temperature, whose type is distinct from int.
+func toFahrenheit(c Celsius) Fahrenheit {
+	return Fahrenheit(c*9/5 + 32)
+}
+
+// trace returns the sum of the diagonal of a board.
+func trace(b Board) int {
+	t := 0
+	for i := 0; i < size; i++ {
+		t += b[i][i]
+	}
+	return t
+}
+
+// apply applies an operation, which may be given by a function literal.
+func apply(o op, x, y int) int {
+	return o(x, y)
+}
+
+func main() {
+	unit = "F"
+	var t Temp = boiling
+	printInt int(toFahrenheit(t))
+	printStr unit + "\n"
+	printInt int(toFahrenheit(freezing))
+	printStr unit + "\n"
+	var n Count = limit
+	n += 2
+	printInt n
+	printStr "\n"
+	r := reading{"lake", 12}
+	r.temp = r.temp + 3
+	printInt int(r.temp)
+	printStr "\n"
+	temps := [3]Celsius{}
+	total := Celsius(0)
+	for i := 0; i < 3; i++ {
+		temps[i] = Celsius(i*5) - 5
+		total += temps[i]
+	}
+	printInt int(total)
+	printStr "\n"
+	// The elements of arrays, slices and maps are of their named types.
+	var first Celsius = temps[0]
+	printInt int(toFahrenheit(first)) + int(toFahrenheit(temps[2]))
+	printStr "\n"
+	lows := []Celsius{-40, 10}
+	highs := map[string]Celsius{"lake": 30}
+	printInt int(toFahrenheit(lows[0])) + int(toFahrenheit(highs["lake"]))
+	printStr "\n"
+	var add op = func(x, y int) int {
+		return x + y
+	}
+	printInt add(1, 2)*10 + apply(func(x, y int) int { return x * y }, 2, 3)
+	printStr "\n"
+	var d Meters = 2.5
+	d = d * 2
+	printFloat float64(d)
+	printStr "\n"
+	var b Board
+	b[1] = [size]int{4, 5}
+	b[0][0] = 2
+	c := Board{{1, 0}, {0, 1}}
+	printInt trace(b)*10 + trace(c)
+	printStr "\n"
+}
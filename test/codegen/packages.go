@@ -0,0 +1,37 @@
+package main
+
+import (
+	"geom"
+	m "mathx"
+)
+
+// helper has the same name as the functions of the imported packages.
+func helper() int {
+	return 100
+}
+
+func main() {
+	p := geom.Point{1, 2}
+	q := geom.Point{X: 4, Y: 6}
+	printInt geom.Dist2(p, q)
+	printStr "\n"
+
+	pp := &p
+	pp.Move(2, 2)
+	printInt p.X*10 + p.Y
+	printStr " "
+	printInt geom.Moves
+	geom.Moves += 10
+	printStr " "
+	printInt geom.Moves
+	printStr "\n"
+
+	printInt m.Succ(helper())
+	printStr " " + m.Label(geom.Dim) + " "
+	printInt m.Norm(q)
+	printStr "\n"
+
+	r := geom.Scale(q, 2)
+	printInt r.Sum()
+	printStr "\n"
+}
@@ -0,0 +1,63 @@
+package main
+
+import "fmt"
+
+type node struct {
+	val  int
+	next *node
+}
+
+// divide returns the quotient and the remainder of a division, which are
+// checked for a zero divisor at runtime.
+func divide(a, b int) (int, int) {
+	return a / b, a % b
+}
+
+// sum returns the sum of the values of a list, whose pointers are checked
+// before they are dereferenced.
+func sum(n *node) int {
+	s := 0
+	for n != nil {
+		s += n.val
+		n = n.next
+	}
+	return s
+}
+
+// last returns the value of the last element of a list, which must not be
+// empty.
+func last(n *node) int {
+	if n.next == nil {
+		return n.val
+	}
+	return last(n.next)
+}
+
+// validate panics with the index of the first negative element of a slice.
+func validate(s []int) {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 0 {
+			panic(i)
+		}
+	}
+}
+
+func main() {
+	q, r := divide(17, 5)
+	fmt.Println("divide:", q, r)
+
+	list := &node{1, &node{2, &node{3, nil}}}
+	fmt.Println("sum:", sum(list), "last:", last(list))
+
+	var a [4]int
+	for i := 0; i < len(a); i++ {
+		a[i] = i * i
+	}
+	validate(a[:])
+	fmt.Println("valid:", a[3])
+
+	// The last element of an empty list dereferences a nil pointer.
+	var empty *node
+	fmt.Println("empty:", sum(empty))
+	fmt.Println(last(empty))
+}
@@ -1,10 +1,76 @@
 package main
 
 func main() {
+	newline := "\n"
 	// a = 4
 	a := 4 + 2 - 3/3*2
 	// b = 6
 	b := 4 + 2 - 3/(3*2)
 	printInt a
+	printStr newline
+	printInt b
+	printStr newline
+
+	// Operators of the same precedence associate to the left.
+	x := 10
+	y := 4
+	z := 2
+	// c = 8
+	c := x - y + z
+	printInt c
+	printStr newline
+	// d = 4
+	d := x / z * 2 / 5 * 2
+	printInt d
+	printStr newline
+	// e = 2
+	e := 1 - y + 5
+	printInt e
+	printStr newline
+
+	// Bitwise and shift operators.
+	// f = 14
+	f := x | y
+	printInt f
+	printStr newline
+	// g = 14
+	g := x ^ y
+	printInt g
+	printStr newline
+	// h = 10
+	h := x &^ y | x&y
+	printInt h
+	printStr newline
+	// i = 41
+	i := x<<z + 1
+	printInt i
+	printStr newline
+	// j = -3
+	j := -x >> z
+	printInt j
+	printStr newline
+	// k = -11
+	k := ^x
+	printInt k
+	printStr newline
+	// l = 1
+	l := 1 << y >> z &^ 3 ^ 5
+	printInt l
+	printStr newline
+	// m = 6
+	m := !(x < y) && (x|y)&^8 == 6
+	if m == true {
+		printInt 6
+		printStr newline
+	}
+
+	// Assignment operations.
+	x <<= 2
+	x |= 1
+	x &^= 8
+	x -= 3
+	// x = 30
+	printInt x
+	printStr newline
 	return
 }
@@ -0,0 +1,95 @@
+package main
+
+// count returns the number of occurrences of a byte in a string.
+func count(s string, c rune) int {
+	n := 0
+	for _, b := range s {
+		if b == c {
+			n++
+		}
+	}
+	return n
+}
+
+func main() {
+	newline := "\n"
+	space := " "
+
+	a := [5]int{}
+	for i := range a {
+		a[i] = i * i
+	}
+	sum := 0
+	for _, v := range a {
+		sum += v
+	}
+	printInt sum
+	printStr newline
+
+	// The iteration variables can be assigned instead of declared.
+	i, v := 0, 0
+	for i, v = range a {
+		if v > 5 {
+			break
+		}
+	}
+	printInt i*10 + v
+	printStr newline
+
+	s := make([]int, 0)
+	for k := range 6 {
+		s = append(s, k+1)
+	}
+	product := 1
+	for _, v := range s {
+		// A continue statement proceeds to the next element.
+		if v%2 == 0 {
+			continue
+		}
+		product *= v
+	}
+	printInt product
+	printStr newline
+
+	str := "mississippi"
+	printInt count(str, 115)
+	printStr space
+	printInt count("hello", 108)
+	printStr newline
+
+	n := 0
+	for range 3 {
+		n++
+	}
+	printInt n
+	printStr newline
+
+	// A continue statement runs the post statement.
+	odd := 0
+	for j := 0; j < 10; j++ {
+		if j%2 == 0 {
+			continue
+		}
+		odd += j
+	}
+	printInt odd
+	printStr newline
+
+	// The elements of an array are those it holds when the loop begins,
+	// whereas those of an array pointed to are loaded in each iteration.
+	digits := [3]int{1, 2, 3}
+	total := 0
+	for i, v := range digits {
+		digits[2] = 100 * (i + 1)
+		total += v
+	}
+	shared := &[2]int{1, 2}
+	for _, v := range shared {
+		shared[1] = 10
+		total += v * 1000
+	}
+	printInt total
+	printStr space
+	printInt digits[2]
+	printStr newline
+}
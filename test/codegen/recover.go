@@ -0,0 +1,89 @@
+package main
+
+import "fmt"
+
+// safeDiv recovers from a division by zero, which is reported by its named
+// result.
+func safeDiv(a, b int) (q int, err string) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println("recovered:", r)
+			err = "division failed"
+		}
+	}()
+	q = a / b
+	return q, ""
+}
+
+// deep panics once the recursion reaches the bottom, the deferred calls of the
+// callers running as the panic unwinds them.
+func deep(n int) {
+	defer fmt.Println("unwinding", n)
+	if n == 0 {
+		panic("bottom reached")
+	}
+	deep(n - 1)
+}
+
+func catch() (res int) {
+	defer func() {
+		r := recover()
+		fmt.Println("caught:", r)
+		res = -1
+	}()
+	deep(3)
+	return 7
+}
+
+// repanic recovers from a panic and panics again with the same value, which
+// is recovered from by its caller.
+func repanic() {
+	defer func() {
+		r := recover()
+		fmt.Println("first recover:", r)
+		panic(r)
+	}()
+	panic(42)
+}
+
+func outer() {
+	defer func() {
+		fmt.Println("outer recover:", recover())
+	}()
+	repanic()
+	fmt.Println("not reached")
+}
+
+type node struct {
+	val int
+}
+
+// worker recovers from a nil pointer dereference in its own goroutine.
+func worker(done chan bool) {
+	defer func() {
+		done <- true
+	}()
+	defer fmt.Println("worker exiting")
+	var p *node
+	defer func() {
+		fmt.Println("worker recovered:", recover())
+	}()
+	fmt.Println(p.val)
+}
+
+func main() {
+	q, err := safeDiv(7, 2)
+	fmt.Println("safeDiv:", q, err)
+	q, err = safeDiv(7, 0)
+	fmt.Println("safeDiv:", q, err)
+	fmt.Println("catch:", catch())
+	outer()
+	done := make(chan bool)
+	go worker(done)
+	<-done
+	fmt.Println("recover without panic:", recover())
+
+	// The deferred calls run before an unrecovered panic is printed.
+	defer fmt.Println("main deferred")
+	panic("fatal")
+}
@@ -0,0 +1,154 @@
+package main
+
+type Pair struct {
+	a int
+	b int
+}
+
+// divmod returns the quotient and the remainder of x divided by y.
+func divmod(x, y int) (int, int) {
+	return x / y, x % y
+}
+
+// stats returns the minimum, the maximum and the sum of three integers,
+// along with whether they are sorted. The results are named, and are returned
+// by an empty return statement.
+func stats(x, y, z int) (lo, hi, sum int, sorted bool) {
+	lo, hi = x, x
+	if y < lo {
+		lo = y
+	}
+	if z < lo {
+		lo = z
+	}
+	if y > hi {
+		hi = y
+	}
+	if z > hi {
+		hi = z
+	}
+	sum = x + y + z
+	sorted = x <= y && y <= z
+	return
+}
+
+// forward returns the results of another call.
+func forward(x int) (int, int) {
+	return divmod(x, 7)
+}
+
+// swap returns a pair with the members exchanged.
+func swap(p Pair) Pair {
+	q := Pair{p.b, p.a}
+	return q
+}
+
+// split returns a pair holding the quotient and the remainder, and the name
+// of the larger one.
+func split(x, y int) (p Pair, name string) {
+	p.a, p.b = divmod(x, y)
+	if p.a > p.b {
+		return p, "quotient"
+	}
+	return p, "remainder"
+}
+
+// three returns multiples of its parameter, which is modified before the
+// results are returned on the stack.
+func three(n int) (int, int, int) {
+	n++
+	return n, n * 2, n * 3
+}
+
+// rot returns its parameters rotated, where the results returned on the stack
+// are held by the words of other parameters.
+func rot(a, b, c, d int) (int, int, int, int) {
+	return c, d, b, a
+}
+
+// mk returns a pair along with the sum of its members, where the results are
+// unnamed and the first one is a struct.
+func mk(x, y int) (Pair, int) {
+	return Pair{x, y}, x + y
+}
+
+// order returns the number of members which are in order, along with the pair
+// with its members sorted.
+func order(p Pair) (int, Pair) {
+	if p.a > p.b {
+		return 0, Pair{p.b, p.a}
+	}
+	return 2, p
+}
+
+// dup returns a copy of a pair and a pointer to another one.
+func dup(p Pair) (Pair, *Pair) {
+	q := p
+	return p, &q
+}
+
+func add(x, y int) int {
+	return x + y
+}
+
+func main() {
+	newline := "\n"
+	space := " "
+	q, r := divmod(17, 5)
+	printInt q*10 + r
+	printStr newline
+
+	lo, hi, sum, sorted := stats(7, 3, 9)
+	printInt lo
+	printStr space
+	printInt hi
+	printStr space
+	printInt sum
+	printStr space
+	if !sorted {
+		printInt 0
+	}
+	printStr newline
+
+	// The results of a call are not overwritten by a nested call.
+	x, y := forward(add(divmod(40, 3)))
+	printInt x*10 + y
+	printStr newline
+
+	p := Pair{1, 2}
+	s := swap(swap(swap(p)))
+	printInt s.a*10 + s.b
+	printStr newline
+
+	pair, name := split(23, 4)
+	printInt pair.a*10 + pair.b
+	printStr space
+	printStr name
+	printStr newline
+
+	u, v, w := three(1)
+	printInt u*100 + v*10 + w
+	printStr newline
+	e, f, g, h := rot(1, 2, 3, 4)
+	printInt e*1000 + f*100 + g*10 + h
+	printStr newline
+
+	// A function value returns multiple results as well.
+	fn := func(n int) (int, int, int) {
+		return n, n * n, n * n * n
+	}
+	a, b, c := fn(3)
+	printInt a + b + c
+	printStr newline
+
+	m, sm := mk(4, 5)
+	printInt m.a*100 + m.b*10 + sm
+	printStr space
+	n, o := order(Pair{7, 3})
+	printInt n*100 + o.a*10 + o.b
+	printStr space
+	d, ptr := dup(o)
+	ptr.a = 9
+	printInt d.a*100 + ptr.a*10 + ptr.b
+	printStr newline
+}
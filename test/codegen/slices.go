@@ -0,0 +1,119 @@
+package main
+
+// Point is an element of a slice which occupies multiple words.
+type Point struct {
+	X, Y int
+}
+
+// history is a package level slice, which is initially nil.
+var history []int
+
+// sum returns the sum of the elements of a slice.
+func sum(s []int) int {
+	total := 0
+	for i := 0; i < len(s); i++ {
+		total += s[i]
+	}
+	return total
+}
+
+// squares returns the squares of the first n natural numbers.
+func squares(n int) []int {
+	s := make([]int, 0, n)
+	for i := 1; i <= n; i++ {
+		s = append(s, i*i)
+	}
+	return s
+}
+
+// primes and buf are package level slices declared after functions, which are
+// initialized at the start of main.
+var primes = []int{2, 3, 5, 7}
+var buf = make([]int, 2, 4)
+
+func main() {
+	newline := "\n"
+	s := make([]int, 3)
+	s[0] = 4
+	s[1] = 5
+	s[2] = 6
+	printInt sum(s)
+	printStr newline
+
+	// The underlying array is reallocated as the slice grows.
+	var t []int
+	for i := 0; i < 10; i++ {
+		t = append(t, i)
+	}
+	printInt sum(t)
+	printStr newline
+	printInt cap(t)
+	printStr newline
+
+	sq := squares(5)
+	u := sq[1:2:3]
+	printInt len(u)*10 + cap(u)
+	printStr newline
+	// The capacity of u is sufficient, hence sq is modified.
+	u = append(u, 100)
+	printInt sq[2]
+	printStr newline
+	// The capacity of u is exceeded, hence sq is not modified.
+	u = append(u, 200, 300)
+	printInt sq[3]
+	printStr newline
+	printInt u[3]
+	printStr newline
+
+	// Slicing an array shares its elements.
+	a := [5]int{}
+	a[3] = 9
+	b := a[1:4]
+	b[1] = 8
+	printInt a[2] + b[2]
+	printStr newline
+	printInt len(b) + cap(b)
+	printStr newline
+
+	n := 3
+	printInt sum(sq[n:]) + len(sq[:n])
+	printStr newline
+
+	// A slice is nil until an array is allocated for it, even if empty.
+	var none []int
+	if none == nil && none[:0] == nil && make([]int, 0) != nil {
+		none = append(none, n)
+	}
+	printInt len(none)
+	printStr newline
+
+	// The elements of a slice can be of any type.
+	ps := make([]Point, 2)
+	ps[1].Y = 7
+	ps = append(ps, Point{1, 2})
+	printInt ps[1].Y + ps[2].X
+	printStr newline
+	pp := []*Point{&ps[1], nil}
+	pp[0].Y++
+	printInt pp[0].Y
+	printStr newline
+	bs := []byte{200, 100}
+	bs[0] += bs[1]
+	printInt bs[0]
+	printStr newline
+	grid := [][]int{{1, 2}, {3}}
+	grid[1] = append(grid[1], 4)
+	for _, row := range grid {
+		history = append(history, row...)
+	}
+	printInt sum(history) + len(grid[1])
+	printStr newline
+	buf = append(buf, primes[3])
+	printInt sum(primes) + len(buf)*10 + cap(buf)
+	printStr newline
+
+	// Out of range indices cause a panic.
+	printInt sq[n+2]
+	printStr newline
+	return
+}
@@ -0,0 +1,105 @@
+package main
+
+import "strconv"
+
+type person struct {
+	name string
+	age  int
+}
+
+var greeting = "hi"
+
+// greet returns a greeting for a name.
+func greet(name string) string {
+	return "hello, " + name + "!"
+}
+
+// repeat returns a string repeated n times.
+func repeat(s string, n int) string {
+	r := ""
+	for i := 0; i < n; i++ {
+		r += s
+	}
+	return r
+}
+
+// min returns the lexicographically smaller string.
+func min(a, b string) string {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// label returns the name of a number along with its length.
+func label(n int) (string, int) {
+	s := "#" + strconv.Itoa(n)
+	return s, len(s)
+}
+
+func main() {
+	newline := "\n"
+	space := " "
+
+	printStr greet("gopher")
+	printStr newline
+	printStr repeat("ab", 3)
+	printStr newline
+
+	// The concatenation of string literals is evaluated during compilation.
+	s := "go" + "pher"
+	t := "go"
+	t = t + "pher"
+	if s == t {
+		printInt 1
+	}
+	if s != "gopher" || t >= "gophers" {
+		printInt 2
+	}
+	printInt len(t)
+	printStr newline
+
+	printStr min("banana", "apple")
+	printStr space
+	printStr min("app", "apple")
+	printStr newline
+
+	printStr strconv.Itoa(1234) + space + strconv.Itoa(-56) + space + strconv.Itoa(0)
+	printStr newline
+	n := -2147483647 - 1
+	printStr strconv.Itoa(n)
+	printStr newline
+	l, k := label(42)
+	printStr l
+	printInt k
+	printStr newline
+
+	var b byte = 'G'
+	r := 'o'
+	printStr string(b) + string(r) + string(65)
+	printStr newline
+
+	// A string is not modified by an assignment to a copy of it.
+	u := s
+	u += "s"
+	printStr s + space + u + newline
+
+	p := person{"ada", 36}
+	p.name += " lovelace"
+	printStr p.name + space + strconv.Itoa(p.age)
+	printStr newline
+	q := person{}
+	printStr q.name + "|" + strconv.Itoa(q.age) + newline
+
+	greeting += ", there"
+	printStr greeting
+	printStr newline
+
+	// The strings held by arrays, slices and maps start out empty.
+	var names [2]string
+	words := make([]string, 2)
+	counts := map[int]string{}
+	printStr names[0] + "d" + words[1] + "e" + counts[3] + "f"
+	printInt len(names[1]) + len(words[0]) + len(counts[1])
+	printStr newline
+}
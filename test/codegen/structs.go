@@ -5,9 +5,123 @@ type Node struct {
 	y int
 }
 
+type Point struct {
+	X, Y int
+}
+
+type Rect struct {
+	name   string
+	min    Point
+	max    Point
+	weight float64
+}
+
+type Grid struct {
+	cells [4]int
+	size  int
+}
+
+var origin Point
+
+// area returns the area of a rectangle. The rectangle is passed by value,
+// hence renaming it does not affect the caller.
+func area(r Rect) int {
+	r.name = "copy"
+	return (r.max.X - r.min.X) * (r.max.Y - r.min.Y)
+}
+
+// fill sets every cell of a copy of the grid and returns the copy.
+func fill(g Grid, v int) Grid {
+	for i := 0; i < g.size; i++ {
+		g.cells[i] = v
+	}
+	return g
+}
+
+// diagonal returns a grid whose cells hold the given values.
+func diagonal(a, b int) Grid {
+	return Grid{cells: [4]int{a, b, b, a}, size: 4}
+}
+
+// Set sets a cell of the grid in place.
+func (g *Grid) Set(i, v int) {
+	g.cells[i] = v
+}
+
+// Sum returns the sum of the cells of the grid.
+func (g Grid) Sum() int {
+	s := 0
+	for i := 0; i < g.size; i++ {
+		s += g.cells[i]
+	}
+	g.cells[0] = 100
+	return s
+}
+
 func main() {
 	a := Node{}
 	b := Node{1 + 2, 3}
 	c := b.x
-	return
+	printInt a.x + c
+	printStr "\n"
+
+	// The fields which are not keyed take their zero values.
+	p := Point{X: 1}
+	printInt p.X*10 + p.Y
+	printStr "\n"
+	r := Rect{name: "box", max: Point{3, 4}}
+	r.min = p
+	printInt area(r)
+	printStr " " + r.name + "\n"
+
+	// Structs are copied on assignment.
+	s := r
+	s.max.X = 10
+	printInt r.max.X + s.max.X
+	printStr "\n"
+	var t Rect
+	t = s
+	printInt t.max.X + origin.X
+	printStr "\n"
+
+	// The fields are accessed through a pointer.
+	q := &r
+	q.min.Y = 2
+	q.weight = 1.5
+	printInt r.min.Y
+	printStr " "
+	printFloat r.weight
+	printStr "\n"
+
+	g := Grid{size: 4}
+	g.Set(1, 5)
+	h := fill(g, 7)
+	printInt g.Sum() + h.Sum()
+	printStr " "
+	printInt g.cells[0] + g.cells[1] + h.cells[3]
+	printStr "\n"
+
+	// The cells of a returned grid outlive the frame of the callee.
+	d := diagonal(3, 4)
+	printInt area(r) + d.Sum()
+	printStr " "
+	printInt d.cells[0] + d.cells[1]
+	printStr "\n"
+
+	// Structs and arrays are equal when their members (or elements) are.
+	e := diagonal(3, 4)
+	if e == d && e.cells != h.cells && r != s && r.min == q.min {
+		printStr "equal\n"
+	}
+	e.cells[3] = 0
+	if e != d {
+		printStr "unequal\n"
+	}
+
+	// The elements of a slice of pointers can be literals of the type
+	// pointed to, whose addresses are taken.
+	ps := []*Point{{1, 2}, {X: 3}}
+	ps[0].X, ps[1].Y = ps[1].X, ps[0].X
+	printInt ps[0].X*10 + ps[1].Y
+	printStr "\n"
 }
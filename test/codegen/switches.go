@@ -0,0 +1,145 @@
+package main
+
+// classify returns 1 for small primes, 2 for other numbers below 10 and 3 for
+// the remaining numbers.
+func classify(n int) int {
+	switch n {
+	default:
+		return 3
+	case 2, 3, 5, 7:
+		return 1
+	case 0, 1, 4, 6, 8, 9:
+		return 2
+	}
+	return 0
+}
+
+// sign returns the sign of an integer.
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+	return 0
+}
+
+func double(n int) int {
+	return 2 * n
+}
+
+func main() {
+	newline := "\n"
+	printInt classify(5)*100 + classify(4)*10 + classify(42)
+	printStr newline
+	printInt sign(-7) + sign(0)*10 + sign(3)*100
+	printStr newline
+
+	switch x := double(4); x {
+	case 8:
+		printInt x
+		fallthrough
+	case 9:
+		printInt 9
+	case 10:
+		printInt 10
+	}
+	printStr newline
+
+	// A break statement inside a switch statement terminates the switch
+	// statement and not the enclosing loop.
+	sum := 0
+	for i := 0; i < 10; i++ {
+		switch {
+		case i%3 == 0:
+			continue
+		case i > 7:
+			break
+		default:
+			sum += i
+		}
+		sum += 100
+	}
+	printInt sum
+	printStr newline
+
+	// A case whose body only continues the loop is a jump to its
+	// post statement.
+	sum = 0
+	for i := 0; i < 8; i++ {
+		switch i {
+		default:
+			sum += i
+		case 5:
+			continue
+		case 6:
+		}
+		sum += 10
+	}
+	printInt sum
+	printStr newline
+
+	n := 0
+	switch y := 3; {
+	case y > 2 && y < 5:
+		n = 1
+		if y == 3 {
+			break
+		}
+		n = 2
+	}
+	printInt n
+	printStr newline
+
+	// The strings are compared by their contents.
+	word := "be" + "ta"
+	switch word {
+	case "alpha":
+		printInt 1
+	case "gamma", "beta":
+		printInt 2
+	default:
+		printInt 3
+	}
+	f := 2.5
+	switch f * 2 {
+	case 2.5:
+		printInt 1
+	case 5:
+		printInt 2
+	}
+	printStr newline
+
+	// A case may begin with a loop, with or without a tag and a label.
+	k := 0
+	switch word {
+	case "beta":
+		for k < 3 {
+			k = k + 1
+		}
+	}
+	switch {
+	case k == 3:
+		for i := 0; i < 2; i++ {
+			k += 10
+		}
+		fallthrough
+	case k > 100:
+		for k < 30 {
+			k++
+		}
+	}
+outer:
+	switch k {
+	case 30:
+		for {
+			k++
+			if k == 33 {
+				break outer
+			}
+		}
+	}
+	printInt k
+	printStr newline
+}
@@ -0,0 +1,93 @@
+package main
+
+import "fmt"
+
+type point struct {
+	x, y int
+}
+
+// sum returns the sum of any number of integers.
+func sum(xs ...int) int {
+	total := 0
+	for _, v := range xs {
+		total += v
+	}
+	return total
+}
+
+// join concatenates the parts, separated by sep.
+func join(sep string, parts ...string) string {
+	s := ""
+	for i, v := range parts {
+		if i > 0 {
+			s += sep
+		}
+		s += v
+	}
+	return s
+}
+
+// shift moves a point by each of the offsets in turn.
+func (p point) shift(ds ...int) point {
+	for _, d := range ds {
+		p.x += d
+		p.y -= d
+	}
+	return p
+}
+
+// reset zeroes the first value, which is shared with the caller when a slice
+// is spread.
+func reset(xs ...int) int {
+	if len(xs) > 0 {
+		xs[0] = 0
+	}
+	return len(xs)
+}
+
+// count returns the number of elements of a slice, which may be nil.
+func count(xs []int) int {
+	return len(xs)
+}
+
+// none returns a nil slice.
+func none() []int {
+	return nil
+}
+
+func main() {
+	fmt.Println(sum(), sum(1), sum(1, 2, 3))
+	s := make([]int, 0)
+	s = append(s, 4, 5, 6)
+	fmt.Println(sum(s...), sum(s[1:]...))
+
+	fmt.Println(join(", "), join("-", "a"), join(", ", "x", "y", "z"))
+	words := make([]string, 0)
+	words = append(words, "go", "went", "gone")
+	fmt.Println(join("/", words...))
+
+	p := point{1, 2}
+	p = p.shift(1, 2, 3)
+	fmt.Println(p.x, p.y)
+
+	// The values passed for a variadic parameter are placed in a new slice,
+	// whereas a spread slice is passed as is.
+	a, b := 7, 8
+	fmt.Println(reset(a, b), a)
+	fmt.Println(reset(s...), s[0])
+
+	// The elements of a spread slice are appended.
+	t := append(s, s...)
+	fmt.Println(len(t), t[0], t[3], t[5])
+	t = append(make([]int, 0), s[1:]...)
+	t = append(t, 9)
+	fmt.Println(len(t), t[0], t[1], t[2])
+
+	// nil is passed for a slice as an empty slice.
+	fmt.Println(count(nil), count(none()), sum(none()...))
+
+	defer fmt.Println("deferred", sum(t...))
+	for i := 0; i < 3; i++ {
+		defer fmt.Println(sum(s[i:]...))
+	}
+}